@@ -43,6 +43,9 @@ Examples:
   # Analyze a GoogleSQL (BigQuery, Spanner) query
   sqlc analyze --dialect googlesql --schema schema.sql query.sql
 
+  # Analyze a query against Spanner's types and functions only
+  sqlc analyze --dialect googlesql --flavor spanner --schema schema.sql query.sql
+
   # Analyze a SQL Server (T-SQL) query
   sqlc analyze --dialect mssql --schema schema.sql query.sql
 
@@ -50,6 +53,9 @@ Examples:
   echo "-- name: GetAuthor :one
   SELECT * FROM authors WHERE id = $1;" | sqlc analyze --dialect postgresql --schema schema.sql
 
+  # Analyze against functions and types from an extra catalog file
+  sqlc analyze --dialect postgresql --schema schema.sql --catalog postgis.jsonl query.sql
+
   # Include the statement AST in the output
   sqlc analyze --dialect postgresql --schema schema.sql --ast query.sql`,
 		Args: cobra.MaximumNArgs(1),
@@ -70,6 +76,11 @@ Examples:
 				return fmt.Errorf("--schema flag is required")
 			}
 
+			catalogPaths, err := cmd.Flags().GetStringSlice("catalog")
+			if err != nil {
+				return err
+			}
+
 			includeAST, err := cmd.Flags().GetBool("ast")
 			if err != nil {
 				return err
@@ -126,10 +137,20 @@ Examples:
 				return fmt.Errorf("unsupported dialect: %s (use postgresql, mysql, sqlite, clickhouse, googlesql, or mssql)", dialect)
 			}
 
+			flavor, err := cmd.Flags().GetString("flavor")
+			if err != nil {
+				return err
+			}
+
 			sql := config.SQL{
 				Engine:  engine,
+				Flavor:  flavor,
 				Schema:  config.Paths{schemaPath},
 				Queries: config.Paths{queryPath},
+				Catalog: catalogPaths,
+			}
+			if err := config.Validate(&config.Config{SQL: []config.SQL{sql}}); err != nil {
+				return err
 			}
 			combo := config.Combine(config.Config{}, sql)
 			parserOpts := opts.Parser{}
@@ -167,6 +188,8 @@ Examples:
 	}
 	cmd.Flags().StringP("dialect", "d", "", "SQL dialect to use (postgresql, mysql, sqlite, clickhouse, googlesql, or mssql)")
 	cmd.Flags().StringP("schema", "s", "", "path to the schema file")
+	cmd.Flags().String("flavor", "", "flavor of the googlesql dialect (spanner or bigquery)")
+	cmd.Flags().StringSlice("catalog", nil, "path to a catalog file of extra types, operators, casts and functions (repeatable)")
 	cmd.Flags().BoolP("ast", "", false, "include the statement AST in the output")
 	return cmd
 }
@@ -46,6 +46,12 @@ func Do(args []string, stdin io.Reader, stdout io.Writer, stderr io.Writer) int
 	rootCmd.AddCommand(initCmd)
 	rootCmd.AddCommand(newParseCmd())
 	rootCmd.AddCommand(newAnalyzeCmd())
+	rootCmd.AddCommand(newFmtCmd())
+	rootCmd.AddCommand(newTranslateCmd())
+	rootCmd.AddCommand(newIntrospectCmd())
+	rootCmd.AddCommand(newConfigCmd())
+	rootCmd.AddCommand(newReportCmd())
+	rootCmd.AddCommand(newDocsCmd())
 	rootCmd.AddCommand(versionCmd)
 	rootCmd.AddCommand(verifyCmd)
 	rootCmd.AddCommand(pushCmd)
@@ -0,0 +1,120 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+func newConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "config",
+		Short: "Inspect the configuration file",
+	}
+	cmd.AddCommand(newConfigPrintCmd())
+	return cmd
+}
+
+func newConfigPrintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "print",
+		Short: "Print the configuration with its includes and defaults applied",
+		Long: `Print the configuration every other command works from.
+
+The files the configuration includes are merged into it, every sql entry is
+given the settings of the defaults blocks it inherits, and the paths of
+included files are rewritten to be relative to the configuration. The result
+is checked against the configuration's JSON schema before it is printed.
+
+Examples:
+  # Print the resolved configuration as YAML
+  sqlc config print
+
+  # Print it as JSON
+  sqlc config print --format json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+			if format != "yaml" && format != "json" {
+				return fmt.Errorf("unsupported format: %s (use yaml or json)", format)
+			}
+			stderr := cmd.ErrOrStderr()
+			dir, name := getConfigPath(stderr, cmd.Flag("file"))
+			return PrintConfig(dir, name, format, cmd.OutOrStdout(), stderr)
+		},
+	}
+	cmd.Flags().String("format", "yaml", "output format (yaml or json)")
+	return cmd
+}
+
+// PrintConfig resolves the version 2 configuration in dir, validates it and
+// writes it to stdout in format, yaml or json.
+func PrintConfig(dir, filename, format string, stdout, stderr io.Writer) error {
+	configPath, err := findConfig(stderr, dir, filename)
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(configPath)
+	if err != nil {
+		return err
+	}
+	// Parsing first reports the errors every other command would, including
+	// fields the schema does not know about.
+	conf, err := config.ParseConfigFrom(bytes.NewReader(data), dir)
+	if err != nil {
+		return fmt.Errorf("error parsing %s: %w", configPath, err)
+	}
+	if conf.Version != "2" {
+		return fmt.Errorf("config print requires a version 2 configuration")
+	}
+	doc, err := config.Resolve(data, dir)
+	if err != nil {
+		return err
+	}
+	if err := config.ValidateSchema(doc); err != nil {
+		return fmt.Errorf("%s does not match the configuration schema:\n%w", configPath, err)
+	}
+
+	switch format {
+	case "json":
+		var v any
+		if err := doc.Decode(&v); err != nil {
+			return err
+		}
+		out, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintf(stdout, "%s\n", out)
+		return err
+	default:
+		// A configuration written as JSON, or pieced together from several
+		// files, is printed in a single block style.
+		plain(doc)
+		enc := yaml.NewEncoder(stdout)
+		enc.SetIndent(2)
+		if err := enc.Encode(doc); err != nil {
+			return err
+		}
+		return enc.Close()
+	}
+}
+
+// plain clears the styles and comments of a node and everything in it.
+func plain(n *yaml.Node) {
+	n.Style = 0
+	n.HeadComment, n.LineComment, n.FootComment = "", "", ""
+	for _, c := range n.Content {
+		plain(c)
+	}
+}
@@ -88,7 +88,7 @@ func CreateDB(ctx context.Context, dir, filename, querySetName string, o *Option
 	}
 
 	now := time.Now().UTC().UnixNano()
-	client := dbmanager.NewClient(conf.Servers)
+	client := dbmanager.NewClient(conf.Servers, conf.Environ())
 	resp, err := client.CreateDatabase(ctx, &dbmanager.CreateDatabaseRequest{
 		Engine:     string(queryset.Engine),
 		Migrations: ddl,
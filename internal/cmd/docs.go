@@ -0,0 +1,124 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/docs"
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+func newDocsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "docs",
+		Short: "Write documentation and ER diagrams for the schema",
+		Long: `Write documentation for the schema of every sql block.
+
+The markdown and html formats document each table's columns with their types,
+nullability, defaults, comments and keys, the foreign keys between tables, the
+named queries that use each table, and the enums, with an entity-relationship
+diagram in Mermaid. The mermaid and dot formats write only the diagram, in
+Mermaid or in the Graphviz DOT language.
+
+To write the documentation with sqlc generate, add a codegen entry that uses
+the built-in docs plugin.
+
+Examples:
+  # Write Markdown documentation
+  sqlc docs > SCHEMA.md
+
+  # Draw the ER diagram with Graphviz
+  sqlc docs --format dot | dot -Tsvg > schema.svg`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+			switch format {
+			case "markdown", "html", "mermaid", "dot":
+			default:
+				return fmt.Errorf("unsupported format: %s (use markdown, html, mermaid, or dot)", format)
+			}
+			stderr := cmd.ErrOrStderr()
+			dir, name := getConfigPath(stderr, cmd.Flag("file"))
+			opts := &Options{
+				Env:    ParseEnv(cmd),
+				Stderr: stderr,
+			}
+			return WriteDocs(cmd.Context(), dir, name, format, cmd.OutOrStdout(), opts)
+		},
+	}
+	cmd.Flags().String("format", "markdown", "output format (markdown, html, mermaid, or dot)")
+	return cmd
+}
+
+type documenter struct {
+	dir string
+	// titles are the titles of the sql blocks, in the order of the
+	// configuration, which the documents are written in.
+	titles []string
+
+	m    sync.Mutex
+	docs []*docs.Doc
+}
+
+// title names a sql block by its name. An unnamed block is the schema,
+// unless there are several to tell apart by their query files.
+func (d *documenter) title(conf *config.Config, sql config.SQL) string {
+	if sql.Name != "" {
+		return sql.Name
+	}
+	if len(conf.SQL) == 1 {
+		return "Schema"
+	}
+	return "Schema (" + queryPaths(d.dir, sql) + ")"
+}
+
+func (d *documenter) Pairs(ctx context.Context, conf *config.Config) []OutputPair {
+	var pairs []OutputPair
+	for _, sql := range conf.SQL {
+		d.titles = append(d.titles, d.title(conf, sql))
+		pairs = append(pairs, OutputPair{
+			SQL: sql,
+		})
+	}
+	return pairs
+}
+
+func (d *documenter) ProcessResult(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result) error {
+	doc := docs.New(d.title(&combo.Global, sql.SQL), codeGenRequest(result, combo))
+	d.m.Lock()
+	d.docs = append(d.docs, doc)
+	d.m.Unlock()
+	return nil
+}
+
+// WriteDocs writes the documentation of every sql block in the configuration
+// to stdout in format, markdown, html, mermaid or dot.
+func WriteDocs(ctx context.Context, dir, filename, format string, stdout io.Writer, opts *Options) error {
+	d := &documenter{dir: dir}
+	if err := Process(ctx, d, dir, filename, opts); err != nil {
+		return err
+	}
+	sort.SliceStable(d.docs, func(i, j int) bool {
+		return slices.Index(d.titles, d.docs[i].Title) < slices.Index(d.titles, d.docs[j].Title)
+	})
+	switch format {
+	case "html":
+		return docs.WriteHTML(stdout, d.docs, true)
+	case "mermaid":
+		return docs.WriteMermaid(stdout, d.docs)
+	case "dot":
+		return docs.WriteDOT(stdout, d.docs)
+	default:
+		return docs.WriteMarkdown(stdout, d.docs, true)
+	}
+}
@@ -0,0 +1,286 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/cubicdaiya/gonp"
+	"github.com/spf13/cobra"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/engine/dolphin"
+	"github.com/sqlc-dev/sqlc/internal/engine/postgresql"
+	"github.com/sqlc-dev/sqlc/internal/engine/sqlite"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/canonical"
+	"github.com/sqlc-dev/sqlc/internal/sql/format"
+	"github.com/sqlc-dev/sqlc/internal/sql/preprocess"
+	"github.com/sqlc-dev/sqlc/internal/sql/sqlpath"
+)
+
+var errUnformatted = errors.New("unformatted files found")
+
+// formatParser is the subset of the engine parsers that the fmt command
+// needs: parsing SQL into statements and formatting the AST back into SQL.
+type formatParser interface {
+	Parse(io.Reader) ([]ast.Statement, error)
+	format.Dialect
+}
+
+func newFmtCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "fmt",
+		Short: "Format the schema and query files",
+		Long: `Format the schema and query files listed in the configuration file.
+
+Every statement is printed in a canonical form, with upper case keywords and
+each clause of a query on a line of its own. Comments that lead a statement,
+including the "-- name:" annotation, are kept in place, and comments inside
+a statement are moved above it. A statement that sqlc cannot print without
+changing its meaning, such as one with a dollar-quoted body, is left as
+written.
+
+By default the changes are printed as a diff. Use --write to rewrite the
+files in place, or --check to exit with an error when a file is not
+formatted.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			check, err := cmd.Flags().GetBool("check")
+			if err != nil {
+				return err
+			}
+			write, err := cmd.Flags().GetBool("write")
+			if err != nil {
+				return err
+			}
+			stderr := cmd.ErrOrStderr()
+			dir, name := getConfigPath(stderr, cmd.Flag("file"))
+			return Fmt(dir, name, check, write, cmd.OutOrStdout(), stderr)
+		},
+	}
+	cmd.Flags().Bool("check", false, "exit with an error if any file is not formatted")
+	cmd.Flags().Bool("write", false, "rewrite the files in place")
+	cmd.MarkFlagsMutuallyExclusive("check", "write")
+	return cmd
+}
+
+// Fmt formats the schema and query files of every sql block in the
+// configuration. With write, the files are rewritten and their names
+// printed; otherwise the changes are printed as a diff, to stderr and as an
+// error when check is set.
+func Fmt(dir, filename string, check, write bool, stdout, stderr io.Writer) error {
+	_, conf, err := readConfig(stderr, dir, filename)
+	if err != nil {
+		return err
+	}
+
+	engines := map[string]config.Engine{}
+	for _, sql := range conf.SQL {
+		var paths []string
+		for _, p := range append(append([]string{}, sql.Schema...), sql.Queries...) {
+			if !filepath.IsAbs(p) {
+				p = filepath.Join(dir, p)
+			}
+			paths = append(paths, p)
+		}
+		files, err := sqlpath.Glob(paths)
+		if err != nil {
+			fmt.Fprintf(stderr, "error formatting files: %s\n", err)
+			return err
+		}
+		for _, file := range files {
+			if engine, ok := engines[file]; ok && engine != sql.Engine {
+				err := fmt.Errorf("%s is read as both %s and %s", file, engine, sql.Engine)
+				fmt.Fprintf(stderr, "error formatting files: %s\n", err)
+				return err
+			}
+			engines[file] = sql.Engine
+		}
+	}
+	files := make([]string, 0, len(engines))
+	for file := range engines {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+
+	out := stdout
+	if check {
+		out = stderr
+	}
+	var errored, changed bool
+	for _, file := range files {
+		blob, err := os.ReadFile(file)
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", file, err)
+			errored = true
+			continue
+		}
+		formatted, err := formatSQL(engines[file], string(blob))
+		if err != nil {
+			fmt.Fprintf(stderr, "%s: %s\n", file, err)
+			errored = true
+			continue
+		}
+		if formatted == string(blob) {
+			continue
+		}
+		changed = true
+		if write {
+			if err := os.WriteFile(file, []byte(formatted), 0644); err != nil {
+				fmt.Fprintf(stderr, "%s: %s\n", file, err)
+				errored = true
+				continue
+			}
+			fmt.Fprintln(stdout, strings.TrimPrefix(file, dir+string(filepath.Separator)))
+			continue
+		}
+		diff := gonp.New(getLines(blob), getLines([]byte(formatted)))
+		diff.Compose()
+		fmt.Fprintf(out, "--- a%s\n", strings.TrimPrefix(file, dir))
+		fmt.Fprintf(out, "+++ b%s\n", strings.TrimPrefix(file, dir))
+		diff.FprintUniHunks(out, filterHunks(diff.UnifiedHunks()))
+	}
+	if errored {
+		return errors.New("errors formatting files")
+	}
+	if check && changed {
+		return errUnformatted
+	}
+	return nil
+}
+
+// formatSQL formats the statements of a schema or query file. The comments
+// and whitespace that lead a statement are kept, trimmed, above it, and
+// statements are separated by a blank line.
+func formatSQL(engine config.Engine, src string) (string, error) {
+	var parser formatParser
+	switch engine {
+	case config.EnginePostgreSQL:
+		parser = postgresql.NewParser()
+	case config.EngineMySQL:
+		parser = dolphin.NewParser()
+	case config.EngineSQLite:
+		parser = sqlite.NewParser()
+	default:
+		return "", fmt.Errorf("fmt does not support the %s engine", engine)
+	}
+	stmts, err := parser.Parse(strings.NewReader(src))
+	if err != nil {
+		return "", err
+	}
+
+	var parts []string
+	pos := 0
+	for _, stmt := range stmts {
+		end := stmt.Raw.StmtLocation + stmt.Raw.StmtLen
+		if stmt.Raw.StmtLen == 0 {
+			end = len(src)
+		}
+		if end < pos {
+			return "", fmt.Errorf("statement at offset %d overlaps the one before it", stmt.Raw.StmtLocation)
+		}
+		segment := src[pos:end]
+		pos = end + len(src[end:]) - len(strings.TrimLeft(src[end:], " \t\r\n"))
+		if strings.HasPrefix(src[pos:], ";") {
+			pos++
+		}
+
+		start, verbatim, _ := preprocess.Leading(engine, segment)
+		leading := strings.TrimSpace(segment[:start])
+		body := strings.TrimSpace(strings.TrimSuffix(strings.TrimSpace(segment[start:]), ";"))
+		if !verbatim {
+			if pretty, ok := formatStatement(engine, parser, stmt.Raw, body); ok {
+				body = pretty
+			}
+		} else if pretty, comments, ok := formatCommented(engine, parser, stmt.Raw, body); ok {
+			body = pretty
+			leading = strings.TrimSpace(leading + "\n" + strings.Join(comments, "\n"))
+			verbatim = false
+		}
+		if leading != "" {
+			body = leading + "\n" + body
+		}
+		// A semicolon after a trailing line comment would be part of the
+		// comment.
+		if lines := strings.Split(body, "\n"); verbatim && hasLineComment(engine, lines[len(lines)-1]) {
+			body += "\n"
+		}
+		parts = append(parts, body+";")
+	}
+	if tail := strings.TrimSpace(src[pos:]); tail != "" {
+		parts = append(parts, tail)
+	}
+	if len(parts) == 0 {
+		return src, nil
+	}
+	return strings.Join(parts, "\n\n") + "\n", nil
+}
+
+// formatStatement prints a statement in canonical form. It reports false when
+// the printed statement does not parse to the same tree as the one that was
+// written, either as SQL or once sqlc's own syntax, such as sqlc.arg() and
+// @name, is rewritten to native placeholders.
+func formatStatement(engine config.Engine, parser formatParser, raw *ast.RawStmt, body string) (string, bool) {
+	out := strings.TrimSuffix(ast.Pretty(raw, parser), ";")
+	if strings.TrimSpace(out) == "" {
+		return "", false
+	}
+	if !canonical.Equal(engine, body, out) {
+		return "", false
+	}
+	before := preprocess.File(engine, body+";")
+	after := preprocess.File(engine, out+";")
+	if !canonical.Equal(engine, before.Text, after.Text) || !sameSqlcSyntax(before, after) {
+		return "", false
+	}
+	return out, true
+}
+
+// formatCommented prints a statement that holds comments. The comments are
+// kept, each on a line of its own, to go above the printed statement. It
+// reports false when the statement holds anything else a printer would lose,
+// such as a dollar-quoted function body.
+func formatCommented(engine config.Engine, parser formatParser, raw *ast.RawStmt, body string) (string, []string, bool) {
+	rest, comments, ok := preprocess.Comments(engine, body)
+	if !ok || len(comments) == 0 {
+		return "", nil, false
+	}
+	if _, verbatim, _ := preprocess.Leading(engine, rest); verbatim {
+		return "", nil, false
+	}
+	pretty, ok := formatStatement(engine, parser, raw, body)
+	if !ok {
+		return "", nil, false
+	}
+	return pretty, comments, true
+}
+
+// sameSqlcSyntax reports whether two statements use the same named
+// parameters and embeds.
+func sameSqlcSyntax(a, b *preprocess.Result) bool {
+	x, y := a.Statements(), b.Statements()
+	if len(x) != 1 || len(y) != 1 {
+		return len(x) == len(y)
+	}
+	if !reflect.DeepEqual(x[0].Params, y[0].Params) || len(x[0].Embeds) != len(y[0].Embeds) {
+		return false
+	}
+	for i := range x[0].Embeds {
+		if x[0].Embeds[i].Orig() != y[0].Embeds[i].Orig() {
+			return false
+		}
+	}
+	return true
+}
+
+// hasLineComment reports whether a line may end in a comment that runs to the
+// end of the line.
+func hasLineComment(engine config.Engine, line string) bool {
+	return strings.Contains(line, "--") || (engine == config.EngineMySQL && strings.Contains(line, "#"))
+}
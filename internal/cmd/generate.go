@@ -14,8 +14,10 @@ import (
 
 	"google.golang.org/grpc"
 
+	"github.com/sqlc-dev/sqlc/internal/codegen/docs"
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang"
 	genjson "github.com/sqlc-dev/sqlc/internal/codegen/json"
+	genproto "github.com/sqlc-dev/sqlc/internal/codegen/proto"
 	"github.com/sqlc-dev/sqlc/internal/compiler"
 	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/config/convert"
@@ -54,6 +56,14 @@ func printFileErr(stderr io.Writer, dir string, fileErr *multierr.FileError) {
 	fmt.Fprintf(stderr, "%s:%d:%d: %s\n", filename, fileErr.Line, fileErr.Column, fileErr.Err)
 }
 
+func printFileWarning(stderr io.Writer, dir string, fileErr *multierr.FileError) {
+	filename, err := filepath.Rel(dir, fileErr.Filename)
+	if err != nil {
+		filename = fileErr.Filename
+	}
+	fmt.Fprintf(stderr, "%s:%d:%d: warning: %s\n", filename, fileErr.Line, fileErr.Column, fileErr.Err)
+}
+
 func findPlugin(conf config.Config, name string) (*config.Plugin, error) {
 	for _, plug := range conf.Plugins {
 		if plug.Name == name {
@@ -63,7 +73,10 @@ func findPlugin(conf config.Config, name string) (*config.Plugin, error) {
 	return nil, fmt.Errorf("plugin not found")
 }
 
-func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config, error) {
+// findConfig returns the path of the configuration file in dir: filename if
+// one was given, and otherwise whichever of sqlc.yaml, sqlc.yml and sqlc.json
+// exists.
+func findConfig(stderr io.Writer, dir, filename string) (string, error) {
 	configPath := ""
 	if filename != "" {
 		configPath = filepath.Join(dir, filename)
@@ -86,12 +99,12 @@ func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config,
 
 		if yamlMissing && ymlMissing && jsonMissing {
 			fmt.Fprintln(stderr, "error parsing configuration files. sqlc.(yaml|yml) or sqlc.json: file does not exist")
-			return "", nil, errors.New("config file missing")
+			return "", errors.New("config file missing")
 		}
 
 		if (!yamlMissing || !ymlMissing) && !jsonMissing {
 			fmt.Fprintln(stderr, "error: both sqlc.json and sqlc.(yaml|yml) files present")
-			return "", nil, errors.New("sqlc.json and sqlc.(yaml|yml) present")
+			return "", errors.New("sqlc.json and sqlc.(yaml|yml) present")
 		}
 
 		if jsonMissing {
@@ -104,6 +117,14 @@ func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config,
 			configPath = jsonPath
 		}
 	}
+	return configPath, nil
+}
+
+func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config, error) {
+	configPath, err := findConfig(stderr, dir, filename)
+	if err != nil {
+		return "", nil, err
+	}
 
 	base := filepath.Base(configPath)
 	file, err := os.Open(configPath)
@@ -113,7 +134,9 @@ func readConfig(stderr io.Writer, dir, filename string) (string, *config.Config,
 	}
 	defer file.Close()
 
-	conf, err := config.ParseConfig(file)
+	// Includes are relative to dir, like every other path the configuration
+	// names.
+	conf, err := config.ParseConfigFrom(file, dir)
 	if err != nil {
 		switch err {
 		case config.ErrMissingVersion:
@@ -183,6 +206,12 @@ func (g *generator) Pairs(ctx context.Context, conf *config.Config) []OutputPair
 				Gen: config.SQLGen{JSON: sql.Gen.JSON},
 			})
 		}
+		if sql.Gen.Proto != nil {
+			pairs = append(pairs, OutputPair{
+				SQL: sql,
+				Gen: config.SQLGen{Proto: sql.Gen.Proto},
+			})
+		}
 		for i := range sql.Codegen {
 			pairs = append(pairs, OutputPair{
 				SQL:    sql,
@@ -293,7 +322,14 @@ func parse(ctx context.Context, name, dir string, sql config.SQL, combo config.C
 		}
 		return nil, true
 	}
-	return c.Result(), false
+	result := c.Result()
+	if len(result.Warnings) > 0 {
+		fmt.Fprintf(stderr, "# package %s\n", name)
+		for _, fileErr := range result.Warnings {
+			printFileWarning(stderr, dir, fileErr)
+		}
+	}
+	return result, false
 }
 
 func codegen(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result) (string, *plugin.GenerateResponse, error) {
@@ -302,6 +338,15 @@ func codegen(ctx context.Context, combo config.CombinedSettings, sql OutputPair,
 	var handler grpc.ClientConnInterface
 	var out string
 	switch {
+	case sql.Plugin != nil && sql.Plugin.Plugin == config.BuiltinDocs:
+		out = sql.Plugin.Out
+		handler = ext.HandleFunc(docs.Generate)
+		opts, err := convert.YAMLtoJSON(sql.Plugin.Options)
+		if err != nil {
+			return "", nil, fmt.Errorf("invalid plugin options: %w", err)
+		}
+		req.PluginOptions = opts
+
 	case sql.Plugin != nil:
 		out = sql.Plugin.Out
 		plug, err := findPlugin(combo.Global, sql.Plugin.Plugin)
@@ -367,6 +412,15 @@ func codegen(ctx context.Context, combo config.CombinedSettings, sql OutputPair,
 		}
 		req.PluginOptions = opts
 
+	case sql.Gen.Proto != nil:
+		out = combo.Proto.Out
+		handler = ext.HandleFunc(genproto.Generate)
+		opts, err := json.Marshal(sql.Gen.Proto)
+		if err != nil {
+			return "", nil, fmt.Errorf("opts marshal failed: %w", err)
+		}
+		req.PluginOptions = opts
+
 	default:
 		return "", nil, fmt.Errorf("missing language backend")
 	}
@@ -0,0 +1,133 @@
+package cmd
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/introspect"
+)
+
+// The files introspect writes, which a configuration names as its schema and
+// its catalog.
+const (
+	introspectSchemaFile  = "schema.sql"
+	introspectCatalogFile = "catalog.jsonl"
+)
+
+func newIntrospectCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "introspect",
+		Short: "Read the schema of a live database into a schema file and a catalog file",
+		Long: `Connect to a database and write its schema out as the files sqlc reads.
+
+schema.sql holds the DDL of its tables, views, enums and functions. Types,
+operators and functions that DDL cannot declare, such as those of an extension
+sqlc has no data for, go to catalog.jsonl, which the "catalog" configuration
+key loads. catalog.jsonl is only written when there is something to put in it.
+
+For PostgreSQL, every schema that is not a system schema is read unless
+--schema names the ones to read. MySQL reads the database in the connection
+string, and SQLite the database file.
+
+Examples:
+  # Start a project from a production PostgreSQL database
+  sqlc introspect --dialect postgresql --url "$DATABASE_URL" -o db/
+
+  # Read a MySQL database
+  sqlc introspect --dialect mysql --url "root:secret@tcp(localhost:3306)/app"
+
+  # Read a SQLite database file
+  sqlc introspect --dialect sqlite --url app.db`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			dialect, err := cmd.Flags().GetString("dialect")
+			if err != nil {
+				return err
+			}
+			url, err := cmd.Flags().GetString("url")
+			if err != nil {
+				return err
+			}
+			schemas, err := cmd.Flags().GetStringSlice("schema")
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			if dialect == "" {
+				return fmt.Errorf("--dialect flag is required (postgresql, mysql, or sqlite)")
+			}
+			if url == "" {
+				return fmt.Errorf("--url flag is required")
+			}
+
+			var engine config.Engine
+			var driver string
+			switch dialect {
+			case "postgresql", "postgres", "pg":
+				engine, driver = config.EnginePostgreSQL, "pgx"
+			case "mysql":
+				engine, driver = config.EngineMySQL, "mysql"
+			case "sqlite":
+				engine, driver = config.EngineSQLite, "sqlite3"
+			default:
+				return fmt.Errorf("unsupported dialect: %s (use postgresql, mysql, or sqlite)", dialect)
+			}
+			if len(schemas) > 0 && engine != config.EnginePostgreSQL {
+				return fmt.Errorf("--schema is only supported for postgresql")
+			}
+			if engine == config.EngineSQLite {
+				// Opening a file that does not exist would create it.
+				if _, err := os.Stat(url); err != nil {
+					return err
+				}
+			}
+
+			db, err := sql.Open(driver, url)
+			if err != nil {
+				return fmt.Errorf("failed to open the database: %w", err)
+			}
+			defer db.Close()
+
+			res, err := introspect.Introspect(cmd.Context(), engine, db, introspect.Options{Schemas: schemas})
+			if err != nil {
+				return err
+			}
+			return writeIntrospection(res, output)
+		},
+	}
+	cmd.Flags().StringP("dialect", "d", "", "SQL dialect of the database (postgresql, mysql, or sqlite)")
+	cmd.Flags().String("url", "", "connection string of the database, or the path of a SQLite database file")
+	cmd.Flags().StringSlice("schema", nil, "PostgreSQL schema to read (repeatable; default every non-system schema)")
+	cmd.Flags().StringP("output", "o", ".", "directory to write schema.sql and catalog.jsonl to")
+	return cmd
+}
+
+func writeIntrospection(res *introspect.Result, output string) error {
+	if err := os.MkdirAll(output, 0755); err != nil {
+		return err
+	}
+	var schema bytes.Buffer
+	if err := res.WriteSchema(&schema); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(output, introspectSchemaFile), schema.Bytes(), 0644); err != nil {
+		return err
+	}
+	if len(res.Catalog) == 0 {
+		return nil
+	}
+	var catalog bytes.Buffer
+	if err := res.WriteCatalog(&catalog); err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(output, introspectCatalogFile), catalog.Bytes(), 0644)
+}
@@ -85,6 +85,12 @@ func processQuerySets(ctx context.Context, rp ResultProcessor, conf *config.Conf
 			}
 			sql.Queries = joined
 
+			joined = make([]string, 0, len(sql.Catalog))
+			for _, c := range sql.Catalog {
+				joined = append(joined, filepath.Join(dir, c))
+			}
+			sql.Catalog = joined
+
 			var name, lang string
 			parseOpts := opts.Parser{
 				Experiment: o.Env.Experiment,
@@ -121,12 +127,13 @@ func processQuerySets(ctx context.Context, rp ResultProcessor, conf *config.Conf
 	if err := grp.Wait(); err != nil {
 		return err
 	}
-	if errored {
-		for i, _ := range stderrs {
-			if _, err := io.Copy(stderr, &stderrs[i]); err != nil {
-				return err
-			}
+	// A package that generated its code may still have warnings to report.
+	for i, _ := range stderrs {
+		if _, err := io.Copy(stderr, &stderrs[i]); err != nil {
+			return err
 		}
+	}
+	if errored {
 		return fmt.Errorf("errored")
 	}
 	return nil
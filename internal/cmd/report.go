@@ -0,0 +1,144 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/report"
+)
+
+func newReportCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "report",
+		Short: "Report on the schema and the queries that use it",
+	}
+	cmd.AddCommand(newReportUsageCmd())
+	return cmd
+}
+
+func newReportUsageCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "usage",
+		Short: "Report which tables and columns the queries use",
+		Long: `Cross-reference the queries of every sql block with its schema.
+
+The text report lists the tables and columns no query uses, the tables queries
+write to but never read, and the queries that use each table. The JSON and CSV
+reports have the queries that use every table and every column, for planning
+which columns to drop or finding the queries a migration affects.
+
+A column name that is not qualified with its table counts for every table in
+the query that has a column of that name, so a column reported unused is one
+no query can refer to. Views are reported like tables, and the columns a
+view's definition selects are not counted as used.
+
+Examples:
+  # Print the report
+  sqlc report usage
+
+  # Write the usage of every column to a spreadsheet
+  sqlc report usage --format csv > usage.csv`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			format, err := cmd.Flags().GetString("format")
+			if err != nil {
+				return err
+			}
+			if format != "text" && format != "json" && format != "csv" {
+				return fmt.Errorf("unsupported format: %s (use text, json, or csv)", format)
+			}
+			stderr := cmd.ErrOrStderr()
+			dir, name := getConfigPath(stderr, cmd.Flag("file"))
+			opts := &Options{
+				Env:    ParseEnv(cmd),
+				Stderr: stderr,
+			}
+			return ReportUsage(cmd.Context(), dir, name, format, cmd.OutOrStdout(), opts)
+		},
+	}
+	cmd.Flags().String("format", "text", "output format (text, json, or csv)")
+	return cmd
+}
+
+type reporter struct {
+	dir string
+	// names are the names of the sql blocks, in the order of the
+	// configuration, which the reports are written in.
+	names []string
+
+	m       sync.Mutex
+	reports []*report.Usage
+}
+
+// reportName names a sql block by its name, or by its query files.
+func (r *reporter) reportName(sql config.SQL) string {
+	if sql.Name != "" {
+		return sql.Name
+	}
+	return queryPaths(r.dir, sql)
+}
+
+// queryPaths lists the query files of a sql block relative to dir.
+func queryPaths(dir string, sql config.SQL) string {
+	var paths []string
+	for _, q := range sql.Queries {
+		if rel, err := filepath.Rel(dir, q); err == nil && filepath.IsAbs(q) {
+			q = rel
+		}
+		paths = append(paths, filepath.ToSlash(q))
+	}
+	return strings.Join(paths, ", ")
+}
+
+func (r *reporter) Pairs(ctx context.Context, conf *config.Config) []OutputPair {
+	var pairs []OutputPair
+	for _, sql := range conf.SQL {
+		r.names = append(r.names, r.reportName(sql))
+		pairs = append(pairs, OutputPair{
+			SQL: sql,
+		})
+	}
+	return pairs
+}
+
+func (r *reporter) ProcessResult(ctx context.Context, combo config.CombinedSettings, sql OutputPair, result *compiler.Result) error {
+	u := report.Analyze(r.reportName(sql.SQL), result)
+	r.m.Lock()
+	r.reports = append(r.reports, u)
+	r.m.Unlock()
+	return nil
+}
+
+// ReportUsage writes the usage report of every sql block in the
+// configuration to stdout in format, text, json or csv.
+func ReportUsage(ctx context.Context, dir, filename, format string, stdout io.Writer, opts *Options) error {
+	r := &reporter{dir: dir}
+	if err := Process(ctx, r, dir, filename, opts); err != nil {
+		return err
+	}
+	sort.SliceStable(r.reports, func(i, j int) bool {
+		return slices.Index(r.names, r.reports[i].Name) < slices.Index(r.names, r.reports[j].Name)
+	})
+	switch format {
+	case "json":
+		return report.WriteJSON(stdout, r.reports)
+	case "csv":
+		return report.WriteCSV(stdout, r.reports)
+	default:
+		if err := report.WriteText(stdout, r.reports); err != nil {
+			return err
+		}
+		_, err := fmt.Fprintln(stdout)
+		return err
+	}
+}
@@ -73,9 +73,14 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 					Vals:    typ.Vals,
 				})
 			case *catalog.CompositeType:
+				var columns []*plugin.Column
+				for _, c := range typ.Columns {
+					columns = append(columns, pluginCatalogColumn(c, nil))
+				}
 				cts = append(cts, &plugin.CompositeType{
 					Name:    typ.Name,
 					Comment: typ.Comment,
+					Columns: columns,
 				})
 			}
 		}
@@ -83,28 +88,27 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 		for _, t := range s.Tables {
 			var columns []*plugin.Column
 			for _, c := range t.Columns {
-				l := -1
-				if c.Length != nil {
-					l = *c.Length
-				}
-				columns = append(columns, &plugin.Column{
-					Name: c.Name,
-					Type: &plugin.Identifier{
-						Catalog: c.Type.Catalog,
-						Schema:  c.Type.Schema,
-						Name:    c.Type.Name,
-					},
-					Comment:   c.Comment,
-					NotNull:   c.IsNotNull,
-					Unsigned:  c.IsUnsigned,
-					IsArray:   c.IsArray,
-					ArrayDims: int32(c.ArrayDims),
-					Length:    int32(l),
-					Table: &plugin.Identifier{
-						Catalog: t.Rel.Catalog,
-						Schema:  t.Rel.Schema,
-						Name:    t.Rel.Name,
+				columns = append(columns, pluginCatalogColumn(c, &plugin.Identifier{
+					Catalog: t.Rel.Catalog,
+					Schema:  t.Rel.Schema,
+					Name:    t.Rel.Name,
+				}))
+			}
+			var uniqueKeys []*plugin.UniqueKey
+			for _, key := range t.UniqueKeys {
+				uniqueKeys = append(uniqueKeys, &plugin.UniqueKey{Columns: key})
+			}
+			var foreignKeys []*plugin.ForeignKey
+			for _, fk := range t.ForeignKeys {
+				foreignKeys = append(foreignKeys, &plugin.ForeignKey{
+					Name:    fk.Name,
+					Columns: fk.Columns,
+					RefTable: &plugin.Identifier{
+						Catalog: fk.RefTable.Catalog,
+						Schema:  fk.RefTable.Schema,
+						Name:    fk.RefTable.Name,
 					},
+					RefColumns: fk.RefColumns,
 				})
 			}
 			tables = append(tables, &plugin.Table{
@@ -113,8 +117,11 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 					Schema:  t.Rel.Schema,
 					Name:    t.Rel.Name,
 				},
-				Columns: columns,
-				Comment: t.Comment,
+				Columns:     columns,
+				Comment:     t.Comment,
+				PrimaryKey:  t.PrimaryKey,
+				UniqueKeys:  uniqueKeys,
+				ForeignKeys: foreignKeys,
 			})
 		}
 		schemas = append(schemas, &plugin.Schema{
@@ -127,12 +134,37 @@ func pluginCatalog(c *catalog.Catalog) *plugin.Catalog {
 	}
 	return &plugin.Catalog{
 		Name:          c.Name,
-		DefaultSchema: c.DefaultSchema,
+		DefaultSchema: c.CurrentSchema(),
 		Comment:       c.Comment,
 		Schemas:       schemas,
 	}
 }
 
+// pluginCatalogColumn converts a column of a table, or of a composite type
+// when table is nil.
+func pluginCatalogColumn(c *catalog.Column, table *plugin.Identifier) *plugin.Column {
+	l := -1
+	if c.Length != nil {
+		l = *c.Length
+	}
+	return &plugin.Column{
+		Name: c.Name,
+		Type: &plugin.Identifier{
+			Catalog: c.Type.Catalog,
+			Schema:  c.Type.Schema,
+			Name:    c.Type.Name,
+		},
+		Comment:      c.Comment,
+		NotNull:      c.IsNotNull,
+		Unsigned:     c.IsUnsigned,
+		IsArray:      c.IsArray,
+		ArrayDims:    int32(c.ArrayDims),
+		Length:       int32(l),
+		DefaultValue: c.Default,
+		Table:        table,
+	}
+}
+
 func pluginQueries(r *compiler.Result) []*plugin.Query {
 	var out []*plugin.Query
 	for _, q := range r.Queries {
@@ -152,6 +184,21 @@ func pluginQueries(r *compiler.Result) []*plugin.Query {
 				Name:    q.InsertIntoTable.Name,
 			}
 		}
+		var page *plugin.Pagination
+		if q.Pagination != nil {
+			page = &plugin.Pagination{Descending: q.Pagination.Descending}
+			for _, idx := range q.Pagination.Columns {
+				page.Columns = append(page.Columns, int32(idx))
+			}
+		}
+		var tables []*plugin.Identifier
+		for _, t := range q.Tables {
+			tables = append(tables, &plugin.Identifier{
+				Catalog: t.Catalog,
+				Schema:  t.Schema,
+				Name:    t.Name,
+			})
+		}
 		out = append(out, &plugin.Query{
 			Name:            q.Metadata.Name,
 			Cmd:             q.Metadata.Cmd,
@@ -161,6 +208,9 @@ func pluginQueries(r *compiler.Result) []*plugin.Query {
 			Params:          params,
 			Filename:        q.Metadata.Filename,
 			InsertIntoTable: iit,
+			Pagination:      page,
+			ReadOnly:        q.ReadOnly,
+			Tables:          tables,
 		})
 	}
 	return out
@@ -183,6 +233,7 @@ func pluginQueryColumn(c *compiler.Column) *plugin.Column {
 		IsNamedParam: c.IsNamedParam,
 		IsFuncCall:   c.IsFuncCall,
 		IsSqlcSlice:  c.IsSqlcSlice,
+		TypeOverride: c.TypeOverride,
 	}
 
 	if c.Type != nil {
@@ -0,0 +1,163 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/multierr"
+	"github.com/sqlc-dev/sqlc/internal/sql/translate"
+)
+
+var errUntranslated = errors.New("some statements could not be translated")
+
+func newTranslateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "translate [file...]",
+		Short: "Translate schema and query files from one SQL dialect to another",
+		Long: `Translate schema and query files written for one database engine into the
+SQL of another.
+
+Identifier quoting, string literals, placeholders, casts and column types are
+rewritten for the target, along with the functions that have a known
+equivalent. Everything else, including comments and the "-- name:"
+annotations, is kept as written. A construct that cannot be translated is left
+in place and reported with its position, and the command exits with an error.
+
+Translations go to stdout, or with --output to files of the same name in the
+given directory. With no file arguments, SQL is read from stdin.
+
+Examples:
+  # Translate a MySQL schema to PostgreSQL
+  sqlc translate --from mysql --to postgresql schema.sql
+
+  # Translate SQLite schema and queries to SQL Server, into a directory
+  sqlc translate --from sqlite --to mssql -o mssql/ schema.sql query.sql`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			from, err := cmd.Flags().GetString("from")
+			if err != nil {
+				return err
+			}
+			to, err := cmd.Flags().GetString("to")
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				return err
+			}
+			if from == "" || to == "" {
+				return fmt.Errorf("--from and --to flags are required (postgresql, mysql, sqlite, clickhouse, googlesql, or mssql)")
+			}
+			fromEngine, err := translateEngine(from)
+			if err != nil {
+				return err
+			}
+			toEngine, err := translateEngine(to)
+			if err != nil {
+				return err
+			}
+
+			if len(args) == 0 {
+				if output != "" {
+					return fmt.Errorf("--output requires file arguments")
+				}
+				stat, err := os.Stdin.Stat()
+				if err != nil {
+					return fmt.Errorf("failed to stat stdin: %w", err)
+				}
+				if (stat.Mode() & os.ModeCharDevice) != 0 {
+					return fmt.Errorf("no input provided. Specify file paths or pipe SQL via stdin")
+				}
+			}
+			return Translate(fromEngine, toEngine, args, output, cmd.InOrStdin(), cmd.OutOrStdout(), cmd.ErrOrStderr())
+		},
+	}
+	cmd.Flags().String("from", "", "SQL dialect of the input (postgresql, mysql, sqlite, clickhouse, googlesql, or mssql)")
+	cmd.Flags().String("to", "", "SQL dialect to translate into (postgresql, mysql, sqlite, or mssql)")
+	cmd.Flags().StringP("output", "o", "", "directory to write the translated files to")
+	return cmd
+}
+
+func translateEngine(dialect string) (config.Engine, error) {
+	switch dialect {
+	case "postgresql", "postgres", "pg":
+		return config.EnginePostgreSQL, nil
+	case "mysql":
+		return config.EngineMySQL, nil
+	case "sqlite":
+		return config.EngineSQLite, nil
+	case "clickhouse":
+		return config.EngineClickHouse, nil
+	case "googlesql":
+		return config.EngineGoogleSQL, nil
+	case "mssql", "sqlserver":
+		return config.EngineMSSQL, nil
+	}
+	return "", fmt.Errorf("unsupported dialect: %s (use postgresql, mysql, sqlite, clickhouse, googlesql, or mssql)", dialect)
+}
+
+// Translate translates each file, or stdin when there are none, from one
+// engine to another. The translations are written to the output directory,
+// or to stdout when it is empty. Constructs that could not be translated are
+// reported to stderr.
+func Translate(from, to config.Engine, files []string, output string, stdin io.Reader, stdout, stderr io.Writer) error {
+	tr, err := translate.New(from, to)
+	if err != nil {
+		return err
+	}
+	defer tr.Close()
+
+	if output != "" {
+		if err := os.MkdirAll(output, 0755); err != nil {
+			return err
+		}
+	}
+	var untranslated bool
+	translateFile := func(name string, src []byte) error {
+		out, err := tr.File(name, string(src))
+		if err != nil {
+			var merr *multierr.Error
+			if !errors.As(err, &merr) {
+				return err
+			}
+			for _, fileErr := range merr.Errs() {
+				printFileErr(stderr, ".", fileErr)
+			}
+			untranslated = true
+		}
+		if output == "" {
+			_, err := io.WriteString(stdout, out)
+			return err
+		}
+		return os.WriteFile(filepath.Join(output, filepath.Base(name)), []byte(out), 0644)
+	}
+
+	if len(files) == 0 {
+		src, err := io.ReadAll(stdin)
+		if err != nil {
+			return fmt.Errorf("failed to read stdin: %w", err)
+		}
+		if err := translateFile("<stdin>", src); err != nil {
+			return err
+		}
+	}
+	for _, file := range files {
+		src, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		if err := translateFile(file, src); err != nil {
+			return err
+		}
+	}
+	if untranslated {
+		return errUntranslated
+	}
+	return nil
+}
@@ -60,7 +60,7 @@ func Verify(ctx context.Context, dir, filename string, opts *Options) error {
 		return fmt.Errorf("client init failed: %w", err)
 	}
 
-	manager := dbmanager.NewClient(conf.Servers)
+	manager := dbmanager.NewClient(conf.Servers, conf.Environ())
 
 	// Get query sets from a previous archive by tag. If no tag is provided, get
 	// the latest query sets.
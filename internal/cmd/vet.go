@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/encoding/protojson"
 
+	"github.com/sqlc-dev/sqlc/internal/compiler"
 	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/dbmanager"
 	"github.com/sqlc-dev/sqlc/internal/debug"
@@ -156,7 +157,8 @@ func Vet(ctx context.Context, dir, filename string, opts *Options) error {
 		Env:           env,
 		Stderr:        stderr,
 		OnlyManagedDB: debugDatabases.Value() == "managed",
-		Replacer:      shfmt.NewReplacer(nil),
+		Replacer:      shfmt.NewReplacer(conf.Environ()),
+		Experiment:    e.Experiment,
 	}
 	errored := false
 	for _, sql := range conf.SQL {
@@ -396,6 +398,7 @@ type checker struct {
 	Client        dbmanager.Client
 	clientOnce    sync.Once
 	Replacer      *shfmt.Replacer
+	Experiment    opts.Experiment
 }
 
 // isInMemorySQLite checks if a SQLite URI refers to an in-memory database
@@ -426,7 +429,7 @@ func (c *checker) fetchDatabaseUri(ctx context.Context, s config.SQL) (string, f
 
 	// Initialize the client exactly once, even if called concurrently
 	c.clientOnce.Do(func() {
-		c.Client = dbmanager.NewClient(c.Conf.Servers)
+		c.Client = dbmanager.NewClient(c.Conf.Servers, c.Conf.Environ())
 	})
 
 	var ddl []string
@@ -466,7 +469,7 @@ func (c *checker) fetchDatabaseUri(ctx context.Context, s config.SQL) (string, f
 }
 
 func (c *checker) DSN(dsn string) (string, error) {
-	return c.Replacer.Replace(dsn), nil
+	return c.Replacer.ReplaceStrict(dsn)
 }
 
 func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
@@ -486,8 +489,16 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 	}
 	s.Queries = joined
 
+	joined = make([]string, 0, len(s.Catalog))
+	for _, p := range s.Catalog {
+		joined = append(joined, filepath.Join(c.Dir, p))
+	}
+	s.Catalog = joined
+
 	var name string
-	parseOpts := opts.Parser{}
+	parseOpts := opts.Parser{
+		Experiment: c.Experiment,
+	}
 
 	result, failed := parse(ctx, name, c.Dir, s, combo, parseOpts, c.Stderr)
 	if failed {
@@ -572,6 +583,7 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 	errored := false
 	req := codeGenRequest(result, combo)
 	cfg := vetConfig(req)
+	cfg.Role = s.Role
 	for i, query := range req.Queries {
 		md := result.Queries[i].Metadata
 		if md.Flags[constants.QueryFlagSqlcVetDisable] {
@@ -593,7 +605,7 @@ func (c *checker) checkSQL(ctx context.Context, s config.SQL) error {
 		}
 
 		evalMap := map[string]any{
-			"query":  vetQuery(query),
+			"query":  vetQuery(query, result.Queries[i]),
 			"config": cfg,
 		}
 
@@ -688,7 +700,7 @@ func vetConfig(req *plugin.GenerateRequest) *vet.Config {
 	}
 }
 
-func vetQuery(q *plugin.Query) *vet.Query {
+func vetQuery(q *plugin.Query, cq *compiler.Query) *vet.Query {
 	var params []*vet.Parameter
 	for _, p := range q.Params {
 		params = append(params, &vet.Parameter{
@@ -700,7 +712,35 @@ func vetQuery(q *plugin.Query) *vet.Query {
 		Name:   q.Name,
 		Cmd:    strings.TrimPrefix(q.Cmd, ":"),
 		Params: params,
+		Tables: vetTables(cq.Security),
+	}
+}
+
+// vetTables reports the row-level security of the tables a query touches. The
+// core analyzer records it; without it a query has no tables to report.
+func vetTables(security []*compiler.TableSecurity) []*vet.Table {
+	var tables []*vet.Table
+	for _, ts := range security {
+		t := &vet.Table{
+			Schema:           ts.Rel.Schema,
+			Name:             ts.Rel.Name,
+			Owner:            ts.Owner,
+			RowSecurity:      ts.RowSecurity,
+			ForceRowSecurity: ts.ForceRowSecurity,
+		}
+		for _, p := range ts.Policies {
+			t.Policies = append(t.Policies, &vet.Policy{
+				Name:       p.Name,
+				Command:    p.Command,
+				Permissive: p.Permissive,
+				Roles:      p.Roles,
+				HasUsing:   p.HasUsing,
+				HasCheck:   p.HasCheck,
+			})
+		}
+		tables = append(tables, t)
 	}
+	return tables
 }
 
 type vetEngineOutput struct {
@@ -0,0 +1,146 @@
+package docs
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"regexp"
+	"strings"
+)
+
+// Mermaid returns the entity-relationship diagram of a document in the
+// syntax of Mermaid's erDiagram.
+func Mermaid(doc *Doc) string {
+	var b strings.Builder
+	b.WriteString("erDiagram\n")
+	for _, t := range doc.Tables {
+		fmt.Fprintf(&b, "    %s {\n", mermaidEntity(t.Name))
+		for _, c := range t.Columns {
+			fmt.Fprintf(&b, "        %s %s", mermaidToken(c.Type), mermaidToken(c.Name))
+			if keys := c.Keys(); len(keys) > 0 {
+				fmt.Fprintf(&b, " %s", strings.Join(keys, ", "))
+			}
+			if c.Comment != "" {
+				fmt.Fprintf(&b, " %q", strings.ReplaceAll(oneLine(c.Comment), `"`, "'"))
+			}
+			b.WriteString("\n")
+		}
+		b.WriteString("    }\n")
+	}
+	for _, fk := range doc.Relations {
+		parent := "||"
+		if fk.Optional {
+			parent = "|o"
+		}
+		child := "o{"
+		if fk.Unique {
+			child = "o|"
+		}
+		fmt.Fprintf(&b, "    %s %s--%s %s : %q\n", mermaidEntity(fk.RefTable), parent, child, mermaidEntity(fk.Table), label(fk))
+	}
+	return b.String()
+}
+
+var mermaidName = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_-]*$`)
+
+// mermaidEntity returns the name of an entity, quoted when it has characters,
+// such as the dot of a qualified name, that Mermaid does not allow bare.
+func mermaidEntity(name string) string {
+	if mermaidName.MatchString(name) {
+		return name
+	}
+	return `"` + strings.ReplaceAll(name, `"`, "'") + `"`
+}
+
+var mermaidInvalid = regexp.MustCompile(`[^A-Za-z0-9_()\[\]-]+`)
+
+// mermaidToken returns an attribute type or name with the characters Mermaid
+// does not allow in one replaced by underscores.
+func mermaidToken(s string) string {
+	return mermaidInvalid.ReplaceAllString(s, "_")
+}
+
+// DOT returns the entity-relationship diagram of a document in the Graphviz
+// DOT language. A single-column foreign key connects the two columns, and
+// any other connects the two tables.
+func DOT(doc *Doc) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "digraph %s {\n", dotID(doc.Title))
+	b.WriteString("    rankdir=LR;\n")
+	b.WriteString("    node [shape=plaintext];\n")
+	for _, t := range doc.Tables {
+		fmt.Fprintf(&b, "    %s [label=<\n", dotID(t.Name))
+		b.WriteString(`        <TABLE BORDER="0" CELLBORDER="1" CELLSPACING="0">` + "\n")
+		fmt.Fprintf(&b, `            <TR><TD BGCOLOR="lightgrey" COLSPAN="2"><B>%s</B></TD></TR>`+"\n", html.EscapeString(t.Name))
+		for _, c := range t.Columns {
+			name := html.EscapeString(c.Name)
+			if keys := c.Keys(); len(keys) > 0 {
+				name += " (" + strings.Join(keys, ", ") + ")"
+			}
+			typ := html.EscapeString(c.Type)
+			if !c.NotNull {
+				typ += "?"
+			}
+			fmt.Fprintf(&b, `            <TR><TD PORT=%s ALIGN="LEFT">%s</TD><TD ALIGN="LEFT">%s</TD></TR>`+"\n", dotID(c.Name), name, typ)
+		}
+		b.WriteString("        </TABLE>\n")
+		b.WriteString("    >];\n")
+	}
+	for _, fk := range doc.Relations {
+		from, to := dotID(fk.Table), dotID(fk.RefTable)
+		if len(fk.Columns) == 1 && len(fk.RefColumns) == 1 {
+			from += ":" + dotID(fk.Columns[0])
+			to += ":" + dotID(fk.RefColumns[0])
+		}
+		style := ""
+		if fk.Optional {
+			style = ", style=dashed"
+		}
+		fmt.Fprintf(&b, "    %s -> %s [label=%s%s];\n", from, to, dotID(label(fk)), style)
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// dotID quotes a name as a DOT identifier.
+func dotID(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `\"`) + `"`
+}
+
+// label names a foreign key by its constraint name or its columns.
+func label(fk *ForeignKey) string {
+	if fk.Name != "" {
+		return fk.Name
+	}
+	return strings.Join(fk.Columns, ", ")
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// WriteMermaid writes the diagrams of docs to w, one after another.
+func WriteMermaid(w io.Writer, docs []*Doc) error {
+	for i, doc := range docs {
+		if i > 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, Mermaid(doc)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteDOT writes the graphs of docs to w. Graphviz draws each graph of a
+// file in turn.
+func WriteDOT(w io.Writer, docs []*Doc) error {
+	for _, doc := range docs {
+		if _, err := io.WriteString(w, DOT(doc)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,230 @@
+// Package docs generates documentation for the schema of a sql block: its
+// tables with their columns and keys, its enums, the queries that use each
+// table, and entity-relationship diagrams drawn from the foreign keys.
+package docs
+
+import (
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// Doc is the documentation of one sql block.
+type Doc struct {
+	Title  string
+	Tables []*Table
+	Enums  []*Enum
+	// Relations are the foreign keys of every table.
+	Relations []*ForeignKey
+}
+
+// Table is a table or view. Name is qualified with its schema unless the
+// table is in the default schema.
+type Table struct {
+	Name         string
+	Comment      string
+	Columns      []*Column
+	ForeignKeys  []*ForeignKey
+	ReferencedBy []*ForeignKey
+	// Queries are the names of the queries that read or write the table.
+	Queries []string
+}
+
+type Column struct {
+	Name       string
+	Type       string
+	NotNull    bool
+	Default    string
+	Comment    string
+	PrimaryKey bool
+	Unique     bool
+	ForeignKey bool
+}
+
+// Keys lists the kinds of key the column is part of, as PK, UK and FK.
+func (c *Column) Keys() []string {
+	var keys []string
+	if c.PrimaryKey {
+		keys = append(keys, "PK")
+	}
+	if c.Unique {
+		keys = append(keys, "UK")
+	}
+	if c.ForeignKey {
+		keys = append(keys, "FK")
+	}
+	return keys
+}
+
+// ForeignKey is a foreign key of Table that references RefTable.
+type ForeignKey struct {
+	Name       string
+	Table      string
+	Columns    []string
+	RefTable   string
+	RefColumns []string
+	// Optional is set when a row need not reference anything, because a
+	// column of the key is nullable.
+	Optional bool
+	// Unique is set when a row of RefTable is referenced by at most one
+	// row, because the key's columns are also a key of Table.
+	Unique bool
+}
+
+type Enum struct {
+	Name    string
+	Comment string
+	Values  []string
+}
+
+// systemSchemas hold the tables and types the database provides rather than
+// the schema declares.
+var systemSchemas = map[string]bool{
+	"pg_catalog":         true,
+	"information_schema": true,
+}
+
+// New builds the documentation of the catalog and queries of a generate
+// request.
+func New(title string, req *plugin.GenerateRequest) *Doc {
+	doc := &Doc{Title: title}
+	cat := req.GetCatalog()
+	if cat == nil {
+		return doc
+	}
+	name := func(id *plugin.Identifier) string {
+		if id.GetSchema() == "" || id.GetSchema() == cat.DefaultSchema {
+			return id.GetName()
+		}
+		return id.GetSchema() + "." + id.GetName()
+	}
+
+	tables := map[string]*Table{}
+	var defs []*plugin.Table
+	for _, schema := range cat.Schemas {
+		if systemSchemas[schema.Name] {
+			continue
+		}
+		for _, enum := range schema.Enums {
+			doc.Enums = append(doc.Enums, &Enum{
+				Name:    name(&plugin.Identifier{Schema: schema.Name, Name: enum.Name}),
+				Comment: enum.Comment,
+				Values:  enum.Vals,
+			})
+		}
+		for _, t := range schema.Tables {
+			table := &Table{
+				Name:    name(&plugin.Identifier{Schema: schema.Name, Name: t.Rel.Name}),
+				Comment: t.Comment,
+			}
+			for _, c := range t.Columns {
+				table.Columns = append(table.Columns, &Column{
+					Name:       c.Name,
+					Type:       columnType(c),
+					NotNull:    c.NotNull,
+					Default:    c.DefaultValue,
+					Comment:    c.Comment,
+					PrimaryKey: slices.Contains(t.PrimaryKey, c.Name),
+					Unique: slices.ContainsFunc(t.UniqueKeys, func(key *plugin.UniqueKey) bool {
+						return slices.Contains(key.Columns, c.Name)
+					}),
+				})
+			}
+			doc.Tables = append(doc.Tables, table)
+			tables[table.Name] = table
+			defs = append(defs, t)
+		}
+	}
+
+	for i, t := range defs {
+		table := doc.Tables[i]
+		for _, fk := range t.ForeignKeys {
+			rel := &ForeignKey{
+				Name:       fk.Name,
+				Table:      table.Name,
+				Columns:    fk.Columns,
+				RefTable:   name(fk.RefTable),
+				RefColumns: fk.RefColumns,
+				Unique:     isKey(t, fk.Columns),
+			}
+			ref := tables[rel.RefTable]
+			if len(rel.RefColumns) == 0 && ref != nil {
+				for _, c := range ref.Columns {
+					if c.PrimaryKey {
+						rel.RefColumns = append(rel.RefColumns, c.Name)
+					}
+				}
+			}
+			for _, c := range table.Columns {
+				if slices.Contains(fk.Columns, c.Name) {
+					c.ForeignKey = true
+					rel.Optional = rel.Optional || !c.NotNull
+				}
+			}
+			table.ForeignKeys = append(table.ForeignKeys, rel)
+			if ref != nil {
+				ref.ReferencedBy = append(ref.ReferencedBy, rel)
+			}
+			doc.Relations = append(doc.Relations, rel)
+		}
+	}
+
+	for _, q := range req.Queries {
+		for _, id := range q.Tables {
+			if t := tables[name(id)]; t != nil && !slices.Contains(t.Queries, q.Name) {
+				t.Queries = append(t.Queries, q.Name)
+			}
+		}
+	}
+
+	sort.SliceStable(doc.Tables, func(i, j int) bool {
+		return doc.Tables[i].Name < doc.Tables[j].Name
+	})
+	sort.SliceStable(doc.Enums, func(i, j int) bool {
+		return doc.Enums[i].Name < doc.Enums[j].Name
+	})
+	return doc
+}
+
+// columnType names the type of a column the way the schema spells it, leaving
+// out the schema of built-in types.
+func columnType(c *plugin.Column) string {
+	typ := c.Type.GetName()
+	if schema := c.Type.GetSchema(); schema != "" && !systemSchemas[schema] {
+		typ = schema + "." + typ
+	}
+	if c.Length > 0 && lengthTypes[strings.ToLower(typ)] {
+		typ = typ + "(" + strconv.Itoa(int(c.Length)) + ")"
+	}
+	dims := int(c.ArrayDims)
+	if c.IsArray && dims == 0 {
+		dims = 1
+	}
+	return typ + strings.Repeat("[]", dims)
+}
+
+// lengthTypes are the types whose length is part of the type's name.
+var lengthTypes = map[string]bool{
+	"char":      true,
+	"varchar":   true,
+	"binary":    true,
+	"varbinary": true,
+}
+
+// isKey reports whether columns are the primary key or a unique key of t.
+func isKey(t *plugin.Table, columns []string) bool {
+	same := func(key []string) bool {
+		return len(key) > 0 && len(key) == len(columns) && !slices.ContainsFunc(columns, func(c string) bool {
+			return !slices.Contains(key, c)
+		})
+	}
+	if same(t.PrimaryKey) {
+		return true
+	}
+	return slices.ContainsFunc(t.UniqueKeys, func(key *plugin.UniqueKey) bool {
+		return same(key.Columns)
+	})
+}
@@ -0,0 +1,127 @@
+package docs
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	ejson "encoding/json"
+	"fmt"
+	htemplate "html/template"
+	"io"
+	"slices"
+	"strings"
+	"text/template"
+
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+var funcMap = map[string]any{
+	"mermaid": Mermaid,
+	"join":    strings.Join,
+	"cell":    cell,
+	"codes":   codes,
+}
+
+var (
+	markdownTmpl = template.Must(template.New("").Funcs(funcMap).ParseFS(templates, "templates/markdown.tmpl"))
+	htmlTmpl     = htemplate.Must(htemplate.New("").Funcs(funcMap).ParseFS(templates, "templates/html.tmpl"))
+)
+
+type page struct {
+	Docs    []*Doc
+	Mermaid bool
+}
+
+// WriteMarkdown writes docs to w as one Markdown document, with each
+// document's Mermaid diagram when mermaid is set.
+func WriteMarkdown(w io.Writer, docs []*Doc, mermaid bool) error {
+	return markdownTmpl.ExecuteTemplate(w, "markdown", page{Docs: docs, Mermaid: mermaid})
+}
+
+// WriteHTML writes docs to w as one HTML page, with each document's Mermaid
+// diagram when mermaid is set. The page loads Mermaid to draw the diagrams.
+func WriteHTML(w io.Writer, docs []*Doc, mermaid bool) error {
+	return htmlTmpl.ExecuteTemplate(w, "html", page{Docs: docs, Mermaid: mermaid})
+}
+
+// cell escapes text for a cell of a Markdown table.
+func cell(s string) string {
+	return strings.ReplaceAll(oneLine(s), "|", `\|`)
+}
+
+// codes formats names as a list of Markdown code spans.
+func codes(names []string) string {
+	quoted := make([]string, len(names))
+	for i, name := range names {
+		quoted[i] = "`" + name + "`"
+	}
+	return strings.Join(quoted, ", ")
+}
+
+func parseOptions(req *plugin.GenerateRequest) (*opts, error) {
+	options := new(opts)
+	if len(req.PluginOptions) > 0 {
+		dec := ejson.NewDecoder(bytes.NewReader(req.PluginOptions))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(options); err != nil {
+			return options, fmt.Errorf("unmarshalling options: %s", err)
+		}
+	}
+	if options.Format == "" {
+		options.Format = "markdown"
+	}
+	if options.Format != "markdown" && options.Format != "html" {
+		return options, fmt.Errorf("invalid options: unknown format %q (use markdown or html)", options.Format)
+	}
+	if options.Diagrams == nil {
+		options.Diagrams = []string{"mermaid"}
+	}
+	for _, d := range options.Diagrams {
+		if d != "mermaid" && d != "dot" {
+			return options, fmt.Errorf("invalid options: unknown diagram %q (use mermaid or dot)", d)
+		}
+	}
+	if options.Filename == "" {
+		options.Filename = "schema"
+	}
+	if options.Title == "" {
+		options.Title = "Schema"
+	}
+	return options, nil
+}
+
+// Generate writes the documentation of a sql block, in Markdown or HTML with
+// a Mermaid diagram, and optionally a Graphviz diagram next to it.
+func Generate(ctx context.Context, req *plugin.GenerateRequest) (*plugin.GenerateResponse, error) {
+	options, err := parseOptions(req)
+	if err != nil {
+		return nil, err
+	}
+	doc := New(options.Title, req)
+	mermaid := slices.Contains(options.Diagrams, "mermaid")
+
+	var b bytes.Buffer
+	name := options.Filename + ".md"
+	if options.Format == "html" {
+		name = options.Filename + ".html"
+		err = WriteHTML(&b, []*Doc{doc}, mermaid)
+	} else {
+		err = WriteMarkdown(&b, []*Doc{doc}, mermaid)
+	}
+	if err != nil {
+		return nil, err
+	}
+	resp := &plugin.GenerateResponse{
+		Files: []*plugin.File{{Name: name, Contents: b.Bytes()}},
+	}
+	if slices.Contains(options.Diagrams, "dot") {
+		resp.Files = append(resp.Files, &plugin.File{
+			Name:     options.Filename + ".dot",
+			Contents: []byte(DOT(doc)),
+		})
+	}
+	return resp, nil
+}
@@ -0,0 +1,11 @@
+package docs
+
+type opts struct {
+	// Format is markdown or html.
+	Format string `json:"format,omitempty"`
+	// Diagrams are the diagrams to draw: mermaid, which is embedded in the
+	// document, and dot, which is written to a file of its own.
+	Diagrams []string `json:"diagrams,omitempty"`
+	Filename string   `json:"filename,omitempty"`
+	Title    string   `json:"title,omitempty"`
+}
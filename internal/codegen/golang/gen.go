@@ -39,8 +39,13 @@ type tmplCtx struct {
 	EmitMethodsWithDBArgument bool
 	EmitEnumValidMethod       bool
 	EmitAllEnumValues         bool
+	EmitInstrumentation       bool
+	EmitReadWriteSplit        bool
+	PgRangeTypes              []pgRangeType
 	UsesCopyFrom              bool
 	UsesBatch                 bool
+	UsesPaginate              bool
+	UsesBulk                  bool
 	OmitSqlcVersion           bool
 	BuildTags                 string
 	WrapErrors                bool
@@ -63,11 +68,23 @@ func (t *tmplCtx) codegenEmitPreparedQueries() bool {
 	return t.EmitPreparedQueries
 }
 
-func (t *tmplCtx) codegenQueryMethod(q Query) string {
-	db := "q.db"
-	if t.EmitMethodsWithDBArgument {
-		db = "db"
+// codegenDB returns the DBTX a query runs on: the method's db argument, the
+// reader or writer of a read/write split, or the Queries' only handle.
+func (t *tmplCtx) codegenDB(q Query) string {
+	switch {
+	case t.EmitMethodsWithDBArgument:
+		return "db"
+	case t.EmitReadWriteSplit && q.ReadOnly:
+		return "q.reader"
+	case t.EmitReadWriteSplit:
+		return "q.writer"
+	default:
+		return "q.db"
 	}
+}
+
+func (t *tmplCtx) codegenQueryMethod(q Query) string {
+	db := t.codegenDB(q)
 
 	switch q.Cmd {
 	case ":one":
@@ -76,7 +93,7 @@ func (t *tmplCtx) codegenQueryMethod(q Query) string {
 		}
 		return db + ".QueryRowContext"
 
-	case ":many":
+	case ":many", ":paginate":
 		if t.EmitPreparedQueries {
 			return "q.query"
 		}
@@ -94,7 +111,7 @@ func (t *tmplCtx) codegenQueryRetval(q Query) (string, error) {
 	switch q.Cmd {
 	case ":one":
 		return "row :=", nil
-	case ":many":
+	case ":many", ":paginate":
 		return "rows, err :=", nil
 	case ":exec":
 		return "_, err :=", nil
@@ -120,6 +137,10 @@ func Generate(ctx context.Context, req *plugin.GenerateRequest) (*plugin.Generat
 		return nil, err
 	}
 
+	if options.EmitPgHelperTypes && req.Settings.Engine != "postgresql" {
+		return nil, fmt.Errorf("emit_pg_helper_types is only supported by the postgresql engine")
+	}
+
 	enums := buildEnums(req, options)
 	structs := buildStructs(req, options)
 	queries, err := buildQueries(req, options, enums, structs)
@@ -138,6 +159,10 @@ func Generate(ctx context.Context, req *plugin.GenerateRequest) (*plugin.Generat
 	return generate(req, options, enums, structs, queries)
 }
 
+// instrumentationNames are the package-level identifiers declared by the
+// instrumentation file.
+var instrumentationNames = []string{"Instrumentation", "QueryEvent", "Instrument"}
+
 func validate(options *opts.Options, enums []Enum, structs []Struct, queries []Query) error {
 	enumNames := make(map[string]struct{})
 	for _, enum := range enums {
@@ -151,6 +176,30 @@ func validate(options *opts.Options, enums []Enum, structs []Struct, queries []Q
 		}
 		structNames[struckt.Name] = struct{}{}
 	}
+	if options.EmitInstrumentation {
+		for _, name := range instrumentationNames {
+			if _, ok := enumNames[name]; ok {
+				return fmt.Errorf("instrumentation type name conflicts with enum name: %s", name)
+			}
+			if _, ok := structNames[name]; ok {
+				return fmt.Errorf("instrumentation type name conflicts with struct name: %s", name)
+			}
+		}
+	}
+	if options.EmitPgHelperTypes {
+		names := slices.Clone(pgHelperNames)
+		for _, name := range pgHelperTypes {
+			names = append(names, name)
+		}
+		for _, name := range names {
+			if _, ok := enumNames[name]; ok {
+				return fmt.Errorf("PostgreSQL helper type name conflicts with enum name: %s", name)
+			}
+			if _, ok := structNames[name]; ok {
+				return fmt.Errorf("PostgreSQL helper type name conflicts with struct name: %s", name)
+			}
+		}
+	}
 	if !options.EmitExportedQueries {
 		return nil
 	}
@@ -183,8 +232,12 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		EmitMethodsWithDBArgument: options.EmitMethodsWithDbArgument,
 		EmitEnumValidMethod:       options.EmitEnumValidMethod,
 		EmitAllEnumValues:         options.EmitAllEnumValues,
+		EmitInstrumentation:       options.EmitInstrumentation,
+		EmitReadWriteSplit:        options.EmitReadWriteSplit,
 		UsesCopyFrom:              usesCopyFrom(queries),
 		UsesBatch:                 usesBatch(queries),
+		UsesPaginate:              usesPaginate(queries),
+		UsesBulk:                  usesBulk(queries),
 		SQLDriver:                 parseDriver(options.SqlPackage),
 		Q:                         "`",
 		Package:                   options.Package,
@@ -197,6 +250,10 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		WrapErrors:                options.WrapErrors,
 	}
 
+	if options.EmitPgHelperTypes {
+		tctx.PgRangeTypes = pgRangeTypes
+	}
+
 	if tctx.UsesCopyFrom && !tctx.SQLDriver.IsPGX() && options.SqlDriver != opts.SQLDriverGoSQLDriverMySQL {
 		return nil, errors.New(":copyfrom is only supported by pgx and github.com/go-sql-driver/mysql")
 	}
@@ -223,6 +280,7 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 		// These methods are Go specific, they do not belong in the codegen package
 		// (as that is language independent)
 		"dbarg":               tctx.codegenDbarg,
+		"db":                  tctx.codegenDB,
 		"emitPreparedQueries": tctx.codegenEmitPreparedQueries,
 		"queryMethod":         tctx.codegenQueryMethod,
 		"queryRetval":         tctx.codegenQueryRetval,
@@ -291,6 +349,7 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 	if options.OutputBatchFileName != "" {
 		batchFileName = options.OutputBatchFileName
 	}
+	instrumentationFileName := "instrumentation.go"
 
 	if err := execute(dbFileName, "dbFile"); err != nil {
 		return nil, err
@@ -305,6 +364,16 @@ func generate(req *plugin.GenerateRequest, options *opts.Options, enums []Enum,
 			return nil, err
 		}
 	}
+	if options.EmitInstrumentation {
+		if err := execute(instrumentationFileName, "instrumentationFile"); err != nil {
+			return nil, err
+		}
+	}
+	if options.EmitPgHelperTypes {
+		if err := execute(pgHelperTypesFileName, "pgHelperTypesFile"); err != nil {
+			return nil, err
+		}
+	}
 	if tctx.UsesCopyFrom {
 		if err := execute(copyfromFileName, "copyfromFile"); err != nil {
 			return nil, err
@@ -424,3 +493,21 @@ func filterUnusedStructs(enums []Enum, structs []Struct, queries []Query, qualif
 
 	return keepEnums, keepStructs
 }
+
+func usesPaginate(queries []Query) bool {
+	for _, q := range queries {
+		if q.Cmd == metadata.CmdPaginate {
+			return true
+		}
+	}
+	return false
+}
+
+func usesBulk(queries []Query) bool {
+	for _, q := range queries {
+		if q.Cmd == metadata.CmdBulkExec {
+			return true
+		}
+	}
+	return false
+}
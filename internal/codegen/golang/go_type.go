@@ -1,9 +1,12 @@
 package golang
 
 import (
+	"go/types"
 	"maps"
 	"strings"
 
+	"google.golang.org/protobuf/proto"
+
 	"github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
 	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
 	"github.com/sqlc-dev/sqlc/internal/plugin"
@@ -36,7 +39,40 @@ func addExtraGoStructTags(tags map[string]string, req *plugin.GenerateRequest, o
 	}
 }
 
+// annotatedGoType returns the Go type an @param or @column annotation names,
+// or nil if it names a database type instead. A Go type outside the standard
+// library is written with its full import path, so anything else is only Go
+// if it is a basic type or a standard library type sqlc already knows.
+func annotatedGoType(spec string) *opts.ParsedGoType {
+	if !strings.Contains(spec, "/") {
+		if _, std := stdlibTypes[strings.TrimPrefix(spec, "*")]; !std && types.Universe.Lookup(spec) == nil {
+			return nil
+		}
+	}
+	parsed, err := opts.ParseGoType(spec)
+	if err != nil {
+		return nil
+	}
+	return parsed
+}
+
 func goType(req *plugin.GenerateRequest, options *opts.Options, col *plugin.Column) string {
+	// An annotation on the query itself is the most specific override there is
+	if col.TypeOverride != "" {
+		if gt := annotatedGoType(col.TypeOverride); gt != nil {
+			if col.IsSqlcSlice {
+				return "[]" + gt.TypeName
+			}
+			return gt.TypeName
+		}
+		col = proto.Clone(col).(*plugin.Column)
+		col.Type = &plugin.Identifier{Name: col.TypeOverride}
+		if schema, name, ok := strings.Cut(col.TypeOverride, "."); ok {
+			col.Type = &plugin.Identifier{Schema: schema, Name: name}
+		}
+		col.TypeOverride = ""
+	}
+
 	// Check if the column's type has been overridden
 	for _, override := range options.Overrides {
 		oride := override.ShimOverride
@@ -2,6 +2,7 @@ package golang
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
 
@@ -101,6 +102,7 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 	if i.Options.OutputBatchFileName != "" {
 		batchFileName = i.Options.OutputBatchFileName
 	}
+	instrumentationFileName := "instrumentation.go"
 
 	switch filename {
 	case dbFileName:
@@ -113,6 +115,10 @@ func (i *importer) Imports(filename string) [][]ImportSpec {
 		return mergeImports(i.copyfromImports())
 	case batchFileName:
 		return mergeImports(i.batchImports())
+	case instrumentationFileName:
+		return mergeImports(i.instrumentationImports())
+	case pgHelperTypesFileName:
+		return mergeImports(i.pgHelperTypesImports())
 	default:
 		return mergeImports(i.queryImports(filename))
 	}
@@ -139,6 +145,17 @@ func (i *importer) dbImports() fileImports {
 		}
 	}
 
+	if usesBulk(i.Queries) {
+		std = append(std, ImportSpec{Path: "strconv"}, ImportSpec{Path: "strings"})
+	}
+
+	if usesPaginate(i.Queries) {
+		std = append(std, ImportSpec{Path: "encoding/base64"}, ImportSpec{Path: "encoding/json"})
+		if !slices.Contains(std, ImportSpec{Path: "fmt"}) {
+			std = append(std, ImportSpec{Path: "fmt"})
+		}
+	}
+
 	sort.Slice(std, func(i, j int) bool { return std[i].Path < std[j].Path })
 	sort.Slice(pkg, func(i, j int) bool { return pkg[i].Path < pkg[j].Path })
 	return fileImports{Std: std, Dep: pkg}
@@ -243,6 +260,21 @@ func buildImports(options *opts.Options, queries []Query, uses func(string) bool
 		}
 	}
 
+	// Types named by the queries' own @param and @column annotations
+	for _, q := range queries {
+		for _, gt := range q.annotatedTypes() {
+			if gt.BasicType || gt.ImportPath == "" {
+				continue
+			}
+			if _, alreadyImported := std[gt.ImportPath]; alreadyImported {
+				continue
+			}
+			if uses(gt.TypeName) {
+				pkg[ImportSpec{Path: gt.ImportPath, ID: gt.Package}] = struct{}{}
+			}
+		}
+	}
+
 	// Models package import. When models live in a separate Go package and
 	// any type in this file references a qualified model type, import the
 	// models package under a fixed `models` alias so query files always
@@ -258,6 +290,21 @@ func buildImports(options *opts.Options, queries []Query, uses func(string) bool
 }
 
 func (i *importer) interfaceImports() fileImports {
+	std, pkg := i.querierImports()
+	return sortedImports(std, pkg)
+}
+
+// instrumentationImports matches the Querier interface, plus the time package
+// used to measure each call.
+func (i *importer) instrumentationImports() fileImports {
+	std, pkg := i.querierImports()
+	std["time"] = struct{}{}
+	return sortedImports(std, pkg)
+}
+
+// querierImports collects the imports needed to declare the methods of the
+// Querier interface.
+func (i *importer) querierImports() (map[string]struct{}, map[ImportSpec]struct{}) {
 	std, pkg := buildImports(i.Options, i.Queries, func(name string) bool {
 		for _, q := range i.Queries {
 			if q.hasRetType() {
@@ -279,7 +326,7 @@ func (i *importer) interfaceImports() fileImports {
 
 	std["context"] = struct{}{}
 
-	return sortedImports(std, pkg)
+	return std, pkg
 }
 
 func (i *importer) modelImports() fileImports {
@@ -336,6 +383,14 @@ func (i *importer) queryImports(filename string) fileImports {
 					return true
 				}
 			}
+			// Check the fields of the cursor struct of a :paginate query
+			if q.Page != nil {
+				for _, f := range q.Page.Fields {
+					if hasPrefixIgnoringSliceAndPointerPrefix(f.Type, name) {
+						return true
+					}
+				}
+			}
 			// Check the fields of the argument struct if it's emitted
 			if q.Arg.EmitStruct() {
 				for _, f := range q.Arg.Struct.Fields {
@@ -66,6 +66,12 @@ func (o *GoType) UnmarshalYAML(unmarshal func(any) error) error {
 	return nil
 }
 
+// ParseGoType parses a Go type written the way a go_type override's string
+// form is, e.g. `github.com/segmentio/ksuid.KSUID`.
+func ParseGoType(spec string) (*ParsedGoType, error) {
+	return GoType{Spec: spec}.parse()
+}
+
 var validIdentifier = regexp.MustCompile(`^[a-zA-Z0-9_]+$`)
 var versionNumber = regexp.MustCompile(`^v[0-9]+$`)
 var invalidIdentifier = regexp.MustCompile(`[^a-zA-Z0-9_]`)
@@ -27,6 +27,9 @@ type Options struct {
 	EmitEnumValidMethod          bool              `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
 	EmitAllEnumValues            bool              `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
 	EmitSqlAsComment             bool              `json:"emit_sql_as_comment,omitempty" yaml:"emit_sql_as_comment"`
+	EmitInstrumentation          bool              `json:"emit_instrumentation,omitempty" yaml:"emit_instrumentation"`
+	EmitReadWriteSplit           bool              `json:"emit_read_write_split,omitempty" yaml:"emit_read_write_split"`
+	EmitPgHelperTypes            bool              `json:"emit_pg_helper_types,omitempty" yaml:"emit_pg_helper_types"`
 	JsonTagsCaseStyle            string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	Package                      string            `json:"package" yaml:"package"`
 	Out                          string            `json:"out" yaml:"out"`
@@ -165,6 +168,21 @@ func ValidateOpts(opts *Options) error {
 	if opts.EmitMethodsWithDbArgument && opts.EmitPreparedQueries {
 		return fmt.Errorf("invalid options: emit_methods_with_db_argument and emit_prepared_queries options are mutually exclusive")
 	}
+	if opts.EmitInstrumentation && !opts.EmitInterface {
+		return fmt.Errorf("invalid options: emit_instrumentation requires emit_interface")
+	}
+	if opts.EmitReadWriteSplit && opts.EmitMethodsWithDbArgument {
+		return fmt.Errorf("invalid options: emit_read_write_split and emit_methods_with_db_argument options are mutually exclusive")
+	}
+	if opts.EmitReadWriteSplit && opts.EmitPreparedQueries {
+		return fmt.Errorf("invalid options: emit_read_write_split and emit_prepared_queries options are mutually exclusive")
+	}
+	if opts.EmitPgHelperTypes && (opts.SqlPackage == SQLPackagePGXV4 || opts.SqlPackage == SQLPackagePGXV5) {
+		return fmt.Errorf("invalid options: emit_pg_helper_types is only supported by database/sql; pgx has its own types for these columns")
+	}
+	if opts.EmitPgHelperTypes && opts.OutputModelsImport != "" {
+		return fmt.Errorf("invalid options: emit_pg_helper_types and output_models_import options are mutually exclusive")
+	}
 	if *opts.QueryParameterLimit < 0 {
 		return fmt.Errorf("invalid options: query parameter limit must not be negative")
 	}
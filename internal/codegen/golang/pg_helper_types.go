@@ -0,0 +1,78 @@
+package golang
+
+import "github.com/sqlc-dev/sqlc/internal/codegen/golang/opts"
+
+// pgHelperTypesFileName is the file emit_pg_helper_types writes the helper
+// types to.
+const pgHelperTypesFileName = "pgtypes.go"
+
+// pgRangeType describes a range type and its multirange, which the helper
+// types template declares one pair of types for.
+type pgRangeType struct {
+	Name         string
+	MultiName    string
+	SQLName      string
+	SQLMultiName string
+	Elem         string
+	Parse        string
+	Format       string
+}
+
+var pgRangeTypes = []pgRangeType{
+	{"PgInt4Range", "PgInt4Multirange", "int4range", "int4multirange", "int32", "parsePgInt4", "formatPgInt4"},
+	{"PgInt8Range", "PgInt8Multirange", "int8range", "int8multirange", "int64", "parsePgInt8", "formatPgInt8"},
+	{"PgNumRange", "PgNumMultirange", "numrange", "nummultirange", "string", "parsePgNumeric", "formatPgNumeric"},
+	{"PgDateRange", "PgDateMultirange", "daterange", "datemultirange", "time.Time", "parsePgDate", "formatPgDate"},
+	{"PgTsRange", "PgTsMultirange", "tsrange", "tsmultirange", "time.Time", "parsePgTimestamp", "formatPgTimestamp"},
+	{"PgTstzRange", "PgTstzMultirange", "tstzrange", "tstzmultirange", "time.Time", "parsePgTimestamptz", "formatPgTimestamptz"},
+}
+
+// pgHelperTypes maps the PostgreSQL types the helper types cover to the Go
+// type used for them. Each helper type has a Valid field, so the same type is
+// used for nullable and NOT NULL columns.
+var pgHelperTypes = func() map[string]string {
+	m := map[string]string{
+		"point":               "PgPoint",
+		"line":                "PgLine",
+		"lseg":                "PgLseg",
+		"box":                 "PgBox",
+		"path":                "PgPath",
+		"polygon":             "PgPolygon",
+		"circle":              "PgCircle",
+		"tsvector":            "PgTSVector",
+		"pg_catalog.tsvector": "PgTSVector",
+		"tsquery":             "PgTSQuery",
+		"pg_catalog.tsquery":  "PgTSQuery",
+	}
+	for _, rt := range pgRangeTypes {
+		m[rt.SQLName] = rt.Name
+		m[rt.SQLMultiName] = rt.MultiName
+	}
+	return m
+}()
+
+// pgHelperNames are the exported identifiers declared by the helper types
+// file, besides the types in pgHelperTypes.
+var pgHelperNames = []string{
+	"PgBoundType", "PgInclusive", "PgExclusive", "PgUnbounded", "PgInfinite", "PgEmpty",
+	"PgVec2", "PgTSLexeme", "PgTSPosition",
+}
+
+// pgHelperType returns the helper type for a PostgreSQL type, if there is one.
+func pgHelperType(options *opts.Options, columnType string) (string, bool) {
+	if !options.EmitPgHelperTypes {
+		return "", false
+	}
+	name, ok := pgHelperTypes[columnType]
+	return name, ok
+}
+
+func (i *importer) pgHelperTypesImports() fileImports {
+	return fileImports{Std: []ImportSpec{
+		{Path: "database/sql/driver"},
+		{Path: "fmt"},
+		{Path: "strconv"},
+		{Path: "strings"},
+		{Path: "time"},
+	}}
+}
@@ -0,0 +1,53 @@
+package golang
+
+import (
+	"context"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// TestPgHelperTypes generates the helper types emit_pg_helper_types adds and
+// runs the tests in testdata/pghelpertypes against them, in a module of their
+// own, since the generated code is not part of this one.
+func TestPgHelperTypes(t *testing.T) {
+	gobin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go command not found")
+	}
+	resp, err := Generate(context.Background(), &plugin.GenerateRequest{
+		Settings:      &plugin.Settings{Engine: "postgresql"},
+		Catalog:       &plugin.Catalog{DefaultSchema: "public"},
+		PluginOptions: []byte(`{"package": "pgtypes", "emit_pg_helper_types": true}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir := t.TempDir()
+	files := map[string][]byte{"go.mod": []byte("module pgtypes\n\ngo 1.21\n")}
+	for _, f := range resp.Files {
+		if f.Name == pgHelperTypesFileName {
+			files[f.Name] = f.Contents
+		}
+	}
+	if files[pgHelperTypesFileName] == nil {
+		t.Fatalf("%s was not generated", pgHelperTypesFileName)
+	}
+	if files["roundtrip_test.go"], err = os.ReadFile(filepath.Join("testdata", "pghelpertypes", "roundtrip_test.go")); err != nil {
+		t.Fatal(err)
+	}
+	for name, contents := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), contents, 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	cmd := exec.Command(gobin, "test", "-count=1", ".")
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "GOWORK=off", "GOFLAGS=-mod=mod")
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("go test: %s\n%s", err, out)
+	}
+}
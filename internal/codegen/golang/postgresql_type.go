@@ -43,6 +43,9 @@ func postgresType(req *plugin.GenerateRequest, options *opts.Options, col *plugi
 	if options.EmitPointersForNullEnumTypes != nil {
 		emitPointersForNullEnums = driver.IsPGX() && *options.EmitPointersForNullEnumTypes
 	}
+	if name, ok := pgHelperType(options, columnType); ok {
+		return name
+	}
 
 	switch columnType {
 	case "serial", "serial4", "pg_catalog.serial4":
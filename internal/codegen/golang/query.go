@@ -56,6 +56,16 @@ func (v QueryValue) Pair() string {
 	return strings.Join(out, ",")
 }
 
+// CallArgs returns the argument names from Pair, for forwarding a call to
+// another query method.
+func (v QueryValue) CallArgs() string {
+	var out []string
+	for _, arg := range v.Pairs() {
+		out = append(out, arg.Name)
+	}
+	return strings.Join(out, ",")
+}
+
 // Return the argument name and type for query methods. Should only be used in
 // the context of method arguments.
 func (v QueryValue) Pairs() []Argument {
@@ -136,8 +146,12 @@ func (v QueryValue) UniqueFields() []Field {
 }
 
 func (v QueryValue) Params() string {
+	return joinParams(v.paramList())
+}
+
+func (v QueryValue) paramList() []string {
 	if v.isEmpty() {
-		return ""
+		return nil
 	}
 	var out []string
 	if v.Struct == nil {
@@ -155,6 +169,10 @@ func (v QueryValue) Params() string {
 			}
 		}
 	}
+	return out
+}
+
+func joinParams(out []string) string {
 	if len(out) <= 3 {
 		return strings.Join(out, ",")
 	}
@@ -162,6 +180,15 @@ func (v QueryValue) Params() string {
 	return "\n" + strings.Join(out, ",\n")
 }
 
+// ElemParams returns the arguments for one record of a :bulkexec query, read
+// from the loop variable name.
+func (v QueryValue) ElemParams(name string) string {
+	elem := v
+	elem.Name = name
+	elem.Emit = true
+	return strings.Join(elem.paramList(), ", ")
+}
+
 func (v QueryValue) ColumnNames() []string {
 	if v.Struct == nil {
 		return []string{v.DBName}
@@ -279,14 +306,110 @@ type Query struct {
 	Arg          QueryValue
 	// Used for :copyfrom
 	Table *plugin.Identifier
+	// Used for :paginate
+	Page *Page
+	// Used for :bulkexec: the parameters of one record, and the most records
+	// one statement can insert within the engine's parameter limit.
+	BulkWidth int
+	BulkRows  int
+	// Used by emit_read_write_split to send the query to the reader
+	ReadOnly bool
+}
+
+// BulkReturning reports whether a :bulkexec query collects RETURNING rows.
+func (q Query) BulkReturning() bool {
+	return q.Cmd == metadata.CmdBulkExec && !q.Ret.isEmpty()
+}
+
+// Page describes the cursor of a :paginate query. The cursor holds the sort
+// keys of the last row of a page, so the next page can start after it.
+type Page struct {
+	// CursorType is the unexported struct the cursor is encoded from.
+	CursorType string
+	Fields     []Field
+	// Keys read each field's value from the last row, named "last".
+	Keys []string
+	// CursorName and LimitName are the method's extra parameters, renamed
+	// if they collide with the query's own.
+	CursorName string
+	LimitName  string
+}
+
+// PagePair returns the method arguments of a :paginate query: the query's
+// own, then the cursor and the page size.
+func (q Query) PagePair() string {
+	out := []string{q.Page.CursorName + " string", q.Page.LimitName + " int32"}
+	if pair := q.Arg.Pair(); pair != "" {
+		out = append([]string{pair}, out...)
+	}
+	return strings.Join(out, ",")
+}
+
+// PageCallArgs returns the argument names from PagePair, for forwarding a
+// call to another query method.
+func (q Query) PageCallArgs() string {
+	out := []string{q.Page.CursorName, q.Page.LimitName}
+	if args := q.Arg.CallArgs(); args != "" {
+		out = append([]string{args}, out...)
+	}
+	return strings.Join(out, ",")
+}
+
+// Params returns the arguments passed to the database for the query. A
+// :paginate query passes the first-page flag, the cursor keys and the page
+// size after the query's own, in the order the rewritten SQL expects.
+func (q Query) Params() string {
+	out := q.Arg.paramList()
+	if q.Page != nil {
+		out = append(out, q.Page.CursorName+` == ""`)
+		for _, f := range q.Page.Fields {
+			out = append(out, "after."+f.Name)
+		}
+		out = append(out, q.Page.LimitName)
+	}
+	return joinParams(out)
+}
+
+// NextCursor returns the literal of the cursor that follows the row "last".
+func (q Query) NextCursor() string {
+	var out []string
+	for i, f := range q.Page.Fields {
+		out = append(out, f.Name+": "+q.Page.Keys[i])
+	}
+	return q.Page.CursorType + "{" + strings.Join(out, ", ") + "}"
 }
 
 func (q Query) hasRetType() bool {
 	scanned := q.Cmd == metadata.CmdOne || q.Cmd == metadata.CmdMany ||
-		q.Cmd == metadata.CmdBatchMany || q.Cmd == metadata.CmdBatchOne
+		q.Cmd == metadata.CmdBatchMany || q.Cmd == metadata.CmdBatchOne ||
+		q.Cmd == metadata.CmdPaginate || q.Cmd == metadata.CmdBulkExec
 	return scanned && !q.Ret.isEmpty()
 }
 
+// annotatedTypes returns the Go types named by @param and @column annotations
+// on the query's arguments and results.
+func (q Query) annotatedTypes() []*opts.ParsedGoType {
+	var out []*opts.ParsedGoType
+	for _, v := range []QueryValue{q.Arg, q.Ret} {
+		cols := []*plugin.Column{v.Column}
+		if v.Struct != nil {
+			cols = cols[:0]
+			for _, f := range v.Struct.Fields {
+				cols = append(cols, f.Column)
+			}
+		}
+		for _, col := range cols {
+			if col == nil || col.TypeOverride == "" {
+				continue
+			}
+			if gt := annotatedGoType(col.TypeOverride); gt != nil {
+				out = append(out, gt)
+			}
+		}
+	}
+	return out
+}
+
 func (q Query) TableIdentifierAsGoSlice() string {
 	escapedNames := make([]string, 0, 3)
 	for _, p := range []string{q.Table.Catalog, q.Table.Schema, q.Table.Name} {
@@ -81,7 +81,7 @@ func buildStructs(req *plugin.GenerateRequest, options *opts.Options) []Struct {
 				})
 			}
 			s := Struct{
-				Table:   &plugin.Identifier{Schema: schema.Name, Name: table.Rel.Name},
+				Table:   &plugin.Identifier{Catalog: table.Rel.Catalog, Schema: schema.Name, Name: table.Rel.Name},
 				Name:    StructName(structName, options),
 				Comment: table.Comment,
 				IsModel: true,
@@ -226,6 +226,7 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, enums []En
 			SQL:          query.Text,
 			Comments:     comments,
 			Table:        query.InsertIntoTable,
+			ReadOnly:     query.ReadOnly,
 		}
 		sqlpkg := parseDriver(options.SqlPackage)
 
@@ -264,7 +265,7 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, enums []En
 
 			// if query params is 2, and query params limit is 4 AND this is a copyfrom, we still want to emit the query's model
 			// otherwise we end up with a copyfrom using a struct without the struct definition
-			if len(query.Params) <= qpl && query.Cmd != ":copyfrom" {
+			if len(query.Params) <= qpl && query.Cmd != ":copyfrom" && query.Cmd != metadata.CmdBulkExec {
 				gq.Arg.Emit = false
 			}
 		}
@@ -297,6 +298,7 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, enums []En
 				Typ:            qualifyType(goType(req, options, c), models, qualifier),
 				SQLDriver:      sqlpkg,
 				ModelQualifier: qualifier,
+				Column:         c,
 			}
 		} else if putOutColumns(query) {
 			var gs *Struct
@@ -348,20 +350,86 @@ func buildQueries(req *plugin.GenerateRequest, options *opts.Options, enums []En
 			}
 		}
 
+		if query.Pagination != nil {
+			gq.Page = buildPage(gq, query, options)
+		}
+		if query.Cmd == metadata.CmdBulkExec {
+			limit, ok := bulkParamLimits[req.Settings.Engine]
+			if !ok {
+				return nil, fmt.Errorf("%s: :bulkexec is not supported by the %s engine", query.Name, req.Settings.Engine)
+			}
+			if len(query.Params) == 0 {
+				return nil, fmt.Errorf("%s: :bulkexec requires parameters", query.Name)
+			}
+			gq.BulkWidth = len(query.Params)
+			gq.BulkRows = max(limit/gq.BulkWidth, 1)
+		}
+
 		qs = append(qs, gq)
 	}
 	sort.Slice(qs, func(i, j int) bool { return qs[i].MethodName < qs[j].MethodName })
 	return qs, nil
 }
 
+// buildPage builds the cursor of a :paginate query from the result fields
+// its ORDER BY names.
+func buildPage(gq Query, query *plugin.Query, options *opts.Options) *Page {
+	page := &Page{
+		CursorType: sdk.LowerTitle(gq.MethodName) + "Cursor",
+		CursorName: "cursor",
+		LimitName:  "limit",
+	}
+	argNames := map[string]struct{}{}
+	for _, a := range gq.Arg.Pairs() {
+		argNames[a.Name] = struct{}{}
+	}
+	for _, name := range []*string{&page.CursorName, &page.LimitName} {
+		for {
+			if _, conflict := argNames[*name]; !conflict {
+				break
+			}
+			*name += "_2"
+		}
+	}
+	for _, idx := range query.Pagination.Columns {
+		dbName := columnName(query.Columns[idx], int(idx))
+		f := Field{Name: StructName(dbName, options), Type: gq.Ret.Type()}
+		key := "last"
+		if gq.Ret.IsStruct() {
+			f = gq.Ret.Struct.Fields[idx]
+			key = "last." + f.Name
+		}
+		page.Fields = append(page.Fields, Field{
+			Name:   f.Name,
+			DBName: dbName,
+			Type:   f.Type,
+			Tags:   map[string]string{"json": dbName},
+		})
+		page.Keys = append(page.Keys, key)
+	}
+	return page
+}
+
 var cmdReturnsData = map[string]struct{}{
 	metadata.CmdBatchMany: {},
 	metadata.CmdBatchOne:  {},
 	metadata.CmdMany:      {},
 	metadata.CmdOne:       {},
+	metadata.CmdPaginate:  {},
+}
+
+// bulkParamLimits are the most bind parameters each engine accepts in one
+// statement.
+var bulkParamLimits = map[string]int{
+	"postgresql": 65535,
+	"mysql":      65535,
+	"sqlite":     32766,
 }
 
 func putOutColumns(query *plugin.Query) bool {
+	if query.Cmd == metadata.CmdBulkExec {
+		return len(query.Columns) > 0
+	}
 	_, found := cmdReturnsData[query.Cmd]
 	return found
 }
@@ -0,0 +1,333 @@
+package pgtypes
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"reflect"
+	"testing"
+	"time"
+)
+
+type pgValue interface {
+	sql.Scanner
+	driver.Valuer
+}
+
+func date(s string) time.Time {
+	t, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+// same reports whether two values are equal, comparing times by the instant
+// and offset they give: time.Parse returns the same text's time in UTC, in
+// the local zone or in a zone of its own, depending on the text and on where
+// the test runs.
+func same(a, b any) bool {
+	ca, cb := reflect.New(reflect.TypeOf(a)).Elem(), reflect.New(reflect.TypeOf(b)).Elem()
+	ca.Set(reflect.ValueOf(a))
+	cb.Set(reflect.ValueOf(b))
+	canonical(ca)
+	canonical(cb)
+	return reflect.DeepEqual(ca.Interface(), cb.Interface())
+}
+
+// canonical moves every time in v to a zone that records nothing but its
+// offset. It changes the elements of slices in place.
+func canonical(v reflect.Value) {
+	if t, ok := v.Interface().(time.Time); ok {
+		_, offset := t.Zone()
+		v.Set(reflect.ValueOf(t.In(time.FixedZone("", offset))))
+		return
+	}
+	switch v.Kind() {
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			canonical(v.Field(i))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			canonical(v.Index(i))
+		}
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		// text is the value as PostgreSQL prints it.
+		text string
+		dest pgValue
+		want any
+		// out is what Value writes, when it differs from text.
+		out string
+	}{
+		{
+			name: "int4range",
+			text: "[1,10)",
+			dest: &PgInt4Range{},
+			want: PgInt4Range{Lower: 1, Upper: 10, LowerType: PgInclusive, UpperType: PgExclusive, Valid: true},
+			out:  `["1","10")`,
+		},
+		{
+			name: "int8range unbounded",
+			text: "(,5]",
+			dest: &PgInt8Range{},
+			want: PgInt8Range{Upper: 5, LowerType: PgUnbounded, UpperType: PgInclusive, Valid: true},
+			out:  `(,"5"]`,
+		},
+		{
+			name: "empty range",
+			text: "empty",
+			dest: &PgInt4Range{},
+			want: PgInt4Range{LowerType: PgEmpty, UpperType: PgEmpty, Valid: true},
+		},
+		{
+			name: "quoted bounds",
+			text: `["2024-01-01 10:00:00","2024-01-02 00:00:00.5")`,
+			dest: &PgTsRange{},
+			want: PgTsRange{
+				Lower:     time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC),
+				Upper:     time.Date(2024, 1, 2, 0, 0, 0, 500000000, time.UTC),
+				LowerType: PgInclusive,
+				UpperType: PgExclusive,
+				Valid:     true,
+			},
+		},
+		{
+			name: "escaped bounds",
+			text: `["a\"b","c\\d")`,
+			dest: &PgNumRange{},
+			want: PgNumRange{Lower: `a"b`, Upper: `c\d`, LowerType: PgInclusive, UpperType: PgExclusive, Valid: true},
+		},
+		{
+			name: "doubled quote",
+			text: `("a""b",)`,
+			dest: &PgNumRange{},
+			want: PgNumRange{Lower: `a"b`, LowerType: PgExclusive, UpperType: PgUnbounded, Valid: true},
+			out:  `("a\"b",)`,
+		},
+		{
+			name: "tstzrange offset",
+			text: `["2024-01-01 10:00:00+02","2024-01-01 12:30:00+05:30")`,
+			dest: &PgTstzRange{},
+			want: PgTstzRange{
+				Lower:     time.Date(2024, 1, 1, 10, 0, 0, 0, time.FixedZone("", 2*60*60)),
+				Upper:     time.Date(2024, 1, 1, 12, 30, 0, 0, time.FixedZone("", 5*60*60+30*60)),
+				LowerType: PgInclusive,
+				UpperType: PgExclusive,
+				Valid:     true,
+			},
+			out: `["2024-01-01 10:00:00+02:00","2024-01-01 12:30:00+05:30")`,
+		},
+		{
+			name: "infinite daterange",
+			text: "[-infinity,infinity]",
+			dest: &PgDateRange{},
+			want: PgDateRange{LowerType: PgInfinite, UpperType: PgInfinite, Valid: true},
+		},
+		{
+			name: "infinite upper bound",
+			text: "[2024-01-01,infinity)",
+			dest: &PgDateRange{},
+			want: PgDateRange{Lower: date("2024-01-01"), LowerType: PgInclusive, UpperType: PgInfinite, Valid: true},
+			out:  `["2024-01-01",infinity]`,
+		},
+		{
+			name: "infinite lower bound",
+			text: `(-infinity,"2024-01-01 00:00:00+00")`,
+			dest: &PgTstzRange{},
+			want: PgTstzRange{Upper: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), LowerType: PgInfinite, UpperType: PgExclusive, Valid: true},
+			out:  `[-infinity,"2024-01-01 00:00:00Z")`,
+		},
+		{
+			name: "numeric infinity",
+			text: "[-Infinity,Infinity]",
+			dest: &PgNumRange{},
+			want: PgNumRange{LowerType: PgInfinite, UpperType: PgInfinite, Valid: true},
+			out:  "[-infinity,infinity]",
+		},
+		{
+			name: "multirange",
+			text: "{[1,3),[5,7)}",
+			dest: &PgInt4Multirange{},
+			want: PgInt4Multirange{Ranges: []PgInt4Range{
+				{Lower: 1, Upper: 3, LowerType: PgInclusive, UpperType: PgExclusive, Valid: true},
+				{Lower: 5, Upper: 7, LowerType: PgInclusive, UpperType: PgExclusive, Valid: true},
+			}, Valid: true},
+			out: `{["1","3"),["5","7")}`,
+		},
+		{
+			name: "multirange quoted bounds",
+			text: `{["2024-01-01 00:00:00","2024-01-02 00:00:00"),["2024-02-01 00:00:00",)}`,
+			dest: &PgTsMultirange{},
+			want: PgTsMultirange{Ranges: []PgTsRange{
+				{Lower: date("2024-01-01"), Upper: date("2024-01-02"), LowerType: PgInclusive, UpperType: PgExclusive, Valid: true},
+				{Lower: date("2024-02-01"), LowerType: PgInclusive, UpperType: PgUnbounded, Valid: true},
+			}, Valid: true},
+		},
+		{
+			name: "multirange infinity",
+			text: "{[-infinity,2024-01-01),[2024-02-01,infinity]}",
+			dest: &PgDateMultirange{},
+			want: PgDateMultirange{Ranges: []PgDateRange{
+				{Upper: date("2024-01-01"), LowerType: PgInfinite, UpperType: PgExclusive, Valid: true},
+				{Lower: date("2024-02-01"), LowerType: PgInclusive, UpperType: PgInfinite, Valid: true},
+			}, Valid: true},
+			out: `{[-infinity,"2024-01-01"),["2024-02-01",infinity]}`,
+		},
+		{
+			name: "empty multirange",
+			text: "{}",
+			dest: &PgInt8Multirange{},
+			want: PgInt8Multirange{Ranges: []PgInt8Range{}, Valid: true},
+		},
+		{
+			name: "point",
+			text: "(1.5,-2)",
+			dest: &PgPoint{},
+			want: PgPoint{P: PgVec2{1.5, -2}, Valid: true},
+		},
+		{
+			name: "line",
+			text: "{1,-1,0.25}",
+			dest: &PgLine{},
+			want: PgLine{A: 1, B: -1, C: 0.25, Valid: true},
+		},
+		{
+			name: "lseg",
+			text: "[(0,0),(1,1)]",
+			dest: &PgLseg{},
+			want: PgLseg{P: [2]PgVec2{{0, 0}, {1, 1}}, Valid: true},
+		},
+		{
+			name: "box",
+			text: "(2,2),(0,0)",
+			dest: &PgBox{},
+			want: PgBox{P: [2]PgVec2{{2, 2}, {0, 0}}, Valid: true},
+		},
+		{
+			name: "open path",
+			text: "[(0,0),(1,1),(2,0)]",
+			dest: &PgPath{},
+			want: PgPath{P: []PgVec2{{0, 0}, {1, 1}, {2, 0}}, Valid: true},
+		},
+		{
+			name: "closed path",
+			text: "((0,0),(1,1),(2,0))",
+			dest: &PgPath{},
+			want: PgPath{P: []PgVec2{{0, 0}, {1, 1}, {2, 0}}, Closed: true, Valid: true},
+		},
+		{
+			name: "polygon",
+			text: "((0,0),(0,1),(1,1),(1,0))",
+			dest: &PgPolygon{},
+			want: PgPolygon{P: []PgVec2{{0, 0}, {0, 1}, {1, 1}, {1, 0}}, Valid: true},
+		},
+		{
+			name: "circle",
+			text: "<(1,2),3.5>",
+			dest: &PgCircle{},
+			want: PgCircle{P: PgVec2{1, 2}, R: 3.5, Valid: true},
+		},
+		{
+			name: "tsvector weights",
+			text: "'a':1A 'cat':5 'fat':2B,4C 'rat'",
+			dest: &PgTSVector{},
+			want: PgTSVector{Lexemes: []PgTSLexeme{
+				{Word: "a", Positions: []PgTSPosition{{1, 'A'}}},
+				{Word: "cat", Positions: []PgTSPosition{{5, 'D'}}},
+				{Word: "fat", Positions: []PgTSPosition{{2, 'B'}, {4, 'C'}}},
+				{Word: "rat"},
+			}, Valid: true},
+		},
+		{
+			name: "tsvector escaping",
+			text: `'back\\slash':3 'it''s':1 'two words'`,
+			dest: &PgTSVector{},
+			want: PgTSVector{Lexemes: []PgTSLexeme{
+				{Word: `back\slash`, Positions: []PgTSPosition{{3, 'D'}}},
+				{Word: "it's", Positions: []PgTSPosition{{1, 'D'}}},
+				{Word: "two words"},
+			}, Valid: true},
+		},
+		{
+			name: "tsquery",
+			text: "'fat' & ( 'rat' | 'cat' )",
+			dest: &PgTSQuery{},
+			want: PgTSQuery{Query: "'fat' & ( 'rat' | 'cat' )", Valid: true},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// Drivers hand back types they do not know as bytes.
+			if err := tc.dest.Scan([]byte(tc.text)); err != nil {
+				t.Fatal(err)
+			}
+			got := reflect.ValueOf(tc.dest).Elem().Interface()
+			if !same(got, tc.want) {
+				t.Fatalf("Scan(%q) = %+v, want %+v", tc.text, got, tc.want)
+			}
+			v, err := tc.dest.Value()
+			if err != nil {
+				t.Fatal(err)
+			}
+			out := tc.out
+			if out == "" {
+				out = tc.text
+			}
+			if v != out {
+				t.Fatalf("Value() = %q, want %q", v, out)
+			}
+			again := reflect.New(reflect.TypeOf(tc.dest).Elem()).Interface().(pgValue)
+			if err := again.Scan(v); err != nil {
+				t.Fatal(err)
+			}
+			if got := reflect.ValueOf(again).Elem().Interface(); !same(got, tc.want) {
+				t.Fatalf("Scan(%q) = %+v, want %+v", v, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestNull(t *testing.T) {
+	for _, dest := range []pgValue{
+		&PgInt4Range{}, &PgDateMultirange{}, &PgPoint{}, &PgLine{}, &PgLseg{}, &PgBox{},
+		&PgPath{}, &PgPolygon{}, &PgCircle{}, &PgTSVector{}, &PgTSQuery{},
+	} {
+		if err := dest.Scan(nil); err != nil {
+			t.Fatalf("%T: %s", dest, err)
+		}
+		if v, err := dest.Value(); v != nil || err != nil {
+			t.Fatalf("%T: Value() = %v, %v, want nil", dest, v, err)
+		}
+	}
+}
+
+// TestScanError checks that a value that fails to scan leaves the
+// destination as it was.
+func TestScanError(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		text string
+		dest pgValue
+	}{
+		{"bad lower bound", "[nope,2024-01-01)", &PgDateRange{Lower: date("2020-01-01"), LowerType: PgInclusive, UpperType: PgUnbounded, Valid: true}},
+		{"bad upper bound", "[2024-01-01,nope)", &PgDateRange{Lower: date("2020-01-01"), LowerType: PgInclusive, UpperType: PgUnbounded, Valid: true}},
+		{"positive infinity as lower bound", "[infinity,infinity]", &PgTsRange{LowerType: PgEmpty, UpperType: PgEmpty, Valid: true}},
+		{"bad range in multirange", "{[1,3),[x,7)}", &PgInt4Multirange{Ranges: []PgInt4Range{{LowerType: PgEmpty, UpperType: PgEmpty, Valid: true}}, Valid: true}},
+		{"short point", "(1)", &PgPoint{P: PgVec2{3, 4}, Valid: true}},
+		{"unterminated lexeme", "'cat", &PgTSVector{Lexemes: []PgTSLexeme{{Word: "dog"}}, Valid: true}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			want := reflect.ValueOf(tc.dest).Elem().Interface()
+			if err := tc.dest.Scan(tc.text); err == nil {
+				t.Fatalf("Scan(%q) succeeded", tc.text)
+			}
+			if got := reflect.ValueOf(tc.dest).Elem().Interface(); !same(got, want) {
+				t.Fatalf("Scan(%q) changed the value to %+v", tc.text, got)
+			}
+		})
+	}
+}
@@ -22,6 +22,11 @@ func parseOptions(req *plugin.GenerateRequest) (*opts, error) {
 	if err := dec.Decode(&options); err != nil {
 		return options, fmt.Errorf("unmarshalling options: %s", err)
 	}
+	switch options.Format {
+	case "", formatRequest, formatJSONSchema, formatOpenAPI:
+	default:
+		return options, fmt.Errorf("invalid format %q: use %s, %s or %s", options.Format, formatRequest, formatJSONSchema, formatOpenAPI)
+	}
 	return options, nil
 }
 
@@ -36,6 +41,25 @@ func Generate(ctx context.Context, req *plugin.GenerateRequest) (*plugin.Generat
 		indent = options.Indent
 	}
 
+	switch options.Format {
+	case formatJSONSchema:
+		files, err := jsonSchemaFiles(req, options, indent)
+		if err != nil {
+			return nil, err
+		}
+		return &plugin.GenerateResponse{Files: files}, nil
+	case formatOpenAPI:
+		filename := "openapi.json"
+		if options.Filename != "" {
+			filename = options.Filename
+		}
+		file, err := openAPIFile(req, options, filename, indent)
+		if err != nil {
+			return nil, err
+		}
+		return &plugin.GenerateResponse{Files: []*plugin.File{file}}, nil
+	}
+
 	filename := "codegen_request.json"
 	if options.Filename != "" {
 		filename = options.Filename
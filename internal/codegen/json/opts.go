@@ -4,4 +4,7 @@ type opts struct {
 	Out      string `json:"out"`
 	Indent   string `json:"indent,omitempty"`
 	Filename string `json:"filename,omitempty"`
+	// Format is request, the default, jsonschema or openapi.
+	Format              string `json:"format,omitempty"`
+	EmitExactTableNames bool   `json:"emit_exact_table_names,omitempty"`
 }
@@ -0,0 +1,335 @@
+package json
+
+import (
+	ejson "encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
+	"github.com/sqlc-dev/sqlc/internal/inflection"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+const (
+	formatRequest    = "request"
+	formatJSONSchema = "jsonschema"
+	formatOpenAPI    = "openapi"
+)
+
+const draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Schema is a JSON Schema. The OpenAPI components use the same schemas, as
+// OpenAPI 3.1 follows JSON Schema 2020-12.
+type Schema struct {
+	Schema               string      `json:"$schema,omitempty"`
+	Ref                  string      `json:"$ref,omitempty"`
+	Title                string      `json:"title,omitempty"`
+	Description          string      `json:"description,omitempty"`
+	Type                 any         `json:"type,omitempty"`
+	Format               string      `json:"format,omitempty"`
+	ContentEncoding      string      `json:"contentEncoding,omitempty"`
+	Enum                 []string    `json:"enum,omitempty"`
+	MaxLength            int32       `json:"maxLength,omitempty"`
+	Minimum              *int        `json:"minimum,omitempty"`
+	Items                *Schema     `json:"items,omitempty"`
+	AnyOf                []*Schema   `json:"anyOf,omitempty"`
+	Properties           *Properties `json:"properties,omitempty"`
+	Required             []string    `json:"required,omitempty"`
+	AdditionalProperties *bool       `json:"additionalProperties,omitempty"`
+}
+
+// Properties are the properties of an object, in the order of its columns.
+type Properties struct {
+	Names   []string
+	Schemas map[string]*Schema
+}
+
+func (p *Properties) add(name string, s *Schema) {
+	if p.Schemas == nil {
+		p.Schemas = map[string]*Schema{}
+	}
+	p.Names = append(p.Names, name)
+	p.Schemas[name] = s
+}
+
+func (p *Properties) MarshalJSON() ([]byte, error) {
+	var b strings.Builder
+	b.WriteByte('{')
+	for i, name := range p.Names {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+		key, err := ejson.Marshal(name)
+		if err != nil {
+			return nil, err
+		}
+		value, err := ejson.Marshal(p.Schemas[name])
+		if err != nil {
+			return nil, err
+		}
+		b.Write(key)
+		b.WriteByte(':')
+		b.Write(value)
+	}
+	b.WriteByte('}')
+	return []byte(b.String()), nil
+}
+
+type schemaBuilder struct {
+	req     *plugin.GenerateRequest
+	options *opts
+	// ref returns the reference to the named schema.
+	ref func(name string) string
+
+	enums  map[string]string
+	tables []*table
+	// schemas are the named schemas, in the order they were added.
+	schemas Properties
+}
+
+type table struct {
+	id   *plugin.Identifier
+	name string
+}
+
+func newSchemaBuilder(req *plugin.GenerateRequest, options *opts, ref func(string) string) *schemaBuilder {
+	b := &schemaBuilder{
+		req:     req,
+		options: options,
+		ref:     ref,
+		enums:   map[string]string{},
+	}
+	defaultSchema := req.Catalog.GetDefaultSchema()
+	for _, schema := range req.Catalog.GetSchemas() {
+		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
+			continue
+		}
+		for _, e := range schema.Enums {
+			name := e.Name
+			if schema.Name != defaultSchema {
+				name = schema.Name + "_" + name
+			}
+			s := &Schema{
+				Title:       typeName(name),
+				Description: e.Comment,
+				Type:        "string",
+				Enum:        e.Vals,
+			}
+			b.enums[schema.Name+"."+e.Name] = s.Title
+			b.schemas.add(s.Title, s)
+		}
+	}
+	for _, schema := range req.Catalog.GetSchemas() {
+		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
+			continue
+		}
+		for _, t := range schema.Tables {
+			name := t.Rel.Name
+			if !options.EmitExactTableNames {
+				name = inflection.Singular(inflection.SingularParams{Name: name})
+			}
+			if schema.Name != defaultSchema {
+				name = schema.Name + "_" + name
+			}
+			s := b.object(typeName(name), t.Comment, t.Columns)
+			b.tables = append(b.tables, &table{
+				id:   &plugin.Identifier{Schema: schema.Name, Name: t.Rel.Name},
+				name: s.Title,
+			})
+			b.schemas.add(s.Title, s)
+		}
+	}
+	for _, q := range req.Queries {
+		if q.Name == "" {
+			continue
+		}
+		if len(q.Params) > 0 {
+			var columns []*plugin.Column
+			for _, p := range q.Params {
+				c := p.Column
+				if c == nil {
+					c = &plugin.Column{}
+				}
+				if c.Name == "" {
+					c = &plugin.Column{
+						Name:        fmt.Sprintf("param_%d", p.Number),
+						NotNull:     c.NotNull,
+						IsArray:     c.IsArray,
+						Length:      c.Length,
+						Type:        c.Type,
+						IsSqlcSlice: c.IsSqlcSlice,
+						Unsigned:    c.Unsigned,
+						ArrayDims:   c.ArrayDims,
+					}
+				}
+				columns = append(columns, c)
+			}
+			s := b.object(typeName(q.Name)+"Params", "", columns)
+			b.schemas.add(s.Title, s)
+		}
+		switch q.Cmd {
+		case metadata.CmdOne, metadata.CmdMany, metadata.CmdBatchOne, metadata.CmdBatchMany, metadata.CmdPaginate:
+			if len(q.Columns) > 0 {
+				s := b.object(typeName(q.Name)+"Row", strings.TrimSpace(strings.Join(q.Comments, "\n")), q.Columns)
+				b.schemas.add(s.Title, s)
+			}
+		}
+	}
+	return b
+}
+
+// object returns the schema of an object with a property for every column.
+// A column without a name is named by its position.
+func (b *schemaBuilder) object(title, description string, columns []*plugin.Column) *Schema {
+	closed := false
+	s := &Schema{
+		Title:                title,
+		Description:          strings.TrimSpace(description),
+		Type:                 "object",
+		Properties:           &Properties{},
+		Required:             []string{},
+		AdditionalProperties: &closed,
+	}
+	for i, c := range columns {
+		name := c.Name
+		if name == "" {
+			name = fmt.Sprintf("column_%d", i+1)
+		}
+		if s.Properties.Schemas[name] != nil {
+			continue
+		}
+		s.Properties.add(name, b.column(c))
+		s.Required = append(s.Required, name)
+	}
+	return s
+}
+
+// column returns the schema of the value of a column. A nullable column also
+// allows null, and an array holds its dimensions.
+func (b *schemaBuilder) column(c *plugin.Column) *Schema {
+	if c.EmbedTable != nil {
+		for _, t := range b.tables {
+			if sdk.SameTableName(c.EmbedTable, t.id, b.req.Catalog.GetDefaultSchema()) {
+				return &Schema{Ref: b.ref(t.name)}
+			}
+		}
+	}
+	var s *Schema
+	if enum := b.enum(c.Type); enum != "" {
+		s = &Schema{Ref: b.ref(enum)}
+	} else {
+		s = scalarSchema(b.req.GetSettings().GetEngine(), c)
+	}
+	dims := int(c.ArrayDims)
+	if dims == 0 && (c.IsArray || c.IsSqlcSlice) {
+		dims = 1
+	}
+	for i := 0; i < dims; i++ {
+		s = &Schema{Type: "array", Items: s}
+	}
+	if !c.NotNull && !c.IsSqlcSlice {
+		s = nullable(s)
+	}
+	s.Description = c.Comment
+	return s
+}
+
+func (b *schemaBuilder) enum(id *plugin.Identifier) string {
+	if id == nil {
+		return ""
+	}
+	schema := id.Schema
+	if schema == "" {
+		schema = b.req.Catalog.GetDefaultSchema()
+	}
+	return b.enums[schema+"."+id.Name]
+}
+
+// nullable returns a schema that also allows null.
+func nullable(s *Schema) *Schema {
+	switch t := s.Type.(type) {
+	case string:
+		s.Type = []string{t, "null"}
+		return s
+	case nil:
+		if s.Ref == "" {
+			// The schema allows any value already.
+			return s
+		}
+	}
+	return &Schema{AnyOf: []*Schema{s, {Type: "null"}}}
+}
+
+// typeName returns a name in PascalCase, the way the Go code names structs.
+func typeName(s string) string {
+	var b strings.Builder
+	for _, w := range strings.FieldsFunc(s, func(r rune) bool {
+		return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+	}) {
+		b.WriteString(sdk.Title(w))
+	}
+	return b.String()
+}
+
+// jsonSchemaFiles returns a JSON Schema document for every enum, table model,
+// and the params and result rows of every query. Documents refer to each other
+// by filename.
+func jsonSchemaFiles(req *plugin.GenerateRequest, options *opts, indent string) ([]*plugin.File, error) {
+	b := newSchemaBuilder(req, options, func(name string) string {
+		return name + ".json"
+	})
+	var files []*plugin.File
+	for _, name := range b.schemas.Names {
+		s := b.schemas.Schemas[name]
+		s.Schema = draft
+		blob, err := ejson.MarshalIndent(s, "", indent)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, &plugin.File{
+			Name:     name + ".json",
+			Contents: append(blob, '\n'),
+		})
+	}
+	return files, nil
+}
+
+type openAPI struct {
+	OpenAPI    string     `json:"openapi"`
+	Info       info       `json:"info"`
+	Components components `json:"components"`
+}
+
+type info struct {
+	Title   string `json:"title"`
+	Version string `json:"version"`
+}
+
+type components struct {
+	Schemas *Properties `json:"schemas"`
+}
+
+// openAPIFile returns an OpenAPI document with the same schemas as
+// jsonSchemaFiles under components.schemas.
+func openAPIFile(req *plugin.GenerateRequest, options *opts, filename, indent string) (*plugin.File, error) {
+	b := newSchemaBuilder(req, options, func(name string) string {
+		return "#/components/schemas/" + name
+	})
+	doc := openAPI{
+		OpenAPI: "3.1.0",
+		Info: info{
+			Title:   "sqlc",
+			Version: req.SqlcVersion,
+		},
+		Components: components{Schemas: &b.schemas},
+	}
+	blob, err := ejson.MarshalIndent(doc, "", indent)
+	if err != nil {
+		return nil, err
+	}
+	return &plugin.File{
+		Name:     filename,
+		Contents: append(blob, '\n'),
+	}, nil
+}
@@ -0,0 +1,166 @@
+package json
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// scalarTypes maps the names databases give their types, in lower case, to
+// the JSON type and format of their values. Names that mean different things
+// to different engines are in engineTypes instead.
+var scalarTypes = map[string][2]string{
+	"smallint":    {"integer", "int32"},
+	"int2":        {"integer", "int32"},
+	"integer":     {"integer", "int32"},
+	"int":         {"integer", "int32"},
+	"int4":        {"integer", "int32"},
+	"mediumint":   {"integer", "int32"},
+	"tinyint":     {"integer", "int32"},
+	"serial":      {"integer", "int32"},
+	"serial2":     {"integer", "int32"},
+	"serial4":     {"integer", "int32"},
+	"smallserial": {"integer", "int32"},
+	"year":        {"integer", "int32"},
+	"bigint":      {"integer", "int64"},
+	"bigserial":   {"integer", "int64"},
+	"serial8":     {"integer", "int64"},
+	"int8":        {"integer", "int64"},
+
+	"real":             {"number", "float"},
+	"float4":           {"number", "float"},
+	"float":            {"number", "double"},
+	"double":           {"number", "double"},
+	"double precision": {"number", "double"},
+	"float8":           {"number", "double"},
+
+	"numeric":    {"string", "decimal"},
+	"decimal":    {"string", "decimal"},
+	"money":      {"string", ""},
+	"smallmoney": {"string", ""},
+
+	"boolean": {"boolean", ""},
+	"bool":    {"boolean", ""},
+	"bit":     {"boolean", ""},
+
+	"text":              {"string", ""},
+	"varchar":           {"string", ""},
+	"char":              {"string", ""},
+	"bpchar":            {"string", ""},
+	"character":         {"string", ""},
+	"character varying": {"string", ""},
+	"citext":            {"string", ""},
+	"name":              {"string", ""},
+	"nchar":             {"string", ""},
+	"nvarchar":          {"string", ""},
+	"ntext":             {"string", ""},
+	"tinytext":          {"string", ""},
+	"mediumtext":        {"string", ""},
+	"longtext":          {"string", ""},
+	"enum":              {"string", ""},
+	"set":               {"string", ""},
+	"xml":               {"string", ""},
+	"uuid":              {"string", "uuid"},
+	"uniqueidentifier":  {"string", "uuid"},
+	"inet":              {"string", ""},
+	"cidr":              {"string", ""},
+	"macaddr":           {"string", ""},
+	"interval":          {"string", "duration"},
+
+	"date":           {"string", "date"},
+	"time":           {"string", "time"},
+	"timetz":         {"string", "time"},
+	"datetime":       {"string", "date-time"},
+	"datetime2":      {"string", "date-time"},
+	"smalldatetime":  {"string", "date-time"},
+	"datetimeoffset": {"string", "date-time"},
+	"timestamp":      {"string", "date-time"},
+	"timestamptz":    {"string", "date-time"},
+}
+
+var engineTypes = map[string]map[string][2]string{
+	"mysql": {
+		"float": {"number", "float"},
+	},
+	"sqlite": {
+		"integer": {"integer", "int64"},
+		"int":     {"integer", "int64"},
+		"real":    {"number", "double"},
+	},
+	"clickhouse": {
+		"int8":       {"integer", "int32"},
+		"int16":      {"integer", "int32"},
+		"int32":      {"integer", "int32"},
+		"int64":      {"integer", "int64"},
+		"uint8":      {"integer", "int32"},
+		"uint16":     {"integer", "int32"},
+		"uint32":     {"integer", "int64"},
+		"uint64":     {"integer", "int64"},
+		"float32":    {"number", "float"},
+		"float64":    {"number", "double"},
+		"string":     {"string", ""},
+		"date32":     {"string", "date"},
+		"datetime64": {"string", "date-time"},
+	},
+	"googlesql": {
+		"int64":   {"integer", "int64"},
+		"float64": {"number", "double"},
+		"string":  {"string", ""},
+	},
+}
+
+// binaryTypes hold bytes, which encoding/json writes in base64.
+var binaryTypes = map[string]bool{
+	"bytea":      true,
+	"blob":       true,
+	"tinyblob":   true,
+	"mediumblob": true,
+	"longblob":   true,
+	"binary":     true,
+	"varbinary":  true,
+	"image":      true,
+	"bytes":      true,
+}
+
+// lengthTypes are the character types whose length limits their values.
+var lengthTypes = map[string]bool{
+	"varchar":           true,
+	"char":              true,
+	"bpchar":            true,
+	"character":         true,
+	"character varying": true,
+	"nchar":             true,
+	"nvarchar":          true,
+}
+
+// scalarSchema returns the schema of a value of a column's type. Types the
+// generator does not know allow any value, as do JSON columns.
+func scalarSchema(engine string, c *plugin.Column) *Schema {
+	name := strings.TrimPrefix(strings.ToLower(c.Type.GetName()), "pg_catalog.")
+	if i := strings.IndexByte(name, '('); i >= 0 {
+		name = strings.TrimSpace(name[:i])
+	}
+	if schema := c.Type.GetSchema(); schema != "" && schema != "pg_catalog" {
+		name = strings.ToLower(sdk.DataType(c.Type))
+	}
+	if binaryTypes[name] {
+		return &Schema{Type: "string", ContentEncoding: "base64"}
+	}
+	typ, ok := engineTypes[engine][name]
+	if !ok {
+		typ, ok = scalarTypes[name]
+	}
+	if !ok {
+		return &Schema{}
+	}
+	s := &Schema{Type: typ[0], Format: typ[1]}
+	if lengthTypes[name] && c.Length > 0 {
+		s.MaxLength = c.Length
+	}
+	if typ[0] == "integer" && c.Unsigned {
+		zero := 0
+		s.Minimum = &zero
+	}
+	return s
+}
@@ -0,0 +1,427 @@
+// Package proto generates a protocol buffers file for the queries of a sql
+// block: a message for every table, request and response messages for every
+// query, and a gRPC service with an RPC for every query.
+package proto
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	ejson "encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"text/template"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
+	"github.com/sqlc-dev/sqlc/internal/inflection"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+//go:embed templates/*.tmpl
+var templates embed.FS
+
+var tmpl = template.Must(template.New("").ParseFS(templates, "templates/*.tmpl"))
+
+type File struct {
+	SqlcVersion string
+	Package     string
+	GoPackage   string
+	Imports     []string
+	Enums       []*Enum
+	Messages    []*Message
+	Service     string
+	RPCs        []*RPC
+}
+
+type Enum struct {
+	Comments []string
+	Name     string
+	Values   []*EnumValue
+}
+
+type EnumValue struct {
+	Name   string
+	Number int
+}
+
+type Message struct {
+	Comments []string
+	Name     string
+	Fields   []*Field
+}
+
+type Field struct {
+	Comments []string
+	// Label is repeated, optional or empty.
+	Label  string
+	Type   string
+	Name   string
+	Number int
+}
+
+type RPC struct {
+	Comments []string
+	Name     string
+	Request  string
+	Response string
+}
+
+func parseOptions(req *plugin.GenerateRequest) (*opts, error) {
+	options := new(opts)
+	if len(req.PluginOptions) > 0 {
+		dec := ejson.NewDecoder(bytes.NewReader(req.PluginOptions))
+		dec.DisallowUnknownFields()
+		if err := dec.Decode(options); err != nil {
+			return options, fmt.Errorf("unmarshalling options: %s", err)
+		}
+	}
+	if options.Package == "" {
+		options.Package = "db"
+	}
+	if options.Service == "" {
+		options.Service = "QueryService"
+	}
+	if options.Filename == "" {
+		options.Filename = "query.proto"
+	}
+	return options, nil
+}
+
+func Generate(ctx context.Context, req *plugin.GenerateRequest) (*plugin.GenerateResponse, error) {
+	options, err := parseOptions(req)
+	if err != nil {
+		return nil, err
+	}
+	g := &generator{
+		req:      req,
+		options:  options,
+		engine:   req.GetSettings().GetEngine(),
+		imports:  map[string]bool{},
+		messages: uniqueNames{},
+	}
+	file := g.file()
+
+	var b bytes.Buffer
+	if err := tmpl.ExecuteTemplate(&b, "protoFile", file); err != nil {
+		return nil, err
+	}
+	return &plugin.GenerateResponse{
+		Files: []*plugin.File{
+			{
+				Name:     options.Filename,
+				Contents: b.Bytes(),
+			},
+		},
+	}, nil
+}
+
+type generator struct {
+	req     *plugin.GenerateRequest
+	options *opts
+	engine  string
+
+	enums   []*enum
+	models  []*model
+	imports map[string]bool
+	// messages are the names of the messages and enums of the file.
+	messages uniqueNames
+}
+
+type enum struct {
+	id   *plugin.Identifier
+	name string
+}
+
+type model struct {
+	id      *plugin.Identifier
+	table   *plugin.Table
+	message *Message
+}
+
+func (g *generator) file() *File {
+	file := &File{
+		SqlcVersion: g.req.SqlcVersion,
+		Package:     g.options.Package,
+		GoPackage:   g.options.GoPackage,
+		Service:     messageName(g.options.Service),
+	}
+	defaultSchema := g.req.Catalog.DefaultSchema
+
+	for _, schema := range g.req.Catalog.Schemas {
+		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
+			continue
+		}
+		for _, e := range schema.Enums {
+			name := e.Name
+			if schema.Name != defaultSchema {
+				name = schema.Name + "_" + name
+			}
+			pe := &Enum{Name: g.messages.add(messageName(name))}
+			if e.Comment != "" {
+				pe.Comments = lines(e.Comment)
+			}
+			pe.Values = append(pe.Values, &EnumValue{Name: enumValueName(pe.Name, "unspecified")})
+			values := uniqueNames{pe.Values[0].Name: true}
+			for i, v := range e.Vals {
+				pe.Values = append(pe.Values, &EnumValue{
+					Name:   values.add(enumValueName(pe.Name, v)),
+					Number: i + 1,
+				})
+			}
+			file.Enums = append(file.Enums, pe)
+			g.enums = append(g.enums, &enum{
+				id:   &plugin.Identifier{Schema: schema.Name, Name: e.Name},
+				name: pe.Name,
+			})
+		}
+	}
+
+	// Models come first, as queries refer to them by name.
+	for _, schema := range g.req.Catalog.Schemas {
+		if schema.Name == "pg_catalog" || schema.Name == "information_schema" {
+			continue
+		}
+		for _, t := range schema.Tables {
+			name := t.Rel.Name
+			if !g.options.EmitExactTableNames {
+				name = inflection.Singular(inflection.SingularParams{Name: name})
+			}
+			if schema.Name != defaultSchema {
+				name = schema.Name + "_" + name
+			}
+			m := &Message{Name: g.messages.add(messageName(name))}
+			if t.Comment != "" {
+				m.Comments = lines(t.Comment)
+			}
+			fields := uniqueNames{}
+			for _, c := range t.Columns {
+				f := g.field(c, c.Name, fields)
+				if c.Comment != "" {
+					f.Comments = lines(c.Comment)
+				}
+				m.Fields = append(m.Fields, f)
+			}
+			number(m.Fields)
+			g.models = append(g.models, &model{
+				id:      &plugin.Identifier{Schema: schema.Name, Name: t.Rel.Name},
+				table:   t,
+				message: m,
+			})
+		}
+	}
+	for _, m := range g.models {
+		file.Messages = append(file.Messages, m.message)
+	}
+
+	for _, q := range g.req.Queries {
+		if q.Name == "" {
+			continue
+		}
+		messages, rpc := g.query(q)
+		file.Messages = append(file.Messages, messages...)
+		file.RPCs = append(file.RPCs, rpc)
+	}
+
+	for imp := range g.imports {
+		file.Imports = append(file.Imports, imp)
+	}
+	sort.Strings(file.Imports)
+	return file
+}
+
+// query returns the request and response messages of a query and its RPC.
+func (g *generator) query(q *plugin.Query) ([]*Message, *RPC) {
+	name := messageName(q.Name)
+	var comments []string
+	for _, c := range q.Comments {
+		comments = append(comments, strings.TrimSpace(c))
+	}
+	rpc := &RPC{
+		Comments: comments,
+		Name:     name,
+		Request:  g.messages.add(name + "Request"),
+		Response: g.messages.add(name + "Response"),
+	}
+	request := &Message{Comments: comments, Name: rpc.Request}
+	response := &Message{Name: rpc.Response}
+	messages := []*Message{request, response}
+
+	params := &Message{}
+	fields := uniqueNames{}
+	for _, p := range q.Params {
+		c := p.Column
+		if c == nil {
+			c = &plugin.Column{}
+		}
+		name := c.Name
+		if name == "" {
+			name = fmt.Sprintf("param_%d", p.Number)
+		}
+		params.Fields = append(params.Fields, g.field(c, name, fields))
+	}
+	number(params.Fields)
+
+	switch q.Cmd {
+	case metadata.CmdCopyFrom:
+		// A copy takes the rows to insert.
+		params.Name = g.messages.add(name + "Params")
+		request.Fields = []*Field{{Label: "repeated", Type: params.Name, Name: "rows", Number: 1}}
+		response.Fields = []*Field{{Type: "int64", Name: "rows_affected", Number: 1}}
+		messages = append(messages, params)
+		return messages, rpc
+	default:
+		request.Fields = params.Fields
+	}
+
+	switch q.Cmd {
+	case metadata.CmdOne, metadata.CmdBatchOne:
+		if m := g.model(q.Columns); m != nil {
+			response.Fields = []*Field{{Type: m.message.Name, Name: fieldName(m.message.Name), Number: 1}}
+		} else {
+			response.Fields = g.columns(q.Columns)
+		}
+	case metadata.CmdMany, metadata.CmdBatchMany, metadata.CmdPaginate:
+		if m := g.model(q.Columns); m != nil {
+			response.Fields = []*Field{{Label: "repeated", Type: m.message.Name, Name: fieldName(m.table.Rel.Name), Number: 1}}
+		} else {
+			row := &Message{Name: g.messages.add(name + "Row"), Fields: g.columns(q.Columns)}
+			response.Fields = []*Field{{Label: "repeated", Type: row.Name, Name: "rows", Number: 1}}
+			messages = append(messages, row)
+		}
+	case metadata.CmdExecRows, metadata.CmdExecResult:
+		response.Fields = []*Field{{Type: "int64", Name: "rows_affected", Number: 1}}
+	case metadata.CmdExecLastId:
+		response.Fields = []*Field{{Type: "int64", Name: "last_insert_id", Number: 1}}
+	}
+	return messages, rpc
+}
+
+// columns returns the fields of the columns a query returns.
+func (g *generator) columns(columns []*plugin.Column) []*Field {
+	var fields []*Field
+	names := uniqueNames{}
+	for i, c := range columns {
+		if c.EmbedTable != nil {
+			if m := g.table(c.EmbedTable); m != nil {
+				fields = append(fields, &Field{Type: m.message.Name, Name: names.add(fieldName(c.Name))})
+				continue
+			}
+		}
+		name := c.Name
+		if name == "" {
+			name = fmt.Sprintf("column_%d", i+1)
+		}
+		fields = append(fields, g.field(c, name, names))
+	}
+	number(fields)
+	return fields
+}
+
+// model returns the model of the table whose columns a query returns, all of
+// them and in order, or nil.
+func (g *generator) model(columns []*plugin.Column) *model {
+	if len(columns) == 0 {
+		return nil
+	}
+	m := g.table(columns[0].Table)
+	if m == nil || len(m.table.Columns) != len(columns) {
+		return nil
+	}
+	for i, c := range columns {
+		tc := m.table.Columns[i]
+		if c.Name != tc.Name || !sdk.SameTableName(c.Table, m.id, g.req.Catalog.DefaultSchema) {
+			return nil
+		}
+		label, typ := g.fieldType(c)
+		tcLabel, tcType := g.fieldType(tc)
+		if label != tcLabel || typ != tcType {
+			return nil
+		}
+	}
+	return m
+}
+
+func (g *generator) table(id *plugin.Identifier) *model {
+	if id == nil {
+		return nil
+	}
+	for _, m := range g.models {
+		if sdk.SameTableName(id, m.id, g.req.Catalog.DefaultSchema) {
+			return m
+		}
+	}
+	return nil
+}
+
+// field returns the field of a column, named uniquely among fields.
+func (g *generator) field(c *plugin.Column, name string, fields uniqueNames) *Field {
+	label, typ := g.fieldType(c)
+	return &Field{
+		Label: label,
+		Type:  typ,
+		Name:  fields.add(fieldName(name)),
+	}
+}
+
+// fieldType returns the label and type of the field of a column. A nullable
+// column is a wrapper message, or an optional enum, and an array or a
+// sqlc.slice() is a repeated field.
+func (g *generator) fieldType(c *plugin.Column) (string, string) {
+	typ := g.enum(c.Type)
+	if typ == "" {
+		typ = scalarType(g.engine, c)
+	}
+	if typ == "" {
+		typ = "string"
+	}
+	if imp, ok := wellKnownImports[typ]; ok {
+		g.imports[imp] = true
+	}
+	if c.IsArray || c.IsSqlcSlice {
+		return "repeated", typ
+	}
+	if c.NotNull {
+		return "", typ
+	}
+	if wrapper, ok := wrappers[typ]; ok {
+		g.imports[wrappersImport] = true
+		return "", wrapper
+	}
+	if slices.ContainsFunc(g.enums, func(e *enum) bool { return e.name == typ }) {
+		return "optional", typ
+	}
+	return "", typ
+}
+
+func (g *generator) enum(id *plugin.Identifier) string {
+	if id == nil {
+		return ""
+	}
+	for _, e := range g.enums {
+		if e.id.Name != id.Name {
+			continue
+		}
+		if id.Schema == e.id.Schema || (id.Schema == "" && e.id.Schema == g.req.Catalog.DefaultSchema) {
+			return e.name
+		}
+	}
+	return ""
+}
+
+// number numbers fields in order, from 1.
+func number(fields []*Field) {
+	for i, f := range fields {
+		f.Number = i + 1
+	}
+}
+
+func lines(s string) []string {
+	var out []string
+	for _, l := range strings.Split(strings.TrimSpace(s), "\n") {
+		out = append(out, strings.TrimSpace(l))
+	}
+	return out
+}
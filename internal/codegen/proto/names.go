@@ -0,0 +1,87 @@
+package proto
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// words splits a name into its words at underscores, at anything else that
+// cannot appear in a proto identifier, and where a lower-case letter or a
+// digit is followed by an upper-case letter.
+func words(s string) []string {
+	var out []string
+	var word []rune
+	flush := func() {
+		if len(word) > 0 {
+			out = append(out, string(word))
+			word = nil
+		}
+	}
+	runes := []rune(s)
+	for i, r := range runes {
+		switch {
+		case r > unicode.MaxASCII || !(unicode.IsLetter(r) || unicode.IsDigit(r)):
+			flush()
+		case unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1])):
+			flush()
+			word = append(word, r)
+		default:
+			word = append(word, r)
+		}
+	}
+	flush()
+	return out
+}
+
+// messageName returns a name in the PascalCase proto uses for messages,
+// enums, services and RPCs.
+func messageName(s string) string {
+	var b strings.Builder
+	for _, w := range words(s) {
+		r := []rune(w)
+		b.WriteRune(unicode.ToUpper(r[0]))
+		b.WriteString(string(r[1:]))
+	}
+	return identifier(b.String(), "X")
+}
+
+// fieldName returns a name in the lower_snake_case proto uses for fields.
+func fieldName(s string) string {
+	ws := words(s)
+	for i, w := range ws {
+		ws[i] = strings.ToLower(w)
+	}
+	return identifier(strings.Join(ws, "_"), "field_")
+}
+
+// enumValueName returns the name of a value of an enum, prefixed with the
+// enum's name in UPPER_SNAKE_CASE as values share the enum's scope.
+func enumValueName(enum, value string) string {
+	ws := append(words(enum), words(value)...)
+	for i, w := range ws {
+		ws[i] = strings.ToUpper(w)
+	}
+	return strings.Join(ws, "_")
+}
+
+// identifier makes a name that does not start with a letter into one.
+func identifier(s, prefix string) string {
+	if s == "" || !unicode.IsLetter(rune(s[0])) {
+		return prefix + s
+	}
+	return s
+}
+
+// uniqueNames hands out names that have not been handed out before, by
+// numbering the ones that repeat.
+type uniqueNames map[string]bool
+
+func (u uniqueNames) add(name string) string {
+	unique := name
+	for i := 2; u[unique]; i++ {
+		unique = fmt.Sprintf("%s_%d", name, i)
+	}
+	u[unique] = true
+	return unique
+}
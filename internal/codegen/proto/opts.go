@@ -0,0 +1,10 @@
+package proto
+
+type opts struct {
+	Out                 string `json:"out"`
+	Package             string `json:"package,omitempty"`
+	GoPackage           string `json:"go_package,omitempty"`
+	Service             string `json:"service,omitempty"`
+	Filename            string `json:"filename,omitempty"`
+	EmitExactTableNames bool   `json:"emit_exact_table_names,omitempty"`
+}
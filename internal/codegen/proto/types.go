@@ -0,0 +1,210 @@
+package proto
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/codegen/sdk"
+	"github.com/sqlc-dev/sqlc/internal/plugin"
+)
+
+// The well-known types a generated file can import, by the file that
+// declares them.
+const (
+	timestampType = "google.protobuf.Timestamp"
+	durationType  = "google.protobuf.Duration"
+	valueType     = "google.protobuf.Value"
+)
+
+var wellKnownImports = map[string]string{
+	timestampType: "google/protobuf/timestamp.proto",
+	durationType:  "google/protobuf/duration.proto",
+	valueType:     "google/protobuf/struct.proto",
+}
+
+// wrappers are the well-known messages that hold a nullable scalar.
+var wrappers = map[string]string{
+	"double": "google.protobuf.DoubleValue",
+	"float":  "google.protobuf.FloatValue",
+	"int64":  "google.protobuf.Int64Value",
+	"uint64": "google.protobuf.UInt64Value",
+	"int32":  "google.protobuf.Int32Value",
+	"uint32": "google.protobuf.UInt32Value",
+	"bool":   "google.protobuf.BoolValue",
+	"string": "google.protobuf.StringValue",
+	"bytes":  "google.protobuf.BytesValue",
+}
+
+const wrappersImport = "google/protobuf/wrappers.proto"
+
+// scalarTypes maps the names databases give their types, in lower case, to
+// proto types. Names that mean different things to different engines are in
+// engineTypes instead.
+var scalarTypes = map[string]string{
+	"smallint":    "int32",
+	"int2":        "int32",
+	"integer":     "int32",
+	"int":         "int32",
+	"int4":        "int32",
+	"mediumint":   "int32",
+	"tinyint":     "int32",
+	"serial":      "int32",
+	"serial2":     "int32",
+	"serial4":     "int32",
+	"smallserial": "int32",
+	"year":        "int32",
+	"bigint":      "int64",
+	"bigserial":   "int64",
+	"serial8":     "int64",
+
+	"real":             "float",
+	"float4":           "float",
+	"double":           "double",
+	"double precision": "double",
+	"float8":           "double",
+
+	"numeric":    "string",
+	"decimal":    "string",
+	"money":      "string",
+	"smallmoney": "string",
+
+	"boolean": "bool",
+	"bool":    "bool",
+	"bit":     "bool",
+
+	"text":              "string",
+	"varchar":           "string",
+	"char":              "string",
+	"bpchar":            "string",
+	"character":         "string",
+	"character varying": "string",
+	"citext":            "string",
+	"name":              "string",
+	"nchar":             "string",
+	"nvarchar":          "string",
+	"ntext":             "string",
+	"tinytext":          "string",
+	"mediumtext":        "string",
+	"longtext":          "string",
+	"enum":              "string",
+	"set":               "string",
+	"uuid":              "string",
+	"uniqueidentifier":  "string",
+	"inet":              "string",
+	"cidr":              "string",
+	"macaddr":           "string",
+	"xml":               "string",
+	"time":              "string",
+	"timetz":            "string",
+
+	"bytea":      "bytes",
+	"blob":       "bytes",
+	"tinyblob":   "bytes",
+	"mediumblob": "bytes",
+	"longblob":   "bytes",
+	"binary":     "bytes",
+	"varbinary":  "bytes",
+	"image":      "bytes",
+
+	"date":           timestampType,
+	"datetime":       timestampType,
+	"datetime2":      timestampType,
+	"smalldatetime":  timestampType,
+	"datetimeoffset": timestampType,
+	"timestamp":      timestampType,
+	"timestamptz":    timestampType,
+
+	"interval": durationType,
+
+	"json":  valueType,
+	"jsonb": valueType,
+}
+
+var engineTypes = map[string]map[string]string{
+	"postgresql": {
+		"int8":  "int64",
+		"float": "double",
+	},
+	"mysql": {
+		"float": "float",
+	},
+	"sqlite": {
+		"integer": "int64",
+		"int":     "int64",
+		"real":    "double",
+	},
+	"mssql": {
+		"float":   "double",
+		"tinyint": "uint32",
+	},
+	"clickhouse": {
+		"int8":        "int32",
+		"int16":       "int32",
+		"int32":       "int32",
+		"int64":       "int64",
+		"uint8":       "uint32",
+		"uint16":      "uint32",
+		"uint32":      "uint32",
+		"uint64":      "uint64",
+		"float32":     "float",
+		"float64":     "double",
+		"string":      "string",
+		"fixedstring": "string",
+		"date32":      timestampType,
+		"datetime64":  timestampType,
+	},
+	"googlesql": {
+		"int64":   "int64",
+		"float64": "double",
+		"string":  "string",
+		"bytes":   "bytes",
+	},
+}
+
+// scalarType maps the type of a column to a proto type, or to "" for a type
+// the generator does not know. The type of an unsigned MySQL integer is
+// unsigned too.
+func scalarType(engine string, col *plugin.Column) string {
+	name := strings.TrimPrefix(strings.ToLower(col.Type.GetName()), "pg_catalog.")
+	if i := strings.IndexByte(name, '('); i >= 0 {
+		name = strings.TrimSpace(name[:i])
+	}
+	if schema := col.Type.GetSchema(); schema != "" && schema != "pg_catalog" {
+		name = strings.ToLower(sdk.DataType(col.Type))
+	}
+	typ, ok := engineTypes[engine][name]
+	if !ok {
+		typ, ok = scalarTypes[name]
+	}
+	if !ok && engine == "sqlite" {
+		typ = sqliteAffinity(name)
+	}
+	if col.Unsigned {
+		switch typ {
+		case "int32":
+			typ = "uint32"
+		case "int64":
+			typ = "uint64"
+		}
+	}
+	return typ
+}
+
+// sqliteAffinity maps a SQLite type name to a proto type by the affinity
+// SQLite gives a column of that type.
+func sqliteAffinity(name string) string {
+	switch {
+	case strings.Contains(name, "int"):
+		return "int64"
+	case strings.Contains(name, "char"), strings.Contains(name, "clob"), strings.Contains(name, "text"):
+		return "string"
+	case strings.Contains(name, "blob"):
+		return "bytes"
+	case strings.Contains(name, "real"), strings.Contains(name, "floa"), strings.Contains(name, "doub"):
+		return "double"
+	case strings.Contains(name, "bool"):
+		return "bool"
+	case strings.Contains(name, "date"), strings.Contains(name, "time"):
+		return timestampType
+	}
+	return ""
+}
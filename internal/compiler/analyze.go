@@ -7,6 +7,7 @@ import (
 	"github.com/sqlc-dev/sqlc/internal/config"
 	"github.com/sqlc-dev/sqlc/internal/source"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
 	"github.com/sqlc-dev/sqlc/internal/sql/named"
 	"github.com/sqlc-dev/sqlc/internal/sql/preprocess"
 	"github.com/sqlc-dev/sqlc/internal/sql/validate"
@@ -149,6 +150,7 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, pre *preprocess
 		if err := check(err); err != nil {
 			return nil, err
 		}
+		fillInsertColumns(c.catalog, table, n)
 		if err := check(validate.InsertStmt(c.catalog, table, n)); err != nil {
 			return nil, err
 		}
@@ -212,3 +214,32 @@ func (c *Compiler) _analyzeQuery(raw *ast.RawStmt, query string, pre *preprocess
 		Named:      namedParams,
 	}, rerr
 }
+
+// fillInsertColumns gives an INSERT without a column list the columns its
+// values are for: the table's, in order, less the generated ones, which
+// compute their own values. It leaves the list empty when the table does not
+// exist, which is reported where its columns are resolved.
+func fillInsertColumns(c *catalog.Catalog, fqn *ast.TableName, n *ast.InsertStmt) {
+	if fqn == nil || (n.Cols != nil && len(n.Cols.Items) > 0) {
+		return
+	}
+	// MySQL's INSERT INTO t () VALUES () gives no column and no value.
+	if sel, ok := n.SelectStmt.(*ast.SelectStmt); ok && sel.ValuesLists != nil && len(sel.ValuesLists.Items) > 0 {
+		if row, ok := sel.ValuesLists.Items[0].(*ast.List); ok && len(row.Items) == 0 {
+			return
+		}
+	}
+	table, err := c.GetTable(fqn)
+	if err != nil {
+		return
+	}
+	cols := &ast.List{}
+	for _, col := range table.Columns {
+		if col.IsGenerated {
+			continue
+		}
+		name := col.Name
+		cols.Items = append(cols.Items, &ast.ResTarget{Name: &name})
+	}
+	n.Cols = cols
+}
@@ -0,0 +1,58 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/sqlc-dev/sqlc/internal/constants"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+)
+
+// applyTypeOverrides applies a query's @param and @column annotations to the
+// parameters and result columns they name. An annotation's nullability is
+// settled here; its type is recorded for codegen, since only the plugin knows
+// whether it names a database type or one of its own.
+func applyTypeOverrides(q *Query) error {
+	var params []*Column
+	for _, p := range q.Params {
+		params = append(params, p.Column)
+	}
+	if err := applyAnnotations(constants.QueryFlagParam, "parameter", q.Metadata.Params, params); err != nil {
+		return err
+	}
+	return applyAnnotations(constants.QueryFlagColumn, "column", q.Metadata.Columns, q.Columns)
+}
+
+func applyAnnotations(flag, kind string, annotations map[string]string, cols []*Column) error {
+	// Walk the annotations in a fixed order so that a query with several bad
+	// ones always reports the same one.
+	names := make([]string, 0, len(annotations))
+	for name := range annotations {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		o, err := metadata.ParseTypeOverride(annotations[name])
+		if err != nil {
+			return fmt.Errorf("%s %s: %w", flag, name, err)
+		}
+		var found bool
+		for _, col := range cols {
+			if col == nil || col.Name != name {
+				continue
+			}
+			found = true
+			if o.Nullable != nil {
+				col.NotNull = !*o.Nullable
+			}
+			if o.Type != "" {
+				col.TypeOverride = o.Type
+			}
+		}
+		if !found {
+			return fmt.Errorf("%s %s: query has no %s named %q", flag, name, kind, name)
+		}
+	}
+	return nil
+}
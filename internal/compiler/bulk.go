@@ -0,0 +1,39 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+	"github.com/sqlc-dev/sqlc/internal/sql/preprocess"
+)
+
+// bulkMarker brackets the VALUES row of a :bulkexec query, which generated
+// code repeats once per record.
+const bulkMarker = "/*BULK*/"
+
+// applyBulk marks the VALUES row of a :bulkexec query for generated code to
+// repeat. The row has to hold every parameter, so that each copy of it binds
+// exactly one record.
+func (c *Compiler) applyBulk(q *Query) error {
+	if q.Metadata.Cmd != metadata.CmdBulkExec {
+		return nil
+	}
+	switch c.conf.Engine {
+	case config.EnginePostgreSQL, config.EngineMySQL, config.EngineSQLite:
+	default:
+		return fmt.Errorf(":bulkexec is not supported by the %s engine", c.conf.Engine)
+	}
+	for _, p := range q.Params {
+		if p.Column != nil && p.Column.IsSqlcSlice {
+			return errors.New(":bulkexec is not compatible with sqlc.slice")
+		}
+	}
+	start, end, err := preprocess.ValuesRow(c.conf.Engine, q.SQL)
+	if err != nil {
+		return err
+	}
+	q.SQL = q.SQL[:start] + bulkMarker + q.SQL[start:end] + bulkMarker + q.SQL[end:]
+	return nil
+}
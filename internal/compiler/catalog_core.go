@@ -11,46 +11,102 @@ import (
 // coreResultCatalog dumps the core catalog into the legacy catalog shape a
 // Result carries, so codegen sees the same table models either way a query
 // set was analyzed. Only relations make the trip: codegen reads tables and
-// their columns to build models, and none of the types, functions or
-// operators the core catalog also holds.
+// their columns to build models, :paginate checks their keys, and nothing
+// reads the types, functions or operators the core catalog also holds. The
+// catalog is named after the database the configuration calls the schema's
+// own, which its tables carry as their catalog too, and its default schema is
+// the one the search path puts first.
 func coreResultCatalog(c *core.Catalog) (*catalog.Catalog, error) {
-	cat := catalog.New("public")
+	cat := catalog.New(c.CurrentSchema())
+	cat.Name = c.Settings().Database
+	cat.TableSearchPath = c.SearchPath()
 	namespaces, err := c.Namespaces()
 	if err != nil {
 		return nil, err
 	}
 	for _, ns := range namespaces {
+		// catalog.New already holds an empty default schema, which lookups
+		// such as GetTable find first.
 		schema := &catalog.Schema{Name: ns.Name}
+		if ns.Name == cat.DefaultSchema {
+			schema = cat.Schemas[0]
+		}
 		tables, err := c.TablesInNamespace(ns.OID)
 		if err != nil {
 			return nil, err
 		}
 		for _, table := range tables {
-			cols, err := c.ClassCodegenColumns(table.OID)
+			t := &catalog.Table{Rel: &ast.TableName{Catalog: cat.Name, Schema: ns.Name, Name: table.Name}}
+			t.PrimaryKey, t.UniqueKeys, err = c.ClassKeys(table.OID)
+			if err != nil {
+				return nil, err
+			}
+			if t.Columns, err = coreResultColumns(c, table.OID); err != nil {
+				return nil, err
+			}
+			if t.Comment, err = c.ClassComment(table.OID); err != nil {
+				return nil, err
+			}
+			fks, err := c.ClassForeignKeys(table.OID)
 			if err != nil {
 				return nil, err
 			}
-			t := &catalog.Table{Rel: &ast.TableName{Schema: ns.Name, Name: table.Name}}
-			for _, col := range cols {
-				// The catalog names an array type after its element with the
-				// suffix appended, which is codegen's data type and array
-				// flag in one string. The core catalog holds one dimension,
-				// and codegen renders a "[]" per dimension.
-				dataType, isArray := strings.CutSuffix(col.TypeName, core.ArraySuffix)
-				column := &catalog.Column{
-					Name:      col.Name,
-					Type:      ast.TypeName{Name: dataType},
-					IsNotNull: col.NotNull,
-					IsArray:   isArray,
-				}
-				if isArray {
-					column.ArrayDims = 1
-				}
-				t.Columns = append(t.Columns, column)
+			for _, fk := range fks {
+				t.ForeignKeys = append(t.ForeignKeys, &ast.ForeignKey{
+					Name:       fk.Name,
+					Columns:    fk.Columns,
+					RefTable:   &ast.TableName{Schema: fk.RefSchema, Name: fk.RefTable},
+					RefColumns: fk.RefColumns,
+				})
 			}
 			schema.Tables = append(schema.Tables, t)
 		}
-		cat.Schemas = append(cat.Schemas, schema)
+		// A composite type, such as a SQL Server table type, is a type a
+		// parameter can have. Its columns are kept on a relation of the
+		// same name.
+		composites, err := c.ClassesOfKind(ns.OID, "c")
+		if err != nil {
+			return nil, err
+		}
+		for _, ct := range composites {
+			cols, err := coreResultColumns(c, ct.OID)
+			if err != nil {
+				return nil, err
+			}
+			schema.Types = append(schema.Types, &catalog.CompositeType{Name: ct.Name, Columns: cols})
+		}
+		if schema != cat.Schemas[0] {
+			cat.Schemas = append(cat.Schemas, schema)
+		}
 	}
 	return cat, nil
 }
+
+// coreResultColumns converts the columns of a relation in the core catalog.
+func coreResultColumns(c *core.Catalog, classOID int64) ([]*catalog.Column, error) {
+	cols, err := c.ClassCodegenColumns(classOID)
+	if err != nil {
+		return nil, err
+	}
+	var out []*catalog.Column
+	for _, col := range cols {
+		// The catalog names an array type after its element with the suffix
+		// appended, which is codegen's data type and array flag in one
+		// string. The core catalog holds one dimension, and codegen renders
+		// a "[]" per dimension.
+		dataType, isArray := strings.CutSuffix(col.TypeName, core.ArraySuffix)
+		column := &catalog.Column{
+			Name:      col.Name,
+			Type:      ast.TypeName{Name: dataType},
+			IsNotNull: col.NotNull,
+			IsArray:   isArray,
+			Comment:   col.Comment,
+			Default:   col.Default,
+		}
+		if isArray {
+			column.ArrayDims = 1
+		}
+		out = append(out, column)
+	}
+	return out, nil
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/sqlc-dev/sqlc/internal/core"
 	coreschema "github.com/sqlc-dev/sqlc/internal/core/schema"
+	"github.com/sqlc-dev/sqlc/internal/core/seed"
 	"github.com/sqlc-dev/sqlc/internal/migrations"
 	"github.com/sqlc-dev/sqlc/internal/multierr"
 	"github.com/sqlc-dev/sqlc/internal/opts"
@@ -58,14 +59,38 @@ func (c *Compiler) parseCatalog(schemas []string) error {
 		c.schema = append(c.schema, contents)
 	}
 
+	// Catalog files extend the dialect the core catalog is seeded with; the
+	// legacy catalogs have nothing to load them into.
+	if len(c.conf.Catalog) > 0 && !c.coreAnalysis {
+		return fmt.Errorf("catalog files require the core analyzer; set SQLCEXPERIMENT=coreanalyzer")
+	}
+	// Privileges are recorded in the core catalog alone, so only the core
+	// analyzer can check a query against them.
+	if c.conf.Role != "" && !c.coreAnalysis {
+		return fmt.Errorf("role requires the core analyzer; set SQLCEXPERIMENT=coreanalyzer")
+	}
+	// The legacy catalogs have one level of namespaces, below any database.
+	if c.conf.DefaultDatabase != "" && !c.coreAnalysis {
+		return fmt.Errorf("default_database requires the core analyzer; set SQLCEXPERIMENT=coreanalyzer")
+	}
+	extras := make([]schemaFile, 0, len(c.conf.Catalog))
+	for _, path := range c.conf.Catalog {
+		blob, err := os.ReadFile(path)
+		if err != nil {
+			merr.Add(path, "", 0, err)
+			continue
+		}
+		extras = append(extras, schemaFile{name: path, contents: string(blob)})
+	}
+
 	if c.coreAnalysis {
-		// A schema file that could not be read is not part of the schema the
-		// action was keyed on, so there is no catalog to restore and nothing
+		// A schema or catalog file that could not be read is not part of what
+		// the action was keyed on, so there is no catalog to restore and nothing
 		// further to report.
 		if len(merr.Errs()) > 0 {
 			return merr
 		}
-		if err := c.parseCatalogCore(files, merr); err != nil {
+		if err := c.parseCatalogCore(extras, files, merr); err != nil {
 			return err
 		}
 	} else {
@@ -101,16 +126,40 @@ func (c *Compiler) parseCatalogLegacy(files []schemaFile, merr *multierr.Error)
 }
 
 // parseCatalogCore builds the catalog the analysis core works against: the
-// dialect's seed with the schema's DDL applied. The two are one cached action,
+// dialect's seed, extended by the configuration's catalog files, with the
+// schema's DDL applied. The two are one cached action,
 // so a run that has built this catalog before restores it and never parses the
 // schema at all.
-func (c *Compiler) parseCatalogCore(files []schemaFile, merr *multierr.Error) error {
+func (c *Compiler) parseCatalogCore(extras, files []schemaFile, merr *multierr.Error) error {
+	catalogs := make([]string, 0, len(extras))
+	for _, file := range extras {
+		catalogs = append(catalogs, file.contents)
+	}
 	contents := make([]string, 0, len(files))
 	for _, file := range files {
 		contents = append(contents, file.contents)
 	}
 
-	cat, err := core.NewCached(string(c.conf.Engine), contents, func(cat *core.Catalog) error {
+	// A flavor seeds a different catalog than its engine does on its own.
+	dialect := string(c.conf.Engine)
+	if c.conf.Flavor != "" {
+		dialect += "/" + c.conf.Flavor
+	}
+	settings := core.Settings{
+		Database:   c.conf.DefaultDatabase,
+		SearchPath: c.conf.SearchPath,
+	}
+	cat, err := core.NewCached(dialect, settings, catalogs, contents, func(cat *core.Catalog) error {
+		for _, file := range extras {
+			if err := seed.Extra(cat, filepath.Base(file.name), strings.NewReader(file.contents)); err != nil {
+				merr.Add(file.name, "", 0, err)
+			}
+		}
+		// The schema names the types the catalog files declare, so it is not
+		// worth applying over a catalog that is missing some of them.
+		if len(merr.Errs()) > 0 {
+			return merr
+		}
 		for _, file := range files {
 			stmts, err := c.parser.Parse(strings.NewReader(file.contents))
 			if err != nil {
@@ -163,6 +212,7 @@ type statement struct {
 
 func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 	merr := multierr.New()
+	warnings := multierr.New()
 	files, err := sqlpath.Glob(c.conf.Queries)
 	if err != nil {
 		return nil, err
@@ -222,6 +272,9 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 			continue
 		}
 		query.Metadata.Filename = filepath.Base(stmt.filename)
+		for _, w := range query.warnings {
+			warnings.Add(stmt.filename, stmt.src, stmt.pp.Origin(stmt.raw.Pos()), w)
+		}
 		queryName := query.Metadata.Name
 		if queryName != "" {
 			if _, exists := set[queryName]; exists {
@@ -241,8 +294,9 @@ func (c *Compiler) parseQueries(o opts.Parser) (*Result, error) {
 	}
 
 	return &Result{
-		Catalog: c.catalog,
-		Queries: q,
+		Catalog:  c.catalog,
+		Queries:  q,
+		Warnings: warnings.Errs(),
 	}, nil
 }
 
@@ -72,7 +72,7 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings, parserOpts opts
 	}
 
 	if conf.Database != nil && conf.Database.Managed {
-		client := dbmanager.NewClient(combo.Global.Servers)
+		client := dbmanager.NewClient(combo.Global.Servers, combo.Global.Environ())
 		c.client = client
 	}
 
@@ -85,7 +85,7 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings, parserOpts opts
 		if conf.Database != nil {
 			if conf.Analyzer.Database == nil || *conf.Analyzer.Database {
 				c.analyzer = analyzer.Cached(
-					sqliteanalyze.New(*conf.Database),
+					sqliteanalyze.New(*conf.Database, combo.Global.Environ()),
 					combo.Global,
 					*conf.Database,
 				)
@@ -103,7 +103,7 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings, parserOpts opts
 		if conf.Database != nil {
 			if conf.Analyzer.Database == nil || *conf.Analyzer.Database {
 				c.analyzer = analyzer.Cached(
-					pganalyze.New(c.client, *conf.Database),
+					pganalyze.New(c.client, *conf.Database, combo.Global.Environ()),
 					combo.Global,
 					*conf.Database,
 				)
@@ -112,6 +112,7 @@ func NewCompiler(conf config.SQL, combo config.CombinedSettings, parserOpts opts
 	default:
 		return nil, fmt.Errorf("unknown engine: %s", conf.Engine)
 	}
+	c.catalog.TableSearchPath = conf.SearchPath
 	return c, nil
 }
 
@@ -139,7 +140,7 @@ func (c *Compiler) initCore() error {
 	case config.EngineGoogleSQL:
 		c.parser = googlesql.NewParser()
 		c.selector = newDefaultSelector()
-		dialect = googlesql.Dialect()
+		dialect = googlesql.DialectFlavor(c.conf.Flavor)
 	case config.EngineMSSQL:
 		c.parser = mssql.NewParser()
 		c.selector = newDefaultSelector()
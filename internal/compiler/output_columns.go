@@ -476,6 +476,9 @@ func (r *tableVisitor) Visit(n ast.Node) astutils.Visitor {
 	case *ast.RangeVar, *ast.RangeFunction:
 		r.list.Items = append(r.list.Items, n)
 		return r
+	case *ast.RangeTableFunc:
+		r.list.Items = append(r.list.Items, n)
+		return nil
 	case *ast.RangeSubselect:
 		r.list.Items = append(r.list.Items, n)
 		return nil
@@ -609,6 +612,35 @@ func (c *Compiler) sourceTables(qc *QueryCatalog, node ast.Node) ([]*Table, erro
 			}
 			tables = append(tables, table)
 
+		case *ast.RangeTableFunc:
+			// A table function such as JSON_TABLE declares the columns it
+			// produces. A path that matches nothing yields NULL.
+			table := &Table{Rel: &ast.TableName{}}
+			if n.Alias != nil && n.Alias.Aliasname != nil {
+				table.Rel.Name = *n.Alias.Aliasname
+			}
+			for _, item := range n.Columns.Items {
+				col, ok := item.(*ast.RangeTableFuncCol)
+				if !ok || col.Colname == nil {
+					continue
+				}
+				if col.ForOrdinality {
+					table.Columns = append(table.Columns, &Column{
+						Name:     *col.Colname,
+						DataType: "int",
+						NotNull:  true,
+					})
+					continue
+				}
+				table.Columns = append(table.Columns, &Column{
+					Name:     *col.Colname,
+					DataType: dataType(col.TypeName),
+					NotNull:  col.IsNotNull,
+					Type:     col.TypeName,
+				})
+			}
+			tables = append(tables, table)
+
 		case *ast.RangeSubselect:
 			cols, err := c.outputColumns(qc, n.Subquery)
 			if err != nil {
@@ -0,0 +1,302 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/metadata"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+)
+
+// Pagination describes the keyset of a :paginate query.
+type Pagination struct {
+	// Columns are the ORDER BY keys, as indexes into the query's columns.
+	Columns    []int
+	Descending bool
+}
+
+// applyPagination checks that a :paginate query's ORDER BY is a keyset, then
+// wraps the query so that one page can be read after a cursor. The wrapped
+// query takes three kinds of extra parameters after the query's own: a flag
+// that is true for the first page, one value per key from the cursor, and the
+// page size.
+func (c *Compiler) applyPagination(q *Query) error {
+	if q.Metadata.Cmd != metadata.CmdPaginate {
+		return nil
+	}
+	switch c.conf.Engine {
+	case config.EnginePostgreSQL, config.EngineMySQL, config.EngineSQLite:
+	default:
+		return fmt.Errorf(":paginate is not supported by the %s engine", c.conf.Engine)
+	}
+	for _, p := range q.Params {
+		if p.Column != nil && p.Column.IsSqlcSlice {
+			return errors.New(":paginate is not compatible with sqlc.slice")
+		}
+	}
+	// The paginated query selects from the original one as a subquery and
+	// names the ORDER BY columns, which needs every name to pick out one
+	// column.
+	names := map[string]bool{}
+	for _, col := range q.Columns {
+		if col.EmbedTable != nil {
+			return errors.New(":paginate is not compatible with sqlc.embed")
+		}
+		if names[col.Name] {
+			return fmt.Errorf(":paginate requires every result column to have a distinct name; give the columns named %q an alias with AS", col.Name)
+		}
+		names[col.Name] = true
+	}
+
+	stmt, ok := q.RawStmt.Stmt.(*ast.SelectStmt)
+	if !ok {
+		return errors.New(":paginate requires a SELECT statement")
+	}
+	page := &Pagination{}
+	for i, item := range astutils.SortItems(stmt) {
+		sb, ok := item.(*ast.SortBy)
+		if !ok {
+			return errors.New(":paginate requires an ORDER BY clause")
+		}
+		switch sb.SortbyDir {
+		case ast.SortByDirUndefined, ast.SortByDirDefault, ast.SortByDirAsc:
+			if page.Descending && i > 0 {
+				return errors.New(":paginate requires every ORDER BY key to sort in the same direction")
+			}
+		case ast.SortByDirDesc:
+			if !page.Descending && i > 0 {
+				return errors.New(":paginate requires every ORDER BY key to sort in the same direction")
+			}
+			page.Descending = true
+		default:
+			return errors.New(":paginate does not support ORDER BY ... USING")
+		}
+		if sb.SortbyNulls != ast.SortByNullsUndefined && sb.SortbyNulls != ast.SortByNullsDefault {
+			return errors.New(":paginate does not support NULLS FIRST or NULLS LAST")
+		}
+		idx, err := sortKeyColumn(q.Columns, sb.Node)
+		if err != nil {
+			return err
+		}
+		col := q.Columns[idx]
+		if !col.NotNull {
+			return fmt.Errorf(":paginate requires ORDER BY column %q to be NOT NULL", col.Name)
+		}
+		if slices.Contains(page.Columns, idx) {
+			return fmt.Errorf(":paginate ORDER BY names column %q twice", col.Name)
+		}
+		page.Columns = append(page.Columns, idx)
+	}
+	if !c.isUniqueKeyset(stmt, q.Columns, page.Columns) {
+		return errors.New(":paginate requires the ORDER BY columns to include a primary key or unique key of every table in FROM")
+	}
+
+	q.SQL = c.paginatedSQL(q, page)
+	q.Pagination = page
+	return nil
+}
+
+// sortKeyColumn finds the result column an ORDER BY key names. A key has to
+// be a column the query returns, by output name, source name or position, so
+// that the cursor can be built from the last row of a page.
+func sortKeyColumn(cols []*Column, node ast.Node) (int, error) {
+	switch n := node.(type) {
+	case *ast.A_Const:
+		if v, ok := n.Val.(*ast.Integer); ok && v.Ival >= 1 && int(v.Ival) <= len(cols) {
+			return int(v.Ival) - 1, nil
+		}
+	case *ast.ColumnRef:
+		var parts []string
+		if n.Fields != nil {
+			for _, f := range n.Fields.Items {
+				if s, ok := f.(*ast.String); ok {
+					parts = append(parts, s.Str)
+				}
+			}
+		} else if n.Name != "" {
+			parts = []string{n.Name}
+		}
+		if len(parts) == 0 {
+			break
+		}
+		name := parts[len(parts)-1]
+		qualifier := ""
+		if len(parts) > 1 {
+			qualifier = parts[len(parts)-2]
+		}
+		matches := func(byName func(*Column) bool) []int {
+			var out []int
+			for i, col := range cols {
+				if !byName(col) {
+					continue
+				}
+				if qualifier != "" && col.TableAlias != qualifier && (col.Table == nil || col.Table.Name != qualifier) {
+					continue
+				}
+				out = append(out, i)
+			}
+			return out
+		}
+		found := matches(func(col *Column) bool { return col.Name == name })
+		if len(found) == 0 {
+			found = matches(func(col *Column) bool { return col.OriginalName == name })
+		}
+		switch len(found) {
+		case 1:
+			return found[0], nil
+		case 0:
+			return 0, fmt.Errorf(":paginate requires ORDER BY column %q to be in the query's results", name)
+		default:
+			return 0, fmt.Errorf(":paginate ORDER BY column %q is ambiguous", name)
+		}
+	}
+	return 0, errors.New(":paginate requires every ORDER BY key to be a column")
+}
+
+// isUniqueKeyset reports whether the key columns include every column of a
+// primary key or unique key of each relation in FROM, which makes the order of
+// the rows total. A join repeats the rows of one side for every match on the
+// other, so the key of a single table is not enough. Relations whose keys are
+// not known, such as subqueries and functions, can never be covered.
+func (c *Compiler) isUniqueKeyset(stmt *ast.SelectStmt, cols []*Column, keys []int) bool {
+	rels, ok := fromRelations(stmt.FromClause)
+	if !ok || len(rels) == 0 {
+		return false
+	}
+	ctes := map[string]bool{}
+	if stmt.WithClause != nil && stmt.WithClause.Ctes != nil {
+		for _, item := range stmt.WithClause.Ctes.Items {
+			if cte, ok := item.(*ast.CommonTableExpr); ok && cte.Ctename != nil {
+				ctes[*cte.Ctename] = true
+			}
+		}
+	}
+	for _, rv := range rels {
+		if rv.Relname == nil || (rv.Schemaname == nil && ctes[*rv.Relname]) {
+			return false
+		}
+		name, err := ParseTableName(rv)
+		if err != nil {
+			return false
+		}
+		table, err := c.catalog.GetTable(name)
+		if err != nil || table.Rel == nil {
+			return false
+		}
+		alias := *rv.Relname
+		if rv.Alias != nil && rv.Alias.Aliasname != nil {
+			alias = *rv.Alias.Aliasname
+		}
+		var names []string
+		for _, idx := range keys {
+			col := cols[idx]
+			if col.Table == nil {
+				continue
+			}
+			if col.TableAlias != "" && col.TableAlias != alias && col.TableAlias != *rv.Relname {
+				continue
+			}
+			source, err := c.catalog.GetTable(col.Table)
+			if err != nil || source.Rel == nil || *source.Rel != *table.Rel {
+				continue
+			}
+			name := col.OriginalName
+			if name == "" {
+				name = col.Name
+			}
+			names = append(names, name)
+		}
+		covered := slices.ContainsFunc(table.Keys(), func(key []string) bool {
+			return !slices.ContainsFunc(key, func(k string) bool { return !slices.Contains(names, k) })
+		})
+		if !covered {
+			return false
+		}
+	}
+	return true
+}
+
+// fromRelations returns the tables a FROM clause reads, looking through
+// joins. It reports false when the clause reads anything but tables.
+func fromRelations(from *ast.List) ([]*ast.RangeVar, bool) {
+	var rels []*ast.RangeVar
+	var walk func(ast.Node) bool
+	walk = func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.RangeVar:
+			rels = append(rels, n)
+			return true
+		case *ast.JoinExpr:
+			return walk(n.Larg) && walk(n.Rarg)
+		default:
+			return false
+		}
+	}
+	if from == nil {
+		return nil, true
+	}
+	for _, item := range from.Items {
+		if !walk(item) {
+			return nil, false
+		}
+	}
+	return rels, true
+}
+
+// paginatedSQL wraps a query so that its results can be filtered by the
+// cursor and cut to a page. The keys are compared as a row value, which
+// PostgreSQL, MySQL and SQLite all order lexicographically.
+func (c *Compiler) paginatedSQL(q *Query, page *Pagination) string {
+	quote := func(name string) string {
+		if c.conf.Engine == config.EngineMySQL {
+			return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+		}
+		return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+	}
+	next := 0
+	for _, p := range q.Params {
+		next = max(next, p.Number)
+	}
+	placeholder := func() string {
+		next++
+		if c.conf.Engine == config.EnginePostgreSQL {
+			return "$" + strconv.Itoa(next)
+		}
+		return "?"
+	}
+
+	first := placeholder()
+	var keys, values, order []string
+	for _, idx := range page.Columns {
+		name := quote(q.Columns[idx].Name)
+		keys = append(keys, name)
+		values = append(values, placeholder())
+		if page.Descending {
+			name += " DESC"
+		}
+		order = append(order, name)
+	}
+	op := ">"
+	if page.Descending {
+		op = "<"
+	}
+	key, value := keys[0], values[0]
+	if len(keys) > 1 {
+		key = "(" + strings.Join(keys, ", ") + ")"
+		value = "(" + strings.Join(values, ", ") + ")"
+	}
+
+	var b strings.Builder
+	b.WriteString("SELECT * FROM (\n")
+	b.WriteString(strings.TrimSpace(q.SQL))
+	b.WriteString("\n) AS sqlc_page\n")
+	fmt.Fprintf(&b, "WHERE %s OR %s %s %s\n", first, key, op, value)
+	fmt.Fprintf(&b, "ORDER BY %s\n", strings.Join(order, ", "))
+	fmt.Fprintf(&b, "LIMIT %s", placeholder())
+	return b.String()
+}
@@ -82,6 +82,10 @@ func (c *Compiler) parseQuery(stmt ast.Node, pp *preprocess.Result, o opts.Parse
 	if err != nil {
 		return nil, err
 	}
+	md.Columns, err = metadata.ParseCommentColumns(cleanedComments)
+	if err != nil {
+		return nil, err
+	}
 
 	var anlys *analysis
 	if c.analyzer != nil {
@@ -135,14 +139,28 @@ func (c *Compiler) parseQuery(stmt ast.Node, pp *preprocess.Result, o opts.Parse
 
 	md.Comments = comments
 
-	return &Query{
+	query := &Query{
 		RawStmt:         raw,
 		Metadata:        md,
 		Params:          anlys.Parameters,
 		Columns:         anlys.Columns,
 		SQL:             trimmed,
 		InsertIntoTable: anlys.Table,
-	}, nil
+	}
+	if err := applyTypeOverrides(query); err != nil {
+		return nil, err
+	}
+	if err := c.applyPagination(query); err != nil {
+		return nil, err
+	}
+	if err := c.applyBulk(query); err != nil {
+		return nil, err
+	}
+	if err := applyReadOnly(query); err != nil {
+		return nil, err
+	}
+	c.applyTables(query)
+	return query, nil
 }
 
 // renumberParams applies the parameter numbers the preprocessor assigned,
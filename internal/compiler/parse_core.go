@@ -43,6 +43,10 @@ func (c *Compiler) parseQueryCore(raw *ast.RawStmt, src string, pre *preprocess.
 	if err != nil {
 		return nil, err
 	}
+	md.Columns, err = metadata.ParseCommentColumns(cleanedComments)
+	if err != nil {
+		return nil, err
+	}
 
 	if pre.ParamErr != nil {
 		return nil, pre.ParamErr
@@ -52,8 +56,9 @@ func (c *Compiler) parseQueryCore(raw *ast.RawStmt, src string, pre *preprocess.
 
 	var cols []*Column
 	var params []Parameter
+	var access []core.Access
 	switch raw.Stmt.(type) {
-	case *ast.SelectStmt, *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+	case *ast.SelectStmt, *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt, *ast.CallStmt:
 		res, err := coreanalyzer.Prepare(c.coreCatalog, raw)
 		if err != nil {
 			return nil, err
@@ -64,6 +69,7 @@ func (c *Compiler) parseQueryCore(raw *ast.RawStmt, src string, pre *preprocess.
 		for _, p := range res.Parameters {
 			params = append(params, Parameter{Number: p.Number, Column: coreParamColumn(p, namedParams)})
 		}
+		access = res.Access
 	}
 
 	trimmed, comments, err := source.StripComments(expanded)
@@ -77,14 +83,31 @@ func (c *Compiler) parseQueryCore(raw *ast.RawStmt, src string, pre *preprocess.
 		insertTable, _ = ParseTableName(ins.Relation)
 	}
 
-	return &Query{
+	query := &Query{
 		RawStmt:         raw,
 		Metadata:        md,
 		Params:          params,
 		Columns:         cols,
 		SQL:             trimmed,
 		InsertIntoTable: insertTable,
-	}, nil
+	}
+	if err := applyTypeOverrides(query); err != nil {
+		return nil, err
+	}
+	if err := c.applyPagination(query); err != nil {
+		return nil, err
+	}
+	if err := c.applyBulk(query); err != nil {
+		return nil, err
+	}
+	if err := applyReadOnly(query); err != nil {
+		return nil, err
+	}
+	c.applyTables(query)
+	if err := c.applySecurity(query, access); err != nil {
+		return nil, err
+	}
+	return query, nil
 }
 
 func coreColumn(c core.Column) *Column {
@@ -100,7 +123,7 @@ func coreColumn(c core.Column) *Column {
 		col.ArrayDims = 1
 	}
 	if c.Source != nil && c.Source.Table != "" {
-		col.Table = &ast.TableName{Schema: c.Source.Schema, Name: c.Source.Table}
+		col.Table = &ast.TableName{Catalog: c.Source.Database, Schema: c.Source.Schema, Name: c.Source.Table}
 		col.TableAlias = c.Source.TableAlias
 		col.OriginalName = c.Source.Column
 	}
@@ -122,7 +145,7 @@ func coreParamColumn(p core.Parameter, params *named.ParamSet) *Column {
 		col.ArrayDims = 1
 	}
 	if p.Source != nil && p.Source.Table != "" {
-		col.Table = &ast.TableName{Schema: p.Source.Schema, Name: p.Source.Table}
+		col.Table = &ast.TableName{Catalog: p.Source.Database, Schema: p.Source.Schema, Name: p.Source.Table}
 		col.OriginalName = p.Source.Column
 	}
 	if col.Name == "" && p.Source != nil {
@@ -0,0 +1,89 @@
+package compiler
+
+import (
+	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/core"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+)
+
+// policyCommands names the commands sql_policy records by letter.
+var policyCommands = map[string]string{
+	"*": "ALL",
+	"r": "SELECT",
+	"a": "INSERT",
+	"w": "UPDATE",
+	"d": "DELETE",
+}
+
+// applySecurity records the owner, row-level security and policies of each
+// table a query accesses, and, when the configuration names the role the
+// application connects as, a warning for each privilege that role lacks.
+func (c *Compiler) applySecurity(q *Query, access []core.Access) error {
+	seen := map[int64]bool{}
+	for _, acc := range access {
+		sec, err := c.coreCatalog.ClassSecurity(acc.ClassOID)
+		if err != nil {
+			return err
+		}
+		if !seen[acc.ClassOID] {
+			seen[acc.ClassOID] = true
+			ts, err := c.tableSecurity(acc.ClassOID, sec)
+			if err != nil {
+				return err
+			}
+			q.Security = append(q.Security, ts)
+		}
+		if c.conf.Role == "" {
+			continue
+		}
+		table := sec.Name
+		if sec.Schema != core.DefaultNamespace {
+			table = sec.Schema + "." + sec.Name
+		}
+		if len(acc.Columns) == 0 {
+			ok, err := c.coreCatalog.HasPrivilege(c.conf.Role, acc.ClassOID, acc.Privilege, "")
+			if err != nil {
+				return err
+			}
+			if !ok {
+				q.warnings = append(q.warnings, fmt.Errorf("role %q has no %s privilege on table %q", c.conf.Role, acc.Privilege, table))
+			}
+			continue
+		}
+		for _, col := range acc.Columns {
+			ok, err := c.coreCatalog.HasPrivilege(c.conf.Role, acc.ClassOID, acc.Privilege, col)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				q.warnings = append(q.warnings, fmt.Errorf("role %q has no %s privilege on column %q of table %q", c.conf.Role, acc.Privilege, col, table))
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Compiler) tableSecurity(classOID int64, sec core.ClassSecurity) (*TableSecurity, error) {
+	policies, err := c.coreCatalog.ClassPolicies(classOID)
+	if err != nil {
+		return nil, err
+	}
+	ts := &TableSecurity{
+		Rel:              &ast.TableName{Schema: sec.Schema, Name: sec.Name},
+		Owner:            sec.Owner,
+		RowSecurity:      sec.RowSecurity,
+		ForceRowSecurity: sec.ForceRowSecurity,
+	}
+	for _, p := range policies {
+		ts.Policies = append(ts.Policies, Policy{
+			Name:       p.Name,
+			Command:    policyCommands[p.Command],
+			Permissive: p.Permissive,
+			Roles:      p.Roles,
+			HasUsing:   p.HasUsing,
+			HasCheck:   p.HasCheck,
+		})
+	}
+	return ts, nil
+}
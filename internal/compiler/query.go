@@ -39,6 +39,8 @@ type Column struct {
 
 	IsSqlcSlice bool // is this sqlc.slice()
 
+	TypeOverride string // type named by an @param or @column annotation
+
 	skipTableRequiredCheck bool
 }
 
@@ -51,10 +53,47 @@ type Query struct {
 	// Needed for CopyFrom
 	InsertIntoTable *ast.TableName
 
+	// Needed for :paginate
+	Pagination *Pagination
+
+	// ReadOnly is set for queries that a read replica can serve
+	ReadOnly bool
+
+	// Tables are the tables and views the query reads or writes
+	Tables []*ast.TableName
+
+	// Security is what the core catalog records about the access to each
+	// table the query reads or writes. Needed for vet
+	Security []*TableSecurity
+
+	// warnings are the privileges the configured role lacks to run the query
+	warnings []error
+
 	// Needed for vet
 	RawStmt *ast.RawStmt
 }
 
+// TableSecurity is who owns a table and the row-level security that governs
+// it.
+type TableSecurity struct {
+	Rel              *ast.TableName
+	Owner            string
+	RowSecurity      bool
+	ForceRowSecurity bool
+	Policies         []Policy
+}
+
+// Policy is a row-level security policy. Command is ALL, SELECT, INSERT,
+// UPDATE or DELETE, and no roles means the policy applies to PUBLIC.
+type Policy struct {
+	Name       string
+	Command    string
+	Permissive bool
+	Roles      []string
+	HasUsing   bool
+	HasCheck   bool
+}
+
 type Parameter struct {
 	Number int
 	Column *Column
@@ -86,6 +86,11 @@ func (qc QueryCatalog) GetTable(rel *ast.TableName) (*Table, error) {
 	if err != nil {
 		return nil, err
 	}
+	// An unqualified name outside the default schema is in whichever schema
+	// the search path found it in.
+	if rel.Schema == "" && src.Rel != nil && src.Rel.Schema != "" && src.Rel.Schema != qc.catalog.DefaultSchema {
+		rel = &ast.TableName{Catalog: rel.Catalog, Schema: src.Rel.Schema, Name: rel.Name}
+	}
 	var cols []*Column
 	for _, c := range src.Columns {
 		cols = append(cols, ConvertColumn(rel, c))
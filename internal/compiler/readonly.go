@@ -0,0 +1,69 @@
+package compiler
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/constants"
+	"github.com/sqlc-dev/sqlc/internal/core"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+)
+
+// applyReadOnly decides whether a query can be sent to a read replica. A
+// SELECT is read-only unless it locks rows, creates a table or modifies data
+// in a WITH clause. The @readonly and @readwrite annotations override the
+// classification, for example for a SELECT that calls a function with side
+// effects, but @readonly is refused for statements that write.
+func applyReadOnly(q *Query) error {
+	readOnly := q.Metadata.Flags[constants.QueryFlagReadOnly]
+	readWrite := q.Metadata.Flags[constants.QueryFlagReadWrite]
+	if readOnly && readWrite {
+		return errors.New("@readonly and @readwrite cannot be used together")
+	}
+	cmd := statementCommand(q.RawStmt.Stmt)
+	switch {
+	case readWrite:
+		q.ReadOnly = false
+	case readOnly:
+		if cmd != "" && !cmd.ReadOnly() {
+			return fmt.Errorf("@readonly cannot be used with %s statements", cmd)
+		}
+		q.ReadOnly = true
+	default:
+		q.ReadOnly = cmd.ReadOnly() && !writesData(q.RawStmt.Stmt)
+	}
+	return nil
+}
+
+// statementCommand returns the command a statement runs, or an empty command
+// for statements other than SELECT, INSERT, UPDATE and DELETE.
+func statementCommand(stmt ast.Node) core.Command {
+	switch stmt.(type) {
+	case *ast.SelectStmt:
+		return core.CommandSelect
+	case *ast.InsertStmt:
+		return core.CommandInsert
+	case *ast.UpdateStmt:
+		return core.CommandUpdate
+	case *ast.DeleteStmt:
+		return core.CommandDelete
+	default:
+		return ""
+	}
+}
+
+// writesData reports whether a SELECT does anything a replica would refuse:
+// a nested INSERT, UPDATE or DELETE, a locking clause or SELECT INTO.
+func writesData(stmt ast.Node) bool {
+	found := astutils.Search(stmt, func(node ast.Node) bool {
+		switch n := node.(type) {
+		case *ast.InsertStmt, *ast.UpdateStmt, *ast.DeleteStmt:
+			return true
+		case *ast.SelectStmt:
+			return (n.LockingClause != nil && len(n.LockingClause.Items) > 0) || n.IntoClause != nil
+		}
+		return false
+	})
+	return len(found.Items) > 0
+}
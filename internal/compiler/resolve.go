@@ -77,6 +77,11 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 			return nil, err
 		}
 		if rv.Alias != nil {
+			// An unqualified name is in whichever schema the search path
+			// found it in.
+			if fqn.Schema == "" {
+				fqn.Schema = table.Rel.Schema
+			}
 			aliasMap[*rv.Alias.Aliasname] = fqn
 		}
 	}
@@ -472,7 +477,15 @@ func (comp *Compiler) resolveCatalogRefs(qc *QueryCatalog, rvs []*ast.RangeVar,
 				rel = fqn.Name
 			}
 			if schema == "" {
+				// An unqualified name is in the first schema on the search
+				// path holding a table by that name.
 				schema = c.DefaultSchema
+				for _, ns := range c.TableSearchPath {
+					if _, ok := typeMap[ns][rel]; ok {
+						schema = ns
+						break
+					}
+				}
 			}
 
 			tableMap, ok := typeMap[schema][rel]
@@ -1,10 +1,15 @@
 package compiler
 
 import (
+	"github.com/sqlc-dev/sqlc/internal/multierr"
 	"github.com/sqlc-dev/sqlc/internal/sql/catalog"
 )
 
 type Result struct {
 	Catalog *catalog.Catalog
 	Queries []*Query
+
+	// Warnings are problems that do not stop code generation, such as a
+	// query the configured role lacks the privileges to run.
+	Warnings []*multierr.FileError
 }
@@ -0,0 +1,52 @@
+package compiler
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+)
+
+// applyTables records the tables and views of the catalog that a query reads
+// or writes, in the order the query first names them. A name a WITH clause
+// binds is the query's own and is left out, as is anything else the catalog
+// has no table for.
+func (c *Compiler) applyTables(q *Query) {
+	ctes := map[string]bool{}
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		if cte, ok := node.(*ast.CommonTableExpr); ok && cte.Ctename != nil {
+			ctes[*cte.Ctename] = true
+		}
+	}), q.RawStmt)
+
+	seen := map[string]bool{}
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		rv, ok := node.(*ast.RangeVar)
+		if !ok || rv.Relname == nil {
+			return
+		}
+		if rv.Schemaname == nil && ctes[*rv.Relname] {
+			return
+		}
+		name, err := ParseTableName(rv)
+		if err != nil {
+			return
+		}
+		table, err := c.catalog.GetTable(name)
+		if err != nil {
+			return
+		}
+		// An unqualified name is in whichever schema the search path found
+		// it in.
+		if name.Schema == "" {
+			name.Schema = table.Rel.Schema
+		}
+		if name.Schema == "" {
+			name.Schema = c.catalog.DefaultSchema
+		}
+		key := name.Schema + "." + name.Name
+		if seen[key] {
+			return
+		}
+		seen[key] = true
+		q.Tables = append(q.Tables, name)
+	}), q.RawStmt)
+}
@@ -59,6 +59,13 @@ const (
 	EngineMSSQL      Engine = "mssql"
 )
 
+// The flavors of GoogleSQL, the databases that speak it. Their type and
+// function sets differ.
+const (
+	FlavorSpanner  = "spanner"
+	FlavorBigQuery = "bigquery"
+)
+
 type Config struct {
 	Version   string               `json:"version" yaml:"version"`
 	Cloud     Cloud                `json:"cloud" yaml:"cloud"`
@@ -68,6 +75,11 @@ type Config struct {
 	Plugins   []Plugin             `json:"plugins" yaml:"plugins"`
 	Rules     []Rule               `json:"rules" yaml:"rules"`
 	Options   map[string]yaml.Node `json:"options" yaml:"options"`
+	// EnvFile is read while the configuration is parsed, to expand the
+	// environment variables it refers to.
+	EnvFile Paths `json:"env_file,omitempty" yaml:"env_file,omitempty"`
+	// env holds the variables read from EnvFile, for Environ.
+	env []string
 }
 
 type Server struct {
@@ -114,8 +126,13 @@ type Overrides struct {
 type SQL struct {
 	Name                 string    `json:"name" yaml:"name"`
 	Engine               Engine    `json:"engine,omitempty" yaml:"engine"`
+	Flavor               string    `json:"flavor,omitempty" yaml:"flavor"`
 	Schema               Paths     `json:"schema" yaml:"schema"`
 	Queries              Paths     `json:"queries" yaml:"queries"`
+	Catalog              Paths     `json:"catalog,omitempty" yaml:"catalog"`
+	Role                 string    `json:"role,omitempty" yaml:"role"`
+	DefaultDatabase      string    `json:"default_database,omitempty" yaml:"default_database"`
+	SearchPath           []string  `json:"search_path,omitempty" yaml:"search_path"`
 	Database             *Database `json:"database" yaml:"database"`
 	StrictFunctionChecks bool      `json:"strict_function_checks" yaml:"strict_function_checks"`
 	StrictOrderBy        *bool     `json:"strict_order_by" yaml:"strict_order_by"`
@@ -129,6 +146,10 @@ type Analyzer struct {
 	Database *bool `json:"database" yaml:"database"`
 }
 
+// BuiltinDocs names the schema documentation generator, which a codegen
+// entry can use without declaring a plugin.
+const BuiltinDocs = "docs"
+
 // TODO: Figure out a better name for this
 type Codegen struct {
 	Out     string    `json:"out" yaml:"out"`
@@ -137,14 +158,26 @@ type Codegen struct {
 }
 
 type SQLGen struct {
-	Go   *golang.Options `json:"go,omitempty" yaml:"go"`
-	JSON *SQLJSON        `json:"json,omitempty" yaml:"json"`
+	Go    *golang.Options `json:"go,omitempty" yaml:"go"`
+	JSON  *SQLJSON        `json:"json,omitempty" yaml:"json"`
+	Proto *SQLProto       `json:"proto,omitempty" yaml:"proto"`
 }
 
 type SQLJSON struct {
-	Out      string `json:"out" yaml:"out"`
-	Indent   string `json:"indent,omitempty" yaml:"indent"`
-	Filename string `json:"filename,omitempty" yaml:"filename"`
+	Out                 string `json:"out" yaml:"out"`
+	Indent              string `json:"indent,omitempty" yaml:"indent"`
+	Filename            string `json:"filename,omitempty" yaml:"filename"`
+	Format              string `json:"format,omitempty" yaml:"format"`
+	EmitExactTableNames bool   `json:"emit_exact_table_names,omitempty" yaml:"emit_exact_table_names"`
+}
+
+type SQLProto struct {
+	Out                 string `json:"out" yaml:"out"`
+	Package             string `json:"package,omitempty" yaml:"package"`
+	GoPackage           string `json:"go_package,omitempty" yaml:"go_package"`
+	Service             string `json:"service,omitempty" yaml:"service"`
+	Filename            string `json:"filename,omitempty" yaml:"filename"`
+	EmitExactTableNames bool   `json:"emit_exact_table_names,omitempty" yaml:"emit_exact_table_names"`
 }
 
 var ErrMissingEngine = errors.New("unknown engine")
@@ -154,7 +187,9 @@ var ErrNoPackagePath = errors.New("missing package path")
 var ErrNoPackages = errors.New("no packages")
 var ErrNoQuerierType = errors.New("no querier emit type enabled")
 var ErrUnknownEngine = errors.New("invalid engine")
+var ErrUnknownFlavor = errors.New("invalid flavor: the googlesql engine supports spanner and bigquery")
 var ErrUnknownVersion = errors.New("invalid version number")
+var ErrInvalidRole = errors.New("invalid role: only the postgresql engine records privileges")
 
 var ErrPluginBuiltin = errors.New("a built-in plugin with that name already exists")
 var ErrPluginNoName = errors.New("missing plugin name")
@@ -179,33 +214,58 @@ dashboard at https://dashboard.sqlc.dev/. If you have an auth token, ensure
 you've set it as the value of the SQLC_AUTH_TOKEN environment variable.`)
 
 func ParseConfig(rd io.Reader) (Config, error) {
-	var buf bytes.Buffer
+	return ParseConfigFrom(rd, ".")
+}
+
+// ParseConfigFrom parses a configuration that lives in dir, which its env
+// files and the files a version 2 configuration includes are found relative
+// to.
+func ParseConfigFrom(rd io.Reader, dir string) (Config, error) {
 	var config Config
 	var version versionSetting
 
-	ver := io.TeeReader(rd, &buf)
-	dec := yaml.NewDecoder(ver)
+	data, err := io.ReadAll(rd)
+	if err != nil {
+		return config, err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(data))
 	if err := dec.Decode(&version); err != nil {
 		return config, err
 	}
 	if version.Number == "" {
 		return config, ErrMissingVersion
 	}
-	var err error
+	if version.Number != "1" && version.Number != "2" {
+		return config, ErrUnknownVersion
+	}
+	doc, changed, err := resolve(data, dir)
+	if err != nil {
+		return config, err
+	}
+	// A configuration that resolves to what was written is decoded as written,
+	// so that errors point at the lines of the file.
+	if changed {
+		if data, err = encodeNode(doc); err != nil {
+			return config, err
+		}
+	}
 	switch version.Number {
 	case "1":
-		config, err = v1ParseConfig(&buf)
+		config, err = v1ParseConfig(bytes.NewReader(data))
 		if err != nil {
 			return config, err
 		}
 	case "2":
-		config, err = v2ParseConfig(&buf)
+		config, err = v2ParseConfig(bytes.NewReader(data))
 		if err != nil {
 			return config, err
 		}
 	default:
 		return config, ErrUnknownVersion
 	}
+	if config.env, err = readEnvFiles(config.EnvFile, dir); err != nil {
+		return config, err
+	}
 	err = config.addEnvVars()
 	if err != nil {
 		return config, err
@@ -218,6 +278,7 @@ type CombinedSettings struct {
 	Package SQL
 	Go      golang.Options
 	JSON    SQLJSON
+	Proto   SQLProto
 
 	// TODO: Combine these into a more usable type
 	Codegen Codegen
@@ -234,5 +295,8 @@ func Combine(conf Config, pkg SQL) CombinedSettings {
 	if pkg.Gen.JSON != nil {
 		cs.JSON = *pkg.Gen.JSON
 	}
+	if pkg.Gen.Proto != nil {
+		cs.Proto = *pkg.Gen.Proto
+	}
 	return cs
 }
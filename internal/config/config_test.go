@@ -89,3 +89,28 @@ func TestInvalidConfig(t *testing.T) {
 		t.Errorf("expected err; got nil")
 	}
 }
+
+func TestInvalidFlavor(t *testing.T) {
+	for _, sql := range []SQL{
+		{Engine: EngineGoogleSQL, Flavor: "postgres"},
+		{Engine: EnginePostgreSQL, Flavor: FlavorSpanner},
+	} {
+		if err := Validate(&Config{SQL: []SQL{sql}}); err != ErrUnknownFlavor {
+			t.Errorf("%s/%s: expected ErrUnknownFlavor; got %v", sql.Engine, sql.Flavor, err)
+		}
+	}
+	if err := Validate(&Config{SQL: []SQL{{Engine: EngineGoogleSQL, Flavor: FlavorBigQuery}}}); err != nil {
+		t.Errorf("expected nil; got %v", err)
+	}
+}
+
+func TestInvalidRole(t *testing.T) {
+	for _, engine := range []Engine{EngineMySQL, EngineSQLite} {
+		if err := Validate(&Config{SQL: []SQL{{Engine: engine, Role: "app"}}}); err != ErrInvalidRole {
+			t.Errorf("%s: expected ErrInvalidRole; got %v", engine, err)
+		}
+	}
+	if err := Validate(&Config{SQL: []SQL{{Engine: EnginePostgreSQL, Role: "app"}}}); err != nil {
+		t.Errorf("expected nil; got %v", err)
+	}
+}
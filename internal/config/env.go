@@ -1,9 +1,17 @@
 package config
 
 import (
+	"bufio"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"slices"
 	"strings"
+
+	yaml "gopkg.in/yaml.v3"
+
+	"github.com/sqlc-dev/sqlc/internal/shfmt"
 )
 
 func (c *Config) addEnvVars() error {
@@ -15,3 +23,156 @@ func (c *Config) addEnvVars() error {
 
 	return nil
 }
+
+// interpolator expands the ${VAR} and ${VAR:-default} references in the
+// string values of a configuration, from the environment and the files its
+// env_file key names.
+type interpolator struct {
+	replacer *shfmt.Replacer
+	changed  bool
+	// undefined lists the variables referred to with no value and no
+	// default, each with where it was found.
+	undefined []string
+}
+
+// newInterpolator reads the env_file of the configuration in root, whose
+// relative paths are relative to dir. The environment takes precedence over
+// the files, and a later file over an earlier one. A file that does not exist
+// is skipped, so that a configuration can name a file that is only present on
+// developers' machines.
+func newInterpolator(root *yaml.Node, dir string) (*interpolator, error) {
+	var env []string
+	if n := mappingValue(root, "env_file"); n != nil {
+		var paths Paths
+		if err := n.Decode(&paths); err != nil {
+			return nil, fmt.Errorf("line %d: env_file must be a path or a list of paths", n.Line)
+		}
+		var err error
+		if env, err = readEnvFiles(paths, dir); err != nil {
+			return nil, err
+		}
+	}
+	return &interpolator{replacer: shfmt.NewReplacer(append(env, os.Environ()...))}, nil
+}
+
+// readEnvFiles reads the variables of env files, whose relative paths are
+// relative to dir, skipping the ones that do not exist.
+func readEnvFiles(paths Paths, dir string) ([]string, error) {
+	var env []string
+	for _, path := range paths {
+		if !filepath.IsAbs(path) {
+			path = filepath.Join(dir, path)
+		}
+		vars, err := readEnvFile(path)
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		env = append(env, vars...)
+	}
+	return env, nil
+}
+
+// Environ returns the variables database and server URIs are expanded with:
+// those of the configuration's env files, which the environment takes
+// precedence over.
+func (c *Config) Environ() []string {
+	return append(slices.Clone(c.env), os.Environ()...)
+}
+
+// node expands the string values in n, which was read from file, or from the
+// root configuration when file is empty. Mapping keys are left alone.
+//
+// Database and server URIs are left as written: a database is only connected
+// to by the commands that need it, which expand the URI then, with the
+// variables Config.Environ returns. That way the other commands work without
+// its variables, and a password in one is not part of what sqlc config print
+// shows.
+func (x *interpolator) node(n *yaml.Node, file string) {
+	switch n.Kind {
+	case yaml.DocumentNode, yaml.SequenceNode:
+		for _, c := range n.Content {
+			x.node(c, file)
+		}
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(n.Content); i += 2 {
+			key, value := n.Content[i], n.Content[i+1]
+			if key.Value == "uri" {
+				continue
+			}
+			x.node(value, file)
+		}
+	case yaml.ScalarNode:
+		x.scalar(n, file)
+	}
+}
+
+func (x *interpolator) scalar(n *yaml.Node, file string) {
+	if n.Kind != yaml.ScalarNode || !strings.Contains(n.Value, "${") {
+		return
+	}
+	value, missing := x.replacer.Expand(n.Value)
+	if len(missing) > 0 {
+		where := fmt.Sprintf("line %d", n.Line)
+		if file != "" {
+			where += " of " + file
+		}
+		for _, name := range missing {
+			x.undefined = append(x.undefined, fmt.Sprintf("%s (%s)", name, where))
+		}
+	}
+	if value == n.Value {
+		return
+	}
+	n.Value = value
+	// An unquoted value is resolved again, so that a variable can set a
+	// boolean or a number.
+	if n.Style == 0 {
+		n.Tag = ""
+	}
+	x.changed = true
+}
+
+func (x *interpolator) err() error {
+	if len(x.undefined) == 0 {
+		return nil
+	}
+	return fmt.Errorf("undefined environment variables: %s", strings.Join(x.undefined, ", "))
+}
+
+// readEnvFile reads the KEY=VALUE lines of a dotenv file. Blank lines and
+// lines starting with # are skipped, an export prefix is allowed, and a value
+// may be wrapped in single or double quotes.
+func readEnvFile(path string) ([]string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var env []string
+	sc := bufio.NewScanner(f)
+	for lineno := 1; sc.Scan(); lineno++ {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		line = strings.TrimPrefix(line, "export ")
+		key, value, ok := strings.Cut(line, "=")
+		key = strings.TrimSpace(key)
+		if !ok || key == "" {
+			return nil, fmt.Errorf("env_file %s: line %d: expected KEY=VALUE", path, lineno)
+		}
+		value = strings.TrimSpace(value)
+		if len(value) >= 2 && (value[0] == '"' || value[0] == '\'') && value[len(value)-1] == value[0] {
+			value = value[1 : len(value)-1]
+		}
+		env = append(env, key+"="+value)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, fmt.Errorf("env_file %s: %w", path, err)
+	}
+	return env, nil
+}
@@ -0,0 +1,74 @@
+package config
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestInterpolate(t *testing.T) {
+	t.Setenv("SQLC_TEST_OUT", "internal/db")
+	t.Setenv("SQLC_TEST_PACKAGE", "")
+	dir := writeFiles(t, map[string]string{
+		".env": `# local settings
+export SQLC_TEST_ENGINE=postgresql
+SQLC_TEST_OUT="ignored"
+SQLC_TEST_JSON_TAGS='true'
+`,
+	})
+	conf, err := ParseConfigFrom(strings.NewReader(`version: "2"
+env_file:
+  - .env
+  - .env.local
+sql:
+  - engine: ${SQLC_TEST_ENGINE}
+    schema: ${SQLC_TEST_SCHEMA:-schema.sql}
+    queries: query.sql
+    database:
+      uri: postgres://${SQLC_TEST_OUT}@${SQLC_TEST_UNDEFINED}/db
+    gen:
+      go:
+        package: ${SQLC_TEST_PACKAGE:-db}
+        out: ${SQLC_TEST_OUT}
+        emit_json_tags: ${SQLC_TEST_JSON_TAGS}
+`), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sql := conf.SQL[0]
+	if sql.Engine != EnginePostgreSQL {
+		t.Errorf("engine is %q, want it read from the env file", sql.Engine)
+	}
+	if sql.Schema[0] != "schema.sql" {
+		t.Errorf("schema is %q, want the default", sql.Schema[0])
+	}
+	if sql.Gen.Go.Package != "db" {
+		t.Errorf("package is %q, want the default for an empty variable", sql.Gen.Go.Package)
+	}
+	if sql.Gen.Go.Out != "internal/db" {
+		t.Errorf("out is %q, want the environment to win over the env file", sql.Gen.Go.Out)
+	}
+	if !sql.Gen.Go.EmitJsonTags {
+		t.Errorf("emit_json_tags is false, want it set from a variable")
+	}
+	if sql.Database.URI != "postgres://${SQLC_TEST_OUT}@${SQLC_TEST_UNDEFINED}/db" {
+		t.Errorf("database uri is %q, want it left for the analyzer", sql.Database.URI)
+	}
+}
+
+func TestInterpolateUndefined(t *testing.T) {
+	_, err := ParseConfigFrom(strings.NewReader(`version: "2"
+sql:
+  - engine: postgresql
+    schema: ${SQLC_TEST_A}/schema.sql
+    queries: ${SQLC_TEST_B:-query.sql}
+    rules:
+      - ${SQLC_TEST_C}
+`), t.TempDir())
+	if err == nil {
+		t.Fatalf("expected err; got nil")
+	}
+	want := "undefined environment variables: SQLC_TEST_A (line 4), SQLC_TEST_C (line 7)"
+	if err.Error() != want {
+		t.Errorf("got %q, want %q", err, want)
+	}
+}
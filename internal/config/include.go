@@ -0,0 +1,336 @@
+package config
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+
+	yaml "gopkg.in/yaml.v3"
+)
+
+// includeKeys are the top-level keys an included file may set. The rest
+// describe the project as a whole and belong to the file that includes it.
+var includeKeys = []string{"version", "include", "defaults", "sql", "plugins", "rules"}
+
+// pathKeys are the keys of a sql entry that name files. In an included file
+// they are relative to that file, and are rewritten to be relative to the
+// configuration that includes it, which is what every command resolves them
+// against.
+var pathKeys = []string{"schema", "queries", "catalog"}
+
+// Resolve reads a configuration, expands the environment variables in its
+// string values and, for version 2, applies its includes and defaults,
+// returning the single document they amount to. Included files and env files
+// are found relative to dir, the directory of the configuration, and included
+// files in turn relative to the file that includes them. A configuration that
+// uses none of these comes back as it was written.
+func Resolve(data []byte, dir string) (*yaml.Node, error) {
+	doc, _, err := resolve(data, dir)
+	return doc, err
+}
+
+// resolve is Resolve, also reporting whether the document changed.
+func resolve(data []byte, dir string) (*yaml.Node, bool, error) {
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return nil, false, err
+	}
+	if doc.Kind != yaml.DocumentNode || len(doc.Content) == 0 || doc.Content[0].Kind != yaml.MappingNode {
+		return &doc, false, nil
+	}
+	root := doc.Content[0]
+	interp, err := newInterpolator(root, dir)
+	if err != nil {
+		return nil, false, err
+	}
+	interp.node(root, "")
+	if v := mappingValue(root, "version"); v == nil || v.Value != "2" ||
+		mappingValue(root, "include") == nil && mappingValue(root, "defaults") == nil {
+		return &doc, interp.changed, interp.err()
+	}
+	abs, err := filepath.Abs(dir)
+	if err != nil {
+		return nil, false, err
+	}
+	r := &resolver{root: abs, seen: map[string]bool{}, interp: interp}
+	parts, err := r.file(root, abs, nil)
+	if err != nil {
+		return nil, false, err
+	}
+	if err := interp.err(); err != nil {
+		return nil, false, err
+	}
+
+	resolved := &yaml.Node{Kind: yaml.MappingNode}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key := root.Content[i].Value
+		switch key {
+		case "include", "defaults", "sql", "plugins", "rules":
+			continue
+		}
+		resolved.Content = append(resolved.Content, root.Content[i], root.Content[i+1])
+	}
+	for _, list := range []struct {
+		key   string
+		items []*yaml.Node
+	}{{"sql", parts.sql}, {"plugins", parts.plugins}, {"rules", parts.rules}} {
+		if len(list.items) == 0 && list.key != "sql" {
+			continue
+		}
+		resolved.Content = append(resolved.Content,
+			&yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: list.key},
+			&yaml.Node{Kind: yaml.SequenceNode, Tag: "!!seq", Content: list.items})
+	}
+	return &yaml.Node{Kind: yaml.DocumentNode, Content: []*yaml.Node{resolved}}, true, nil
+}
+
+// encodeNode writes a resolved document back out, so that it is decoded as
+// strictly as one read from a file.
+func encodeNode(doc *yaml.Node) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return nil, err
+	}
+	if err := enc.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type resolver struct {
+	root string
+	// seen holds the files on the include chain being resolved, so that a
+	// file that includes itself is an error rather than a hang.
+	seen   map[string]bool
+	interp *interpolator
+}
+
+// parts are the lists a file and the files it includes contribute to the
+// resolved configuration.
+type parts struct {
+	sql     []*yaml.Node
+	plugins []*yaml.Node
+	rules   []*yaml.Node
+}
+
+// file resolves one configuration file's mapping, whose relative paths are
+// relative to dir, under the defaults of the files that include it.
+func (r *resolver) file(m *yaml.Node, dir string, inherited *yaml.Node) (parts, error) {
+	var out parts
+	rel, err := filepath.Rel(r.root, dir)
+	if err != nil {
+		return out, err
+	}
+
+	defaults := inherited
+	if d := mappingValue(m, "defaults"); d != nil {
+		if d.Kind != yaml.MappingNode {
+			return out, fmt.Errorf("line %d: defaults must be a mapping", d.Line)
+		}
+		rebasePaths(d, rel)
+		defaults = merge(inherited, d)
+	}
+
+	if s := mappingValue(m, "sql"); s != nil {
+		if s.Kind != yaml.SequenceNode {
+			return out, fmt.Errorf("line %d: sql must be a list", s.Line)
+		}
+		for _, entry := range s.Content {
+			if entry.Kind != yaml.MappingNode {
+				return out, fmt.Errorf("line %d: sql entries must be mappings", entry.Line)
+			}
+			rebasePaths(entry, rel)
+			out.sql = append(out.sql, merge(defaults, entry))
+		}
+	}
+	for _, list := range []struct {
+		key string
+		dst *[]*yaml.Node
+	}{{"plugins", &out.plugins}, {"rules", &out.rules}} {
+		if l := mappingValue(m, list.key); l != nil {
+			if l.Kind != yaml.SequenceNode {
+				return out, fmt.Errorf("line %d: %s must be a list", l.Line, list.key)
+			}
+			*list.dst = append(*list.dst, l.Content...)
+		}
+	}
+
+	paths, err := includes(mappingValue(m, "include"), dir)
+	if err != nil {
+		return out, err
+	}
+	for _, path := range paths {
+		included, err := r.include(path, defaults)
+		if err != nil {
+			return out, err
+		}
+		out.sql = append(out.sql, included.sql...)
+		out.plugins = append(out.plugins, included.plugins...)
+		out.rules = append(out.rules, included.rules...)
+	}
+	return out, nil
+}
+
+// include reads and resolves an included file.
+func (r *resolver) include(path string, defaults *yaml.Node) (parts, error) {
+	if r.seen[path] {
+		return parts{}, fmt.Errorf("include %s: file includes itself", path)
+	}
+	r.seen[path] = true
+	defer delete(r.seen, path)
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return parts{}, fmt.Errorf("include: %w", err)
+	}
+	var doc yaml.Node
+	if err := yaml.Unmarshal(data, &doc); err != nil {
+		return parts{}, fmt.Errorf("include %s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		return parts{}, nil
+	}
+	m := doc.Content[0]
+	if m.Kind != yaml.MappingNode {
+		return parts{}, fmt.Errorf("include %s: not a mapping", path)
+	}
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		key := m.Content[i]
+		if !slices.Contains(includeKeys, key.Value) {
+			return parts{}, fmt.Errorf("include %s: line %d: %s is only allowed in the root configuration", path, key.Line, key.Value)
+		}
+	}
+	if v := mappingValue(m, "version"); v != nil && v.Value != "2" {
+		return parts{}, fmt.Errorf("include %s: %w", path, ErrUnknownVersion)
+	}
+	rel, err := filepath.Rel(r.root, path)
+	if err != nil {
+		return parts{}, err
+	}
+	r.interp.node(m, filepath.ToSlash(rel))
+	p, err := r.file(m, filepath.Dir(path), defaults)
+	if err != nil {
+		return parts{}, fmt.Errorf("include %s: %w", path, err)
+	}
+	return p, nil
+}
+
+// includes returns the files an include value names, a path or a list of
+// them, each of which may be a glob. A pattern that matches nothing is an
+// error: it is far more likely a typo than an intentionally empty include.
+func includes(n *yaml.Node, dir string) ([]string, error) {
+	if n == nil {
+		return nil, nil
+	}
+	var patterns []string
+	switch n.Kind {
+	case yaml.ScalarNode:
+		patterns = []string{n.Value}
+	case yaml.SequenceNode:
+		for _, p := range n.Content {
+			if p.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("line %d: include must be a path or a list of paths", p.Line)
+			}
+			patterns = append(patterns, p.Value)
+		}
+	default:
+		return nil, fmt.Errorf("line %d: include must be a path or a list of paths", n.Line)
+	}
+	var paths []string
+	for _, pattern := range patterns {
+		if !filepath.IsAbs(pattern) {
+			pattern = filepath.Join(dir, pattern)
+		}
+		matches, err := filepath.Glob(pattern)
+		if err != nil {
+			return nil, fmt.Errorf("include %s: %w", pattern, err)
+		}
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("include %s: no such file", pattern)
+		}
+		sort.Strings(matches)
+		paths = append(paths, matches...)
+	}
+	return paths, nil
+}
+
+// rebasePaths rewrites the paths of a sql entry or a defaults block, written
+// relative to a file in rel, to be relative to the root configuration.
+func rebasePaths(m *yaml.Node, rel string) {
+	if rel == "." {
+		return
+	}
+	rebase := func(n *yaml.Node) {
+		if n != nil && n.Kind == yaml.ScalarNode && n.Value != "" && !filepath.IsAbs(n.Value) {
+			n.Value = filepath.ToSlash(filepath.Join(rel, n.Value))
+		}
+	}
+	for _, key := range pathKeys {
+		n := mappingValue(m, key)
+		if n == nil {
+			continue
+		}
+		rebase(n)
+		if n.Kind == yaml.SequenceNode {
+			for _, p := range n.Content {
+				rebase(p)
+			}
+		}
+	}
+	if gen := mappingValue(m, "gen"); gen != nil {
+		for _, lang := range []string{"go", "json"} {
+			if g := mappingValue(gen, lang); g != nil {
+				rebase(mappingValue(g, "out"))
+			}
+		}
+	}
+	if codegen := mappingValue(m, "codegen"); codegen != nil && codegen.Kind == yaml.SequenceNode {
+		for _, cg := range codegen.Content {
+			rebase(mappingValue(cg, "out"))
+		}
+	}
+}
+
+// merge returns over laid on top of base. Mappings are merged key by key, so
+// that an entry can override one setting of a block it inherits; anything
+// else in over, lists included, replaces what base has. Neither is modified.
+func merge(base, over *yaml.Node) *yaml.Node {
+	if base == nil {
+		return over
+	}
+	if base.Kind != yaml.MappingNode || over.Kind != yaml.MappingNode {
+		return over
+	}
+	out := &yaml.Node{Kind: yaml.MappingNode, Tag: over.Tag, Style: over.Style, Line: over.Line, Column: over.Column}
+	for i := 0; i+1 < len(base.Content); i += 2 {
+		key, value := base.Content[i], base.Content[i+1]
+		if o := mappingValue(over, key.Value); o != nil {
+			value = merge(value, o)
+		}
+		out.Content = append(out.Content, key, value)
+	}
+	for i := 0; i+1 < len(over.Content); i += 2 {
+		if mappingValue(base, over.Content[i].Value) == nil {
+			out.Content = append(out.Content, over.Content[i], over.Content[i+1])
+		}
+	}
+	return out
+}
+
+// mappingValue returns the value of key in a mapping node, or nil.
+func mappingValue(m *yaml.Node, key string) *yaml.Node {
+	if m == nil || m.Kind != yaml.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(m.Content); i += 2 {
+		if m.Content[i].Value == key {
+			return m.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,153 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+	dir := t.TempDir()
+	for name, contents := range files {
+		path := filepath.Join(dir, name)
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(contents), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestInclude(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"sqlc.yaml": `version: "2"
+include:
+  - services/*.yaml
+defaults:
+  engine: postgresql
+  gen:
+    go:
+      package: db
+      sql_package: pgx/v5
+sql:
+  - schema: schema.sql
+    queries: query.sql
+    gen:
+      go:
+        out: db
+`,
+		"services/billing.yaml": `defaults:
+  gen:
+    go:
+      package: billing
+sql:
+  - schema: schema.sql
+    queries:
+      - query.sql
+    gen:
+      go:
+        out: gen
+        sql_package: database/sql
+`,
+	})
+	data, err := os.ReadFile(filepath.Join(dir, "sqlc.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	conf, err := ParseConfigFrom(strings.NewReader(string(data)), dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(conf.SQL) != 2 {
+		t.Fatalf("got %d sql entries, want 2", len(conf.SQL))
+	}
+
+	root, billing := conf.SQL[0], conf.SQL[1]
+	if diff := cmp.Diff(Paths{"schema.sql"}, root.Schema); diff != "" {
+		t.Errorf("root schema differed (-want +got):\n%s", diff)
+	}
+	if root.Engine != EnginePostgreSQL || root.Gen.Go.Package != "db" || root.Gen.Go.SqlPackage != "pgx/v5" {
+		t.Errorf("root entry did not inherit the defaults: %+v", root.Gen.Go)
+	}
+	if diff := cmp.Diff(Paths{"services/schema.sql"}, billing.Schema); diff != "" {
+		t.Errorf("billing schema differed (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff(Paths{"services/query.sql"}, billing.Queries); diff != "" {
+		t.Errorf("billing queries differed (-want +got):\n%s", diff)
+	}
+	if billing.Engine != EnginePostgreSQL {
+		t.Errorf("billing engine is %q, want it inherited", billing.Engine)
+	}
+	if billing.Gen.Go.Out != "services/gen" || billing.Gen.Go.Package != "billing" || billing.Gen.Go.SqlPackage != "database/sql" {
+		t.Errorf("billing entry did not override the defaults: %+v", billing.Gen.Go)
+	}
+}
+
+func TestIncludeErrors(t *testing.T) {
+	for _, test := range []struct {
+		name  string
+		files map[string]string
+		err   string
+	}{
+		{
+			"missing",
+			map[string]string{
+				"sqlc.yaml": "version: \"2\"\ninclude: nope.yaml\n",
+			},
+			"no such file",
+		},
+		{
+			"cycle",
+			map[string]string{
+				"sqlc.yaml": "version: \"2\"\ninclude: a.yaml\n",
+				"a.yaml":    "include: b.yaml\n",
+				"b.yaml":    "include: a.yaml\n",
+			},
+			"file includes itself",
+		},
+		{
+			"root key",
+			map[string]string{
+				"sqlc.yaml": "version: \"2\"\ninclude: a.yaml\n",
+				"a.yaml":    "cloud:\n  project: p\n",
+			},
+			"cloud is only allowed in the root configuration",
+		},
+		{
+			"version",
+			map[string]string{
+				"sqlc.yaml": "version: \"2\"\ninclude: a.yaml\n",
+				"a.yaml":    "version: \"1\"\n",
+			},
+			"invalid version number",
+		},
+	} {
+		tt := test
+		t.Run(tt.name, func(t *testing.T) {
+			dir := writeFiles(t, tt.files)
+			_, err := ParseConfigFrom(strings.NewReader(tt.files["sqlc.yaml"]), dir)
+			if err == nil {
+				t.Fatalf("expected err; got nil")
+			}
+			if !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("error %q does not mention %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	doc, err := Resolve([]byte(`{"version": "2", "sql": [{"engine": "oracle"}]}`), ".")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateSchema(doc); err == nil {
+		t.Fatalf("expected err; got nil")
+	}
+}
@@ -0,0 +1,43 @@
+package config
+
+import (
+	_ "embed"
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	yaml "gopkg.in/yaml.v3"
+)
+
+//go:embed v_two.json
+var v2Schema string
+
+// ValidateSchema checks a version 2 configuration, such as one Resolve
+// returns, against the configuration's JSON schema.
+func ValidateSchema(doc *yaml.Node) error {
+	var v any
+	if err := doc.Decode(&v); err != nil {
+		return err
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	schema, err := gojsonschema.NewSchema(gojsonschema.NewStringLoader(v2Schema))
+	if err != nil {
+		return err
+	}
+	result, err := schema.Validate(gojsonschema.NewBytesLoader(data))
+	if err != nil {
+		return err
+	}
+	if result.Valid() {
+		return nil
+	}
+	var msgs []string
+	for _, e := range result.Errors() {
+		msgs = append(msgs, e.String())
+	}
+	return errors.New(strings.Join(msgs, "\n"))
+}
@@ -17,6 +17,7 @@ type V1GenerateSettings struct {
 	Overrides []golang.Override   `json:"overrides,omitempty" yaml:"overrides,omitempty"`
 	Rename    map[string]string   `json:"rename,omitempty" yaml:"rename,omitempty"`
 	Rules     []Rule              `json:"rules" yaml:"rules"`
+	EnvFile   Paths               `json:"env_file,omitempty" yaml:"env_file,omitempty"`
 }
 
 type v1PackageSettings struct {
@@ -27,6 +28,10 @@ type v1PackageSettings struct {
 	Path                         string            `json:"path" yaml:"path"`
 	Schema                       Paths             `json:"schema" yaml:"schema"`
 	Queries                      Paths             `json:"queries" yaml:"queries"`
+	Catalog                      Paths             `json:"catalog,omitempty" yaml:"catalog"`
+	Role                         string            `json:"role,omitempty" yaml:"role"`
+	DefaultDatabase              string            `json:"default_database,omitempty" yaml:"default_database"`
+	SearchPath                   []string          `json:"search_path,omitempty" yaml:"search_path"`
 	EmitInterface                bool              `json:"emit_interface" yaml:"emit_interface"`
 	EmitJSONTags                 bool              `json:"emit_json_tags" yaml:"emit_json_tags"`
 	JsonTagsIDUppercase          bool              `json:"json_tags_id_uppercase" yaml:"json_tags_id_uppercase"`
@@ -43,6 +48,9 @@ type v1PackageSettings struct {
 	EmitEnumValidMethod          bool              `json:"emit_enum_valid_method,omitempty" yaml:"emit_enum_valid_method"`
 	EmitAllEnumValues            bool              `json:"emit_all_enum_values,omitempty" yaml:"emit_all_enum_values"`
 	EmitSqlAsComment             bool              `json:"emit_sql_as_comment,omitempty" yaml:"emit_sql_as_comment"`
+	EmitInstrumentation          bool              `json:"emit_instrumentation,omitempty" yaml:"emit_instrumentation"`
+	EmitReadWriteSplit           bool              `json:"emit_read_write_split,omitempty" yaml:"emit_read_write_split"`
+	EmitPgHelperTypes            bool              `json:"emit_pg_helper_types,omitempty" yaml:"emit_pg_helper_types"`
 	JSONTagsCaseStyle            string            `json:"json_tags_case_style,omitempty" yaml:"json_tags_case_style"`
 	SQLPackage                   string            `json:"sql_package" yaml:"sql_package"`
 	SQLDriver                    string            `json:"sql_driver" yaml:"sql_driver"`
@@ -125,6 +133,7 @@ func (c *V1GenerateSettings) Translate() Config {
 		Version: c.Version,
 		Cloud:   c.Cloud,
 		Rules:   c.Rules,
+		EnvFile: c.EnvFile,
 	}
 
 	for _, pkg := range c.Packages {
@@ -133,13 +142,17 @@ func (c *V1GenerateSettings) Translate() Config {
 			pkg.StrictOrderBy = &defaultValue
 		}
 		conf.SQL = append(conf.SQL, SQL{
-			Name:     pkg.Name,
-			Engine:   pkg.Engine,
-			Database: pkg.Database,
-			Schema:   pkg.Schema,
-			Queries:  pkg.Queries,
-			Rules:    pkg.Rules,
-			Analyzer: pkg.Analyzer,
+			Name:            pkg.Name,
+			Engine:          pkg.Engine,
+			Database:        pkg.Database,
+			Schema:          pkg.Schema,
+			Queries:         pkg.Queries,
+			Catalog:         pkg.Catalog,
+			Role:            pkg.Role,
+			DefaultDatabase: pkg.DefaultDatabase,
+			SearchPath:      pkg.SearchPath,
+			Rules:           pkg.Rules,
+			Analyzer:        pkg.Analyzer,
 			Gen: SQLGen{
 				Go: &golang.Options{
 					EmitInterface:                pkg.EmitInterface,
@@ -158,6 +171,9 @@ func (c *V1GenerateSettings) Translate() Config {
 					EmitEnumValidMethod:          pkg.EmitEnumValidMethod,
 					EmitAllEnumValues:            pkg.EmitAllEnumValues,
 					EmitSqlAsComment:             pkg.EmitSqlAsComment,
+					EmitInstrumentation:          pkg.EmitInstrumentation,
+					EmitReadWriteSplit:           pkg.EmitReadWriteSplit,
+					EmitPgHelperTypes:            pkg.EmitPgHelperTypes,
 					Package:                      pkg.Name,
 					Out:                          pkg.Path,
 					SqlPackage:                   pkg.SQLPackage,
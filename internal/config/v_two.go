@@ -28,8 +28,10 @@ func v2ParseConfig(rd io.Reader) (Config, error) {
 	}
 	// TODO: Store built-in plugins somewhere else
 	builtins := map[string]struct{}{
-		"go":   {},
-		"json": {},
+		"go":        {},
+		"json":      {},
+		"proto":     {},
+		BuiltinDocs: {},
 	}
 	plugins := map[string]struct{}{}
 	for i := range conf.Plugins {
@@ -69,6 +71,11 @@ func v2ParseConfig(rd io.Reader) (Config, error) {
 				return conf, ErrNoOutPath
 			}
 		}
+		if conf.SQL[j].Gen.Proto != nil {
+			if conf.SQL[j].Gen.Proto.Out == "" {
+				return conf, ErrNoOutPath
+			}
+		}
 		for _, cg := range conf.SQL[j].Codegen {
 			if cg.Plugin == "" {
 				return conf, ErrPluginNoName
@@ -76,8 +83,8 @@ func v2ParseConfig(rd io.Reader) (Config, error) {
 			if cg.Out == "" {
 				return conf, ErrNoOutPath
 			}
-			// TODO: Allow the use of built-in codegen from here
-			if _, ok := plugins[cg.Plugin]; !ok {
+			// TODO: Allow the use of the other built-in codegen from here
+			if _, ok := plugins[cg.Plugin]; !ok && cg.Plugin != BuiltinDocs {
 				return conf, ErrPluginNotFound
 			}
 		}
@@ -2,6 +2,14 @@ package config
 
 func Validate(c *Config) error {
 	for _, sql := range c.SQL {
+		if sql.Flavor != "" {
+			if sql.Engine != EngineGoogleSQL || (sql.Flavor != FlavorSpanner && sql.Flavor != FlavorBigQuery) {
+				return ErrUnknownFlavor
+			}
+		}
+		if sql.Role != "" && sql.Engine != EnginePostgreSQL {
+			return ErrInvalidRole
+		}
 		if sql.Database != nil {
 			if sql.Database.URI == "" && !sql.Database.Managed {
 				return ErrInvalidDatabase
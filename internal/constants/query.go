@@ -3,7 +3,10 @@ package constants
 // Flags
 const (
 	QueryFlagParam          = "@param"
+	QueryFlagColumn         = "@column"
 	QueryFlagSqlcVetDisable = "@sqlc-vet-disable"
+	QueryFlagReadOnly       = "@readonly"
+	QueryFlagReadWrite      = "@readwrite"
 )
 
 // Rules
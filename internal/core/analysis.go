@@ -7,15 +7,31 @@ const (
 	CommandInsert Command = "INSERT"
 	CommandUpdate Command = "UPDATE"
 	CommandDelete Command = "DELETE"
+	CommandCall   Command = "CALL"
 )
 
+// ReadOnly reports whether statements running the command only read data.
+func (c Command) ReadOnly() bool {
+	return c == CommandSelect
+}
+
 type PrepareResult struct {
 	Command    Command     `json:"command,omitempty"`
 	Columns    []Column    `json:"columns"`
 	Parameters []Parameter `json:"parameters"`
+	Access     []Access    `json:"access,omitempty"`
+}
+
+// Access is a privilege a statement needs on a relation: on the columns it
+// lists, or on the relation itself when it lists none.
+type Access struct {
+	ClassOID  int64    `json:"class_oid"`
+	Privilege string   `json:"privilege"`
+	Columns   []string `json:"columns,omitempty"`
 }
 
 type ColumnSource struct {
+	Database   string `json:"database,omitempty"`
 	Schema     string `json:"schema,omitempty"`
 	Table      string `json:"table,omitempty"`
 	TableAlias string `json:"table_alias,omitempty"`
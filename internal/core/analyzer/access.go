@@ -0,0 +1,50 @@
+package analyzer
+
+import (
+	"slices"
+
+	"github.com/sqlc-dev/sqlc/internal/core"
+)
+
+// Privileges a statement needs, by what it does to a relation's rows.
+const (
+	privSelect = "SELECT"
+	privInsert = "INSERT"
+	privUpdate = "UPDATE"
+	privDelete = "DELETE"
+)
+
+// accessSet collects the privileges a statement needs on the relations it
+// touches, in the order it first touches them. A query shares it with the
+// subqueries nested in it, the way they share parameters.
+type accessSet struct {
+	entries []core.Access
+	index   map[accessKey]int
+}
+
+type accessKey struct {
+	classOID  int64
+	privilege string
+}
+
+// add records that the statement needs privilege on a relation: on column,
+// or on the relation itself when column is empty. A relation that is not the
+// catalog's, such as a subquery or a WITH query, needs nothing of its own.
+func (s *accessSet) add(classOID int64, privilege, column string) {
+	if s == nil || classOID == 0 {
+		return
+	}
+	key := accessKey{classOID, privilege}
+	i, ok := s.index[key]
+	if !ok {
+		if s.index == nil {
+			s.index = map[accessKey]int{}
+		}
+		i = len(s.entries)
+		s.index[key] = i
+		s.entries = append(s.entries, core.Access{ClassOID: classOID, Privilege: privilege})
+	}
+	if column != "" && !slices.Contains(s.entries[i].Columns, column) {
+		s.entries[i].Columns = append(s.entries[i].Columns, column)
+	}
+}
@@ -14,6 +14,7 @@ func Prepare(cat *core.Catalog, stmt ast.Node) (core.PrepareResult, error) {
 	a := &analyzer{
 		cat:    cat,
 		params: map[int]core.Parameter{},
+		access: &accessSet{},
 	}
 	switch s := stmt.(type) {
 	case *ast.SelectStmt:
@@ -36,6 +37,11 @@ func Prepare(cat *core.Catalog, stmt ast.Node) (core.PrepareResult, error) {
 			return core.PrepareResult{}, err
 		}
 		a.command = core.CommandDelete
+	case *ast.CallStmt:
+		if err := a.analyzeCall(s); err != nil {
+			return core.PrepareResult{}, err
+		}
+		a.command = core.CommandCall
 	default:
 		return core.PrepareResult{}, fmt.Errorf("analyzer: unsupported statement %T", stmt)
 	}
@@ -47,6 +53,7 @@ type analyzer struct {
 	scope   *scope
 	columns []core.Column
 	params  map[int]core.Parameter
+	access  *accessSet
 	command core.Command
 
 	// outer is the scope of the query this one is nested in, which a
@@ -66,12 +73,14 @@ type analyzer struct {
 }
 
 // subquery analyzes a nested SELECT. It shares the parameter set, so a
-// placeholder inside the subquery is reported with the rest, and it sees this
-// query's scope, so a correlated reference resolves.
+// placeholder inside the subquery is reported with the rest, and the access
+// set, so what it reads is too. It sees this query's scope, so a correlated
+// reference resolves.
 func (a *analyzer) subquery(s *ast.SelectStmt) (*analyzer, error) {
 	sub := &analyzer{
 		cat:    a.cat,
 		params: a.params,
+		access: a.access,
 		outer:  a.scope,
 		ctes:   a.ctes,
 	}
@@ -109,6 +118,7 @@ func (a *analyzer) result() core.PrepareResult {
 		Command:    a.command,
 		Columns:    a.columns,
 		Parameters: orderedParams(a.params),
+		Access:     a.access.entries,
 	}
 }
 
@@ -159,6 +169,11 @@ func (a *analyzer) analyzeSelect(s *ast.SelectStmt) error {
 		}
 	}
 
+	if s.PrewhereClause != nil {
+		if _, err := a.typeExpr(s.PrewhereClause); err != nil {
+			return fmt.Errorf("prewhere: %w", err)
+		}
+	}
 	if s.WhereClause != nil {
 		if _, err := a.typeExpr(s.WhereClause); err != nil {
 			return fmt.Errorf("where: %w", err)
@@ -176,6 +191,37 @@ func (a *analyzer) analyzeSelect(s *ast.SelectStmt) error {
 			return fmt.Errorf("having: %w", err)
 		}
 	}
+	if s.QualifyClause != nil {
+		if _, err := a.typeExpr(s.QualifyClause); err != nil {
+			return fmt.Errorf("qualify: %w", err)
+		}
+	}
+	if s.LimitBy != nil {
+		for _, e := range listItems(s.LimitBy.Exprs) {
+			if _, err := a.typeExpr(e); err != nil {
+				return fmt.Errorf("limit by: %w", err)
+			}
+		}
+		if err := a.typeLimit(s.LimitBy.Count); err != nil {
+			return fmt.Errorf("limit by: %w", err)
+		}
+		if err := a.typeLimit(s.LimitBy.Offset); err != nil {
+			return fmt.Errorf("limit by: %w", err)
+		}
+	}
+	if err := a.typeLimit(s.LimitCount); err != nil {
+		return fmt.Errorf("limit: %w", err)
+	}
+	if err := a.typeLimit(s.LimitOffset); err != nil {
+		return fmt.Errorf("offset: %w", err)
+	}
+	for _, item := range listItems(s.Settings) {
+		if d, ok := item.(*ast.DefElem); ok {
+			if _, err := a.typeExpr(d.Arg); err != nil {
+				return fmt.Errorf("settings: %w", err)
+			}
+		}
+	}
 
 	targets := listItems(s.TargetList)
 	if targets == nil {
@@ -237,20 +283,44 @@ func (a *analyzer) bindCTEs(with *ast.WithClause) error {
 		if !ok {
 			continue
 		}
+		if a.ctes == nil {
+			a.ctes = map[string]scopeRel{}
+		}
+		// A recursive query refers to itself from the branch after its UNION.
+		// Its columns are the ones the branch before it selects, so that
+		// branch is bound first for the rest to see.
+		if with.Recursive && sel.Larg != nil && sel.Rarg != nil {
+			cols, err := a.subqueryColumns(sel.Larg)
+			if err != nil {
+				return fmt.Errorf("with %s: %w", *cte.Ctename, err)
+			}
+			rel := derivedRel(*cte.Ctename, cols)
+			renameColumns(&rel, cte.Aliascolnames)
+			a.ctes[*cte.Ctename] = rel
+		}
 		cols, err := a.subqueryColumns(sel)
 		if err != nil {
 			return fmt.Errorf("with %s: %w", *cte.Ctename, err)
 		}
 		rel := derivedRel(*cte.Ctename, cols)
 		renameColumns(&rel, cte.Aliascolnames)
-		if a.ctes == nil {
-			a.ctes = map[string]scopeRel{}
-		}
 		a.ctes[*cte.Ctename] = rel
 	}
 	return nil
 }
 
+// typeLimit types a row count, which is an integer.
+func (a *analyzer) typeLimit(n ast.Node) error {
+	if n == nil {
+		return nil
+	}
+	oid, err := a.cat.ConstTypeOID(core.ConstInteger)
+	if err != nil {
+		return err
+	}
+	return a.typeOperands(n, exprType{typeOID: oid})
+}
+
 // bindAliases records the output names the target list assigns, so a later
 // clause can refer to a result column by name.
 func (a *analyzer) bindAliases(targets *ast.List) {
@@ -2,6 +2,7 @@ package analyzer
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/core"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
@@ -21,6 +22,11 @@ func (a *analyzer) analyzeInsert(s *ast.InsertStmt) error {
 	if err != nil {
 		return err
 	}
+	// INSERT ... DEFAULT VALUES names no column but still takes INSERT.
+	a.access.add(rel.classOID, privInsert, "")
+	for _, col := range targets {
+		a.access.add(rel.classOID, privInsert, col.Name)
+	}
 	if err := a.bindInsertValues(s.SelectStmt, rel, targets); err != nil {
 		return err
 	}
@@ -47,6 +53,10 @@ func (a *analyzer) analyzeUpdate(s *ast.UpdateStmt) error {
 		if !ok {
 			return fmt.Errorf("unknown column %q", *rt.Name)
 		}
+		if col.Generated {
+			return fmt.Errorf("cannot update generated column %q", *rt.Name)
+		}
+		a.access.add(target.classOID, privUpdate, col.Name)
 		if err := a.bindValue(target, &col, rt.Val); err != nil {
 			return fmt.Errorf("set %s: %w", *rt.Name, err)
 		}
@@ -69,6 +79,10 @@ func (a *analyzer) analyzeDelete(s *ast.DeleteStmt) error {
 		return err
 	}
 	a.scope = sc
+	targets := min(len(listItems(s.Relations)), len(sc.rels))
+	for _, rel := range sc.rels[:targets] {
+		a.access.add(rel.classOID, privDelete, "")
+	}
 
 	if s.WhereClause != nil {
 		if _, err := a.typeExpr(s.WhereClause); err != nil {
@@ -84,10 +98,21 @@ func (a *analyzer) analyzeDelete(s *ast.DeleteStmt) error {
 func (a *analyzer) relationScope(relations, extra *ast.List, from ast.Node) (*scope, error) {
 	sc := &scope{}
 	defer a.binding(sc)()
+	// The relations a statement writes are bound without taking SELECT on
+	// them: that takes reading one of their columns.
 	for _, item := range listItems(relations) {
-		if err := a.appendFromItem(sc, item); err != nil {
+		rv, ok := item.(*ast.RangeVar)
+		if !ok {
+			if err := a.appendFromItem(sc, item); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		rel, err := a.bindRangeVar(rv)
+		if err != nil {
 			return nil, err
 		}
+		sc.rels = append(sc.rels, rel)
 	}
 	for _, item := range listItems(extra) {
 		if err := a.appendFromItem(sc, item); err != nil {
@@ -108,7 +133,15 @@ func (a *analyzer) relationScope(relations, extra *ast.List, from ast.Node) (*sc
 func insertTargets(rel scopeRel, cols *ast.List) ([]core.ClassColumn, error) {
 	items := listItems(cols)
 	if len(items) == 0 {
-		return rel.cols, nil
+		// A generated column computes its own value, so the values an INSERT
+		// without a column list gives are for the others.
+		out := make([]core.ClassColumn, 0, len(rel.cols))
+		for _, col := range rel.cols {
+			if !col.Generated {
+				out = append(out, col)
+			}
+		}
+		return out, nil
 	}
 	out := make([]core.ClassColumn, 0, len(items))
 	for _, item := range items {
@@ -120,6 +153,9 @@ func insertTargets(rel scopeRel, cols *ast.List) ([]core.ClassColumn, error) {
 		if !ok {
 			return nil, fmt.Errorf("unknown column %q", *rt.Name)
 		}
+		if col.Generated {
+			return nil, fmt.Errorf("cannot insert into generated column %q", *rt.Name)
+		}
 		out = append(out, col)
 	}
 	return out, nil
@@ -202,3 +238,74 @@ func columnType(rel scopeRel, col core.ClassColumn) exprType {
 		sourceTableAlias:   rel.alias,
 	}
 }
+
+// analyzeCall types the arguments of a procedure call. An argument passed by
+// name, as in EXEC p @qty = @p1, takes the type of the argument it names; any
+// other takes the type of the argument in its position. A placeholder is
+// named after the argument it is passed as. A call to a procedure the
+// catalog does not hold leaves its placeholders untyped.
+func (a *analyzer) analyzeCall(s *ast.CallStmt) error {
+	if s.FuncCall == nil {
+		return fmt.Errorf("call: missing procedure")
+	}
+	f := s.FuncCall
+	var nsOIDs []int64
+	if f.Func != nil && f.Func.Schema != "" {
+		dbOID, err := a.cat.DatabaseOID(f.Func.Catalog)
+		if err != nil {
+			return fmt.Errorf("database %q does not exist", f.Func.Catalog)
+		}
+		nsOID, err := a.cat.NamespaceOIDIn(dbOID, f.Func.Schema)
+		if err != nil {
+			return fmt.Errorf("schema %q: %w", f.Func.Schema, err)
+		}
+		nsOIDs = []int64{nsOID}
+	}
+	overloads, err := a.cat.FindProcs(funcCallName(f), nsOIDs)
+	if err != nil {
+		return err
+	}
+	var procArgs []core.ProcArg
+	for _, p := range overloads {
+		if p.Kind != "p" {
+			continue
+		}
+		if procArgs, err = a.cat.ProcArgs(p.OID); err != nil {
+			return err
+		}
+		break
+	}
+
+	for i, arg := range listItems(f.Args) {
+		var target *core.ProcArg
+		if na, ok := arg.(*ast.NamedArgExpr); ok {
+			arg = na.Arg
+			if na.Name != nil {
+				for j := range procArgs {
+					if strings.EqualFold(procArgs[j].Name, *na.Name) {
+						target = &procArgs[j]
+						break
+					}
+				}
+			}
+		} else if i < len(procArgs) {
+			target = &procArgs[i]
+		}
+		if target == nil {
+			if _, err := a.typeExpr(arg); err != nil {
+				return fmt.Errorf("call: %w", err)
+			}
+			continue
+		}
+		if err := a.typeOperands(arg, exprType{typeOID: target.TypeOID, nullable: true}); err != nil {
+			return fmt.Errorf("call: %w", err)
+		}
+		if pr, ok := arg.(*ast.ParamRef); ok {
+			if p, ok := a.params[pr.Number]; ok && p.Name == "" {
+				p.Name = target.Name
+				a.params[pr.Number] = p
+			}
+		}
+	}
+	return nil
+}
@@ -74,12 +74,21 @@ func (a *analyzer) typeExpr(n ast.Node) (exprType, error) {
 	case *ast.A_ArrayExpr:
 		return a.typeArrayExpr(e)
 
+	case *ast.A_Indirection:
+		return a.typeIndirection(e)
+
+	case *ast.RowExpr:
+		return a.typeRow(e)
+
 	case *ast.SubLink:
 		return a.typeSubLink(e)
 
 	case *ast.CollateExpr:
 		return a.typeExpr(e.Arg)
 
+	case *ast.NextValueExpr:
+		return a.typeNextValue(e)
+
 	case *ast.IntervalExpr:
 		// A dialect with no interval type of its own leaves it untyped.
 		oid, err := a.cat.TypeOID("interval")
@@ -141,6 +150,33 @@ func (a *analyzer) boolType(nullable bool) (exprType, error) {
 	return exprType{typeOID: oid, nullable: nullable}, nil
 }
 
+// resolveQualifiedColumn resolves a column reference whose relation is
+// qualified, returning the fields that follow the column. The qualified name
+// has to name the relation in scope under that name, so an alias hides the
+// qualified name as it does in PostgreSQL.
+func (a *analyzer) resolveQualifiedColumn(parts []string) (scopeRel, core.ClassColumn, []string, bool, error) {
+	for n := 1; n <= 2 && n+2 <= len(parts); n++ {
+		var database, schema string
+		if n == 2 {
+			database = parts[0]
+		}
+		schema = parts[n-1]
+		relation, column := parts[n], parts[n+1]
+		classOID, err := a.cat.LookupClass(database, schema, relation)
+		if err != nil {
+			continue
+		}
+		rel, col, ok, err := a.resolveColumn(relation, column)
+		if err != nil {
+			return scopeRel{}, core.ClassColumn{}, nil, false, err
+		}
+		if ok && rel.classOID == classOID {
+			return rel, col, parts[n+2:], true, nil
+		}
+	}
+	return scopeRel{}, core.ClassColumn{}, nil, false, nil
+}
+
 func (a *analyzer) typeColumnRef(c *ast.ColumnRef) (exprType, error) {
 	parts := flattenFields(c.Fields)
 	if len(parts) == 0 {
@@ -148,14 +184,33 @@ func (a *analyzer) typeColumnRef(c *ast.ColumnRef) (exprType, error) {
 	}
 	relation := ""
 	column := parts[0]
+	path := parts[1:]
 	if len(parts) >= 2 {
 		relation = parts[0]
 		column = parts[1]
+		path = parts[2:]
 	}
 	rel, col, ok, err := a.resolveColumn(relation, column)
 	if err != nil {
 		return exprType{}, err
 	}
+	if !ok && len(parts) >= 3 {
+		// The relation may be qualified by its schema, or its database and
+		// schema, as in public.authors.id.
+		rel, col, path, ok, err = a.resolveQualifiedColumn(parts)
+		if err != nil {
+			return exprType{}, err
+		}
+	}
+	if !ok && relation != "" {
+		// The first part may be a struct column rather than a relation, with
+		// the rest naming the fields it holds.
+		rel, col, ok, err = a.resolveColumn("", parts[0])
+		if err != nil {
+			return exprType{}, err
+		}
+		path = parts[1:]
+	}
 	if !ok {
 		if relation != "" {
 			return exprType{}, fmt.Errorf("unknown column %q.%q", relation, column)
@@ -167,13 +222,43 @@ func (a *analyzer) typeColumnRef(c *ast.ColumnRef) (exprType, error) {
 		}
 		return exprType{}, fmt.Errorf("unknown column %q", column)
 	}
-	return exprType{
+	a.access.add(rel.classOID, privSelect, col.Name)
+	t := exprType{
 		typeOID:            col.TypeOID,
 		nullable:           !col.NotNull,
 		sourceClassOID:     rel.classOID,
 		sourceAttributeOID: col.AttOID,
 		sourceTableAlias:   rel.alias,
-	}, nil
+	}
+	for _, name := range path {
+		field, ok := a.fieldType(t, name)
+		if !ok {
+			return exprType{}, fmt.Errorf("unknown field %q of column %q", name, col.Name)
+		}
+		t = field
+	}
+	return t, nil
+}
+
+// fieldType types the field called name of a value of struct type t. A field
+// of a NULL struct is NULL too.
+func (a *analyzer) fieldType(t exprType, name string) (exprType, bool) {
+	typeName, isArray := a.typeNameOf(t)
+	if isArray {
+		return exprType{}, false
+	}
+	fields, ok := core.StructFields(typeName)
+	if !ok {
+		return exprType{}, false
+	}
+	for _, f := range fields {
+		if strings.EqualFold(f.Name, name) {
+			field := a.namedType(f.Type)
+			field.nullable = t.nullable || !f.NotNull
+			return field, true
+		}
+	}
+	return exprType{}, false
 }
 
 func flattenFields(fields *ast.List) []string {
@@ -216,6 +301,7 @@ func (a *analyzer) inferParam(number int, t exprType) {
 		ad, err := a.cat.LookupAttribute(t.sourceAttributeOID)
 		if err == nil {
 			cur.Source = &core.ColumnSource{
+				Database:   ad.Database,
 				Schema:     ad.Schema,
 				Table:      ad.Table,
 				TableAlias: t.sourceTableAlias,
@@ -425,6 +511,89 @@ func (a *analyzer) typeArrayExpr(e *ast.A_ArrayExpr) (exprType, error) {
 	return a.namedType(element + core.ArraySuffix), nil
 }
 
+// typeIndirection types a subscript, arr[i], or a field of a struct value
+// that is not a column, (expr).f. An element past the end of an array is NULL.
+func (a *analyzer) typeIndirection(e *ast.A_Indirection) (exprType, error) {
+	t, err := a.typeExpr(e.Arg)
+	if err != nil {
+		return exprType{}, err
+	}
+	for _, item := range listItems(e.Indirection) {
+		switch v := item.(type) {
+		case *ast.A_Indices:
+			if _, err := a.typeExpr(v.Lidx); err != nil {
+				return exprType{}, err
+			}
+			if _, err := a.typeExpr(v.Uidx); err != nil {
+				return exprType{}, err
+			}
+			if v.IsSlice {
+				t.nullable = true
+				continue
+			}
+			element, isArray := a.typeNameOf(t)
+			if !isArray {
+				return exprType{nullable: true}, nil
+			}
+			t = a.namedType(element)
+			t.nullable = true
+		case *ast.String:
+			field, ok := a.fieldType(t, v.Str)
+			if !ok {
+				return exprType{}, fmt.Errorf("unknown field %q", v.Str)
+			}
+			t = field
+		default:
+			return exprType{nullable: true}, nil
+		}
+	}
+	return t, nil
+}
+
+// typeRow types a row constructor, ROW(...) or STRUCT(...), as the struct of
+// its values. A field is named by its alias, or by the column it reads.
+func (a *analyzer) typeRow(e *ast.RowExpr) (exprType, error) {
+	names := listItems(e.Colnames)
+	fields := make([]core.StructField, 0, len(listItems(e.Args)))
+	untyped := false
+	for i, arg := range listItems(e.Args) {
+		t, err := a.typeExpr(arg)
+		if err != nil {
+			return exprType{}, err
+		}
+		f := core.StructField{Type: a.typeString(t)}
+		if i < len(names) {
+			if s, ok := names[i].(*ast.String); ok {
+				f.Name = s.Str
+			}
+		}
+		if cr, ok := arg.(*ast.ColumnRef); ok && f.Name == "" {
+			if parts := flattenFields(cr.Fields); len(parts) > 0 {
+				f.Name = parts[len(parts)-1]
+			}
+		}
+		// The catalog names a struct type in lower case, as every other.
+		f.Name = strings.ToLower(f.Name)
+		untyped = untyped || f.Type == ""
+		fields = append(fields, f)
+	}
+	// A field of no known type leaves the struct with none either.
+	if untyped {
+		return exprType{}, nil
+	}
+	return a.namedType(core.StructTypeName(fields)), nil
+}
+
+// typeString is the name of a type with "[]" appended for an array, or "" for
+// a value of no known type.
+func (a *analyzer) typeString(t exprType) string {
+	name, isArray := a.typeNameOf(t)
+	if isArray {
+		name += core.ArraySuffix
+	}
+	return name
+}
+
 // namedType is the type a name refers to, or the name itself when the catalog
 // has no such type.
 func (a *analyzer) namedType(name string) exprType {
@@ -443,6 +612,12 @@ func (a *analyzer) typeNameOf(t exprType) (string, bool) {
 		if name, err = a.cat.TypeName(t.typeOID); err != nil {
 			return "", false
 		}
+		// A type a schema declared outside the current one is named with
+		// that schema, the way a table there is.
+		if schema, err := a.cat.TypeSchema(t.typeOID); err == nil &&
+			schema != core.DefaultNamespace && schema != a.cat.CurrentSchema() {
+			name = schema + "." + name
+		}
 	}
 	if element, ok := strings.CutSuffix(name, core.ArraySuffix); ok {
 		return element, true
@@ -468,17 +643,37 @@ func (a *analyzer) typeSubLink(e *ast.SubLink) (exprType, error) {
 		return exprType{}, err
 	}
 	switch e.SubLinkType {
-	case ast.EXPR_SUBLINK, ast.ARRAY_SUBLINK:
+	case ast.ARRAY_SUBLINK:
+		// ARRAY(subquery) collects the first column's values, and a subquery
+		// that matches no row yields an empty array rather than NULL.
+		if len(cols) == 0 || cols[0].DataType == "" {
+			return exprType{}, nil
+		}
+		return a.namedType(columnTypeName(cols[0]) + core.ArraySuffix), nil
+	case ast.EXPR_SUBLINK:
 		if len(cols) == 0 {
 			return exprType{nullable: true}, nil
 		}
 		// A subquery that matches no row yields NULL.
-		return exprType{typeOID: cols[0].TypeOID, nullable: true}, nil
+		t := exprType{typeOID: cols[0].TypeOID, nullable: true}
+		if t.typeOID == 0 {
+			t.typeName = columnTypeName(cols[0])
+		}
+		return t, nil
 	default:
 		return a.boolType(false)
 	}
 }
 
+// columnTypeName is the name of a result column's type, with "[]" appended
+// for an array.
+func columnTypeName(col core.Column) string {
+	if col.IsArray && col.DataType != "" {
+		return col.DataType + core.ArraySuffix
+	}
+	return col.DataType
+}
+
 // typeComparison types IS DISTINCT FROM and its negation, which compare any two
 // values and never return NULL.
 func (a *analyzer) typeComparison(e *ast.A_Expr) (exprType, error) {
@@ -613,67 +808,187 @@ func (a *analyzer) typeBoolExpr(b *ast.BoolExpr) (exprType, error) {
 	return a.boolType(false)
 }
 
+// typeWindow types the expressions the window a function call runs over
+// partitions and orders by, and its frame's offsets.
+func (a *analyzer) typeWindow(w *ast.WindowDef) error {
+	if w == nil {
+		return nil
+	}
+	for _, item := range listItems(w.PartitionClause) {
+		if _, err := a.typeExpr(item); err != nil {
+			return fmt.Errorf("partition by: %w", err)
+		}
+	}
+	for _, item := range listItems(w.OrderClause) {
+		if sb, ok := item.(*ast.SortBy); ok {
+			item = sb.Node
+		}
+		if _, err := a.typeExpr(item); err != nil {
+			return fmt.Errorf("order by: %w", err)
+		}
+	}
+	for _, offset := range []ast.Node{w.StartOffset, w.EndOffset} {
+		if offset == nil {
+			continue
+		}
+		if _, err := a.typeExpr(offset); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (a *analyzer) typeFuncCall(f *ast.FuncCall) (exprType, error) {
+	t, _, err := a.callFunc(f)
+	return t, err
+}
+
+// callFunc types a function call and returns the overload it calls, or nil
+// for a function the catalog does not hold.
+func (a *analyzer) callFunc(f *ast.FuncCall) (exprType, *core.ProcOverload, error) {
 	name := funcCallName(f)
 	if name == "" {
-		return exprType{}, fmt.Errorf("func call: missing name")
+		return exprType{}, nil, fmt.Errorf("func call: missing name")
 	}
 
 	if f.AggStar && (name == "count" || name == "count.*") {
 		if overloads, err := a.cat.FindProcs("count", nil); err == nil && len(overloads) > 0 {
-			return exprType{typeOID: overloads[0].ReturnTypeOID, nullable: overloads[0].ReturnNullable}, nil
+			return exprType{typeOID: overloads[0].ReturnTypeOID, nullable: overloads[0].ReturnNullable}, &overloads[0], nil
 		}
 		oid, err := a.cat.TypeOID("int8")
 		if err != nil {
-			return exprType{}, err
+			return exprType{}, nil, err
 		}
-		return exprType{typeOID: oid, nullable: false}, nil
+		return exprType{typeOID: oid, nullable: false}, nil, nil
 	}
 
 	args := listItems(f.Args)
-	argTypes := make([]int64, 0, len(args))
+	argTypes := make([]exprType, 0, len(args))
 	for _, arg := range args {
 		t, err := a.typeExpr(arg)
 		if err != nil {
-			return exprType{}, err
+			return exprType{}, nil, err
 		}
-		argTypes = append(argTypes, t.typeOID)
+		argTypes = append(argTypes, t)
+	}
+	if err := a.typeWindow(f.Over); err != nil {
+		return exprType{}, nil, err
 	}
 
 	overloads, err := a.cat.FindProcs(name, nil)
 	if err != nil {
-		return exprType{}, err
+		return exprType{}, nil, err
 	}
 	if len(overloads) == 0 {
 		// A dialect's function list is never complete — extensions add to it,
 		// and so does the user. An unknown function leaves the result untyped
 		// rather than failing the query.
-		return exprType{nullable: true}, nil
+		return exprType{nullable: true}, nil, nil
+	}
+	oids := make([]int64, len(argTypes))
+	for i, t := range argTypes {
+		oids[i] = t.typeOID
 	}
-	p := pickOverload(overloads, argTypes)
-	// An argument that is a bare placeholder takes the parameter's type.
+	p := a.pickOverload(overloads, oids)
+	// An argument that is a bare placeholder takes the parameter's type. A
+	// polymorphic parameter takes the type the other arguments resolve it
+	// to, as in has(tags, ?), and the parameter of type any takes any type.
 	for i, arg := range args {
 		if i >= len(p.ArgTypes) {
 			break
 		}
+		if name, err := a.cat.TypeName(p.ArgTypes[i]); err == nil && isPolymorphic(name) {
+			pr, ok := arg.(*ast.ParamRef)
+			if !ok || name == "any" {
+				continue
+			}
+			if element := a.polymorphicElement(p, argTypes); element != "" {
+				if isPolymorphicArray(name) {
+					element += core.ArraySuffix
+				}
+				a.inferParam(pr.Number, a.namedType(element))
+			}
+			continue
+		}
 		if err := a.typeOperands(arg, exprType{typeOID: p.ArgTypes[i]}); err != nil {
-			return exprType{}, err
+			return exprType{}, nil, err
+		}
+	}
+	t := a.returnType(p, argTypes)
+	t.nullable = p.ReturnNullable
+	if p.Strict {
+		// A strict function passes a NULL argument through. A placeholder
+		// is not one: whether it may be NULL is up to the caller.
+		for i, arg := range args {
+			if _, ok := arg.(*ast.ParamRef); !ok && argTypes[i].nullable {
+				t.nullable = true
+			}
 		}
 	}
-	return exprType{typeOID: a.returnType(p, argTypes), nullable: p.ReturnNullable}, nil
+	return t, &p, nil
 }
 
 // returnType resolves a polymorphic return type — max(anyelement) and its
-// like — to the type the call was made with.
-func (a *analyzer) returnType(p core.ProcOverload, argTypes []int64) int64 {
-	if p.ReturnTypeOID == 0 || len(argTypes) == 0 || argTypes[0] == 0 {
-		return p.ReturnTypeOID
+// like — to the type of the argument passed for the first polymorphic
+// parameter: the element of an array passed for an array, or an array of an
+// element passed for an element. A return type no argument resolves is left
+// untyped.
+func (a *analyzer) returnType(p core.ProcOverload, args []exprType) exprType {
+	if p.ReturnTypeOID == 0 {
+		return exprType{}
+	}
+	ret, err := a.cat.TypeName(p.ReturnTypeOID)
+	if err != nil || !isPolymorphic(ret) {
+		return exprType{typeOID: p.ReturnTypeOID}
+	}
+	for i, arg := range args {
+		if i >= len(p.ArgTypes) || (arg.typeOID == 0 && arg.typeName == "") {
+			continue
+		}
+		param, err := a.cat.TypeName(p.ArgTypes[i])
+		if err != nil || !isPolymorphic(param) || (param == "any" && ret != "any") {
+			// A parameter of type any takes anything without tying it to
+			// the return type, unless the return type is any too.
+			continue
+		}
+		element, isArray := a.typeNameOf(arg)
+		switch {
+		case isPolymorphicArray(param) && !isPolymorphicArray(ret) && isArray:
+			return a.namedType(element)
+		case !isPolymorphicArray(param) && isPolymorphicArray(ret) && !isArray && element != "":
+			return a.namedType(element + core.ArraySuffix)
+		}
+		return exprType{typeOID: arg.typeOID, typeName: arg.typeName}
 	}
-	name, err := a.cat.TypeName(p.ReturnTypeOID)
-	if err != nil || !isPolymorphic(name) {
-		return p.ReturnTypeOID
+	return exprType{}
+}
+
+// polymorphicElement returns the name of the element type the arguments
+// passed for the polymorphic parameters of an overload resolve, or "".
+func (a *analyzer) polymorphicElement(p core.ProcOverload, args []exprType) string {
+	for i, arg := range args {
+		if i >= len(p.ArgTypes) || (arg.typeOID == 0 && arg.typeName == "") {
+			continue
+		}
+		param, err := a.cat.TypeName(p.ArgTypes[i])
+		if err != nil || !isPolymorphic(param) || param == "any" {
+			continue
+		}
+		element, isArray := a.typeNameOf(arg)
+		switch {
+		case isPolymorphicArray(param) && isArray:
+			return element
+		case !isPolymorphicArray(param) && isArray:
+			return element + core.ArraySuffix
+		case !isPolymorphicArray(param):
+			return element
+		}
 	}
-	return argTypes[0]
+	return ""
+}
+
+func isPolymorphicArray(typeName string) bool {
+	return typeName == "anyarray" || typeName == "anycompatiblearray"
 }
 
 func isPolymorphic(typeName string) bool {
@@ -687,9 +1002,10 @@ func isPolymorphic(typeName string) bool {
 }
 
 // pickOverload chooses the overload whose parameters the call's arguments
-// match, preferring an exact match on types over one on arity alone.
-func pickOverload(overloads []core.ProcOverload, argTypes []int64) core.ProcOverload {
-	var byArity *core.ProcOverload
+// match, preferring an exact match on types over one where a polymorphic
+// parameter takes the argument, and either over one on arity alone.
+func (a *analyzer) pickOverload(overloads []core.ProcOverload, argTypes []int64) core.ProcOverload {
+	var byArity, byPolymorphic *core.ProcOverload
 	for i := range overloads {
 		ov := &overloads[i]
 		if len(ov.ArgTypes) != len(argTypes) {
@@ -698,16 +1014,26 @@ func pickOverload(overloads []core.ProcOverload, argTypes []int64) core.ProcOver
 		if byArity == nil {
 			byArity = ov
 		}
-		exact := true
+		exact, polymorphic := true, true
 		for j, oid := range argTypes {
-			if oid != ov.ArgTypes[j] {
-				exact = false
+			if oid == ov.ArgTypes[j] {
+				continue
+			}
+			exact = false
+			if name, err := a.cat.TypeName(ov.ArgTypes[j]); err != nil || !isPolymorphic(name) {
+				polymorphic = false
 				break
 			}
 		}
 		if exact {
 			return *ov
 		}
+		if polymorphic && byPolymorphic == nil {
+			byPolymorphic = ov
+		}
+	}
+	if byPolymorphic != nil {
+		return *byPolymorphic
 	}
 	if byArity != nil {
 		return *byArity
@@ -715,6 +1041,34 @@ func pickOverload(overloads []core.ProcOverload, argTypes []int64) core.ProcOver
 	return overloads[0]
 }
 
+// typeNextValue types NEXT VALUE FOR, which hands out a value of the type the
+// sequence was declared with.
+func (a *analyzer) typeNextValue(e *ast.NextValueExpr) (exprType, error) {
+	if e.Sequence == nil || e.Sequence.Relname == nil {
+		return exprType{}, nil
+	}
+	name := *e.Sequence.Relname
+	var database, schema string
+	if e.Sequence.Catalogname != nil {
+		database = *e.Sequence.Catalogname
+	}
+	if e.Sequence.Schemaname != nil {
+		schema = *e.Sequence.Schemaname
+	}
+	classOID, err := a.cat.LookupClass(database, schema, name)
+	if err != nil {
+		return exprType{}, err
+	}
+	if kind, err := a.cat.ClassKind(classOID); err != nil || kind != "S" {
+		return exprType{}, fmt.Errorf("%q is not a sequence", name)
+	}
+	cols, err := a.cat.ClassColumns(classOID)
+	if err != nil || len(cols) == 0 {
+		return exprType{}, err
+	}
+	return exprType{typeOID: cols[0].TypeOID}, nil
+}
+
 func funcCallName(f *ast.FuncCall) string {
 	if f.Funcname != nil {
 		return opNameFromList(f.Funcname)
@@ -45,6 +45,7 @@ func (a *analyzer) decorateSource(col *core.Column, attOID int64, tableAlias str
 		return
 	}
 	col.Source = &core.ColumnSource{
+		Database:   ad.Database,
 		Schema:     ad.Schema,
 		Table:      ad.Table,
 		TableAlias: tableAlias,
@@ -80,9 +81,10 @@ func isStar(fields []string) bool {
 }
 
 func (a *analyzer) emitStar(fields []string) {
+	// A qualified star, as in public.authors.*, names its relation last.
 	relName := ""
 	if len(fields) > 1 {
-		relName = fields[0]
+		relName = fields[len(fields)-2]
 	}
 	for _, rel := range a.scope.rels {
 		if relName != "" && rel.alias != relName {
@@ -90,6 +92,7 @@ func (a *analyzer) emitStar(fields []string) {
 		}
 		a.columns = slices.Grow(a.columns, len(rel.cols))
 		for _, c := range rel.cols {
+			a.access.add(rel.classOID, privSelect, c.Name)
 			col := core.Column{
 				Name:               c.Name,
 				TypeOID:            c.TypeOID,
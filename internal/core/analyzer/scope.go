@@ -2,9 +2,12 @@ package analyzer
 
 import (
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/core"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 )
 
 type scope struct {
@@ -49,6 +52,9 @@ func (a *analyzer) appendFromItem(sc *scope, item ast.Node) error {
 		if err != nil {
 			return err
 		}
+		// Reading from a relation takes SELECT on it even when none of its
+		// columns is named, as in SELECT count(*).
+		a.access.add(rel.classOID, privSelect, "")
 		sc.rels = append(sc.rels, rel)
 		return nil
 	case *ast.JoinExpr:
@@ -63,6 +69,38 @@ func (a *analyzer) appendFromItem(sc *scope, item ast.Node) error {
 		}
 		sc.rels = append(sc.rels, rel)
 		return nil
+	case *ast.RangeTableSample:
+		// A sample reads fewer of a relation's rows, not other columns.
+		if err := a.appendFromItem(sc, v.Relation); err != nil {
+			return err
+		}
+		for _, arg := range listItems(v.Args) {
+			if _, err := a.typeExpr(arg); err != nil {
+				return err
+			}
+		}
+		return nil
+	case *ast.RangePivot:
+		rel, err := a.bindRangePivot(v)
+		if err != nil {
+			return err
+		}
+		sc.rels = append(sc.rels, rel)
+		return nil
+	case *ast.RangeUnpivot:
+		rel, err := a.bindRangeUnpivot(v)
+		if err != nil {
+			return err
+		}
+		sc.rels = append(sc.rels, rel)
+		return nil
+	case *ast.RangeTableFunc:
+		rel, err := a.bindRangeTableFunc(v)
+		if err != nil {
+			return err
+		}
+		sc.rels = append(sc.rels, rel)
+		return nil
 	case *ast.RangeSubselect:
 		rel, err := a.bindRangeSubselect(v)
 		if err != nil {
@@ -84,7 +122,12 @@ func (a *analyzer) appendFromItem(sc *scope, item ast.Node) error {
 }
 
 // bindRangeFunction binds a function called in FROM. A set-returning function
-// stands in for a relation of a single column named after it.
+// stands in for a relation of a single column named after it, or after the
+// alias it is given. A function returning rows — through OUT or TABLE
+// arguments, or as a relation's row type — stands in for a relation of their
+// columns. Without an alias, a function returning structs stands in for a
+// relation of their fields instead. WITH ORDINALITY adds a column numbering
+// the rows.
 func (a *analyzer) bindRangeFunction(rf *ast.RangeFunction) (scopeRel, error) {
 	call := findFuncCall(rf.Functions)
 	if call == nil {
@@ -101,21 +144,140 @@ func (a *analyzer) bindRangeFunction(rf *ast.RangeFunction) (scopeRel, error) {
 
 	// The arguments are typed against the scope built so far, which is what
 	// gives a placeholder passed to the function its type.
-	if _, err := a.typeFuncCall(call); err != nil {
+	t, proc, err := a.callFunc(call)
+	if err != nil {
+		return scopeRel{}, err
+	}
+	if err := a.nameFuncParams(call, proc); err != nil {
+		return scopeRel{}, err
+	}
+	rowCols, err := a.rowColumns(proc, t)
+	if err != nil {
 		return scopeRel{}, err
 	}
 
-	p := overloads[0]
+	aliased := rf.Alias != nil && rf.Alias.Aliasname != nil && *rf.Alias.Aliasname != ""
+	if aliased {
+		name = *rf.Alias.Aliasname
+	}
 	rel := scopeRel{
 		alias: name,
-		cols:  []core.ClassColumn{{Name: name, TypeOID: p.ReturnTypeOID, NotNull: !p.ReturnNullable}},
+		cols:  []core.ClassColumn{{Name: name, TypeOID: t.typeOID, NotNull: !t.nullable}},
 	}
-	if rf.Alias != nil && rf.Alias.Aliasname != nil && *rf.Alias.Aliasname != "" {
-		rel.alias = *rf.Alias.Aliasname
+	if len(rowCols) > 0 {
+		rel.cols = rowCols
+	} else if !aliased {
+		typeName, _ := a.typeNameOf(t)
+		if fields, ok := core.StructFields(typeName); ok && t.typeOID != 0 {
+			rel.cols = rel.cols[:0]
+			for _, f := range fields {
+				ft := a.namedType(f.Type)
+				rel.cols = append(rel.cols, core.ClassColumn{Name: f.Name, TypeOID: ft.typeOID, NotNull: f.NotNull && !t.nullable})
+			}
+		}
+	}
+	if rf.Ordinality {
+		oid, err := a.cat.ConstTypeOID(core.ConstInteger)
+		if err != nil {
+			return scopeRel{}, err
+		}
+		rel.cols = append(rel.cols, core.ClassColumn{Name: "ordinality", TypeOID: oid, NotNull: true})
+	}
+	if rf.Alias != nil {
+		renameColumns(&rel, rf.Alias.Colnames)
 	}
 	return rel, nil
 }
 
+// nameFuncParams names a placeholder passed to a function after the argument
+// it is passed as, as a procedure call's are.
+func (a *analyzer) nameFuncParams(call *ast.FuncCall, proc *core.ProcOverload) error {
+	if proc == nil {
+		return nil
+	}
+	args, err := a.cat.ProcArgs(proc.OID)
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, arg := range args {
+		switch arg.Mode {
+		case "o", "t":
+			continue
+		}
+		names = append(names, arg.Name)
+	}
+	for i, arg := range listItems(call.Args) {
+		pr, ok := arg.(*ast.ParamRef)
+		if !ok || i >= len(names) || names[i] == "" {
+			continue
+		}
+		if p, ok := a.params[pr.Number]; ok && p.Name == "" {
+			p.Name = names[i]
+			a.params[pr.Number] = p
+		}
+	}
+	return nil
+}
+
+// rowColumns returns the columns of the rows a function returns: its OUT and
+// TABLE arguments or, for a function returning a relation's row type, the
+// relation's columns. A function returning anything else has none. Nothing
+// stops a function from returning NULL in any column.
+func (a *analyzer) rowColumns(proc *core.ProcOverload, t exprType) ([]core.ClassColumn, error) {
+	if proc == nil {
+		return nil, nil
+	}
+	args, err := a.cat.ProcArgs(proc.OID)
+	if err != nil {
+		return nil, err
+	}
+	var cols []core.ClassColumn
+	for _, arg := range args {
+		switch arg.Mode {
+		case "o", "b", "t":
+			cols = append(cols, core.ClassColumn{Name: arg.Name, TypeOID: arg.TypeOID, Num: len(cols) + 1})
+		}
+	}
+	if len(cols) > 0 {
+		return cols, nil
+	}
+
+	classOID, ok := a.rowTypeClass(t.typeOID)
+	if !ok {
+		return nil, nil
+	}
+	relCols, err := a.cat.ClassColumns(classOID)
+	if err != nil {
+		return nil, err
+	}
+	for _, col := range relCols {
+		cols = append(cols, core.ClassColumn{Name: col.Name, TypeOID: col.TypeOID, Num: col.Num})
+	}
+	return cols, nil
+}
+
+// rowTypeClass returns the relation a type is the row type of: the table,
+// view or composite type of the same name.
+func (a *analyzer) rowTypeClass(typeOID int64) (int64, bool) {
+	if typeOID == 0 {
+		return 0, false
+	}
+	name, err := a.cat.TypeName(typeOID)
+	if err != nil {
+		return 0, false
+	}
+	var schema string
+	if i := strings.LastIndexByte(name, '.'); i >= 0 {
+		schema, name = name[:i], name[i+1:]
+	}
+	classOID, err := a.cat.LookupClass("", schema, name)
+	if err != nil {
+		return 0, false
+	}
+	return classOID, true
+}
+
 func findFuncCall(l *ast.List) *ast.FuncCall {
 	for _, item := range listItems(l) {
 		switch v := item.(type) {
@@ -130,6 +292,201 @@ func findFuncCall(l *ast.List) *ast.FuncCall {
 	return nil
 }
 
+// bindRangePivot binds PIVOT, which keeps the columns of its input that
+// neither the aggregates nor the FOR expression read, and adds a column for
+// every value and aggregate. A value no row has leaves its column NULL.
+func (a *analyzer) bindRangePivot(p *ast.RangePivot) (scopeRel, error) {
+	input := &scope{}
+	if err := a.appendFromItem(input, p.Relation); err != nil {
+		return scopeRel{}, err
+	}
+	defer a.binding(input)()
+
+	read := map[string]bool{}
+	var aggs []exprType
+	for _, item := range listItems(p.Aggregates) {
+		rt, ok := item.(*ast.ResTarget)
+		if !ok {
+			continue
+		}
+		t, err := a.typeExpr(rt.Val)
+		if err != nil {
+			return scopeRel{}, fmt.Errorf("pivot: %w", err)
+		}
+		t.nullable = true
+		aggs = append(aggs, t)
+		columnNames(rt.Val, read)
+	}
+	forT, err := a.typeExpr(p.ForExpr)
+	if err != nil {
+		return scopeRel{}, fmt.Errorf("pivot: %w", err)
+	}
+	columnNames(p.ForExpr, read)
+
+	rel := scopeRel{}
+	if p.Alias != nil && p.Alias.Aliasname != nil {
+		rel.alias = *p.Alias.Aliasname
+	}
+	for _, r := range input.rels {
+		for _, c := range r.cols {
+			if !read[c.Name] {
+				rel.cols = append(rel.cols, c)
+			}
+		}
+	}
+	aggNames := listItems(p.Aggregates)
+	for _, item := range listItems(p.Values) {
+		rt, ok := item.(*ast.ResTarget)
+		if !ok {
+			continue
+		}
+		if err := a.typeOperands(rt.Val, forT); err != nil {
+			return scopeRel{}, fmt.Errorf("pivot: %w", err)
+		}
+		value := pivotValueName(rt)
+		for i, t := range aggs {
+			name := value
+			// With more than one aggregate, a column is named after both.
+			if len(aggs) > 1 {
+				if agg, ok := aggNames[i].(*ast.ResTarget); ok && agg.Name != nil {
+					name = *agg.Name + "_" + value
+				}
+			}
+			rel.cols = append(rel.cols, core.ClassColumn{Name: name, TypeOID: t.typeOID})
+		}
+	}
+	return rel, nil
+}
+
+// pivotValueName is the name of the column PIVOT adds for a value: its alias,
+// or the value itself.
+func pivotValueName(rt *ast.ResTarget) string {
+	if rt.Name != nil && *rt.Name != "" {
+		return *rt.Name
+	}
+	if c, ok := rt.Val.(*ast.A_Const); ok {
+		switch v := c.Val.(type) {
+		case *ast.String:
+			return v.Str
+		case *ast.Integer:
+			return strconv.FormatInt(v.Ival, 10)
+		case *ast.Float:
+			return v.Str
+		case *ast.Boolean:
+			return strconv.FormatBool(v.Boolval)
+		}
+	}
+	return "?column?"
+}
+
+// bindRangeUnpivot binds UNPIVOT, which keeps the columns of its input that
+// none of its items read, and adds the value columns, typed like the first
+// item's, and a string column naming the item a row came from.
+func (a *analyzer) bindRangeUnpivot(u *ast.RangeUnpivot) (scopeRel, error) {
+	input := &scope{}
+	if err := a.appendFromItem(input, u.Relation); err != nil {
+		return scopeRel{}, err
+	}
+	defer a.binding(input)()
+
+	read := map[string]bool{}
+	var values []exprType
+	for i, item := range listItems(u.Items) {
+		refs, _ := item.(*ast.List)
+		for _, ref := range listItems(refs) {
+			t, err := a.typeExpr(ref)
+			if err != nil {
+				return scopeRel{}, fmt.Errorf("unpivot: %w", err)
+			}
+			if i == 0 {
+				// A row whose values are all NULL is left out, unless
+				// INCLUDE NULLS keeps it, so a lone value column is never
+				// NULL.
+				t.nullable = u.IncludeNulls || len(listItems(u.ValueColumns)) > 1
+				values = append(values, t)
+			}
+			columnNames(ref, read)
+		}
+	}
+
+	rel := scopeRel{}
+	if u.Alias != nil && u.Alias.Aliasname != nil {
+		rel.alias = *u.Alias.Aliasname
+	}
+	for _, r := range input.rels {
+		for _, c := range r.cols {
+			if !read[c.Name] {
+				rel.cols = append(rel.cols, c)
+			}
+		}
+	}
+	for i, item := range listItems(u.ValueColumns) {
+		s, ok := item.(*ast.String)
+		if !ok || i >= len(values) {
+			continue
+		}
+		rel.cols = append(rel.cols, core.ClassColumn{Name: s.Str, TypeOID: values[i].typeOID, NotNull: !values[i].nullable})
+	}
+	oid, err := a.cat.ConstTypeOID(core.ConstString)
+	if err != nil {
+		return scopeRel{}, err
+	}
+	rel.cols = append(rel.cols, core.ClassColumn{Name: u.NameColumn, TypeOID: oid, NotNull: true})
+	return rel, nil
+}
+
+// columnNames adds the names of the columns an expression reads to names.
+func columnNames(n ast.Node, names map[string]bool) {
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		if cr, ok := node.(*ast.ColumnRef); ok {
+			if parts := flattenFields(cr.Fields); len(parts) > 0 {
+				names[parts[len(parts)-1]] = true
+			}
+		}
+	}), n)
+}
+
+// bindRangeTableFunc binds a table function such as JSON_TABLE, which reads
+// the rows of a document as a relation of the columns it declares. A path
+// that finds nothing yields NULL, so only an ordinality column is not null.
+func (a *analyzer) bindRangeTableFunc(tf *ast.RangeTableFunc) (scopeRel, error) {
+	// The document is typed against the scope built so far, since it is
+	// usually a column of a table before it.
+	if _, err := a.typeExpr(tf.Docexpr); err != nil {
+		return scopeRel{}, err
+	}
+	if _, err := a.typeExpr(tf.Rowexpr); err != nil {
+		return scopeRel{}, err
+	}
+	rel := scopeRel{}
+	if tf.Alias != nil && tf.Alias.Aliasname != nil {
+		rel.alias = *tf.Alias.Aliasname
+	}
+	for _, item := range listItems(tf.Columns) {
+		col, ok := item.(*ast.RangeTableFuncCol)
+		if !ok || col.Colname == nil {
+			continue
+		}
+		if col.ForOrdinality {
+			oid, err := a.cat.ConstTypeOID(core.ConstInteger)
+			if err != nil {
+				return scopeRel{}, err
+			}
+			rel.cols = append(rel.cols, core.ClassColumn{Name: *col.Colname, TypeOID: oid, NotNull: true})
+			continue
+		}
+		oid, err := a.cat.ResolveType(col.TypeName)
+		if err != nil {
+			return scopeRel{}, fmt.Errorf("column %s: %w", *col.Colname, err)
+		}
+		rel.cols = append(rel.cols, core.ClassColumn{Name: *col.Colname, TypeOID: oid, NotNull: col.IsNotNull})
+	}
+	if tf.Alias != nil {
+		renameColumns(&rel, tf.Alias.Colnames)
+	}
+	return rel, nil
+}
+
 // bindRangeSubselect binds a subquery used as a relation in FROM.
 func (a *analyzer) bindRangeSubselect(rs *ast.RangeSubselect) (scopeRel, error) {
 	sel, ok := rs.Subquery.(*ast.SelectStmt)
@@ -166,20 +523,16 @@ func (a *analyzer) bindRangeVar(rv *ast.RangeVar) (scopeRel, error) {
 		return cte, nil
 	}
 
-	schema := ""
+	var database, schema string
+	if rv.Catalogname != nil {
+		database = *rv.Catalogname
+	}
 	if rv.Schemaname != nil {
 		schema = *rv.Schemaname
 	}
-	if schema == "" {
-		schema = "public"
-	}
-	nsOID, err := a.cat.NamespaceOID(schema)
-	if err != nil {
-		return scopeRel{}, fmt.Errorf("schema %q: %w", schema, err)
-	}
-	classOID, err := a.cat.ClassOID(nsOID, relName)
+	classOID, err := a.cat.LookupClass(database, schema, relName)
 	if err != nil {
-		return scopeRel{}, fmt.Errorf("relation %q.%q: %w", schema, relName, err)
+		return scopeRel{}, err
 	}
 	rel := scopeRel{
 		alias:    relName,
@@ -20,6 +20,9 @@ type AttributeSpec struct {
 	AutoIncrement bool
 	IsPrimaryKey  bool
 	IsUnique      bool
+	IsGenerated   bool
+	Default       string
+	Comment       string
 }
 
 func (c *Catalog) CreateAttributeSpec(s AttributeSpec) error {
@@ -36,6 +39,9 @@ func (c *Catalog) CreateAttributeSpec(s AttributeSpec) error {
 		AutoIncrement: boolToInt64(s.AutoIncrement),
 		IsPrimaryKey:  boolToInt64(s.IsPrimaryKey),
 		IsUnique:      boolToInt64(s.IsUnique),
+		IsGenerated:   boolToInt64(s.IsGenerated),
+		DefaultExpr:   s.Default,
+		Comment:       s.Comment,
 	})
 	if err != nil {
 		return fmt.Errorf("create attribute %q on class %d: %w", s.Name, s.ClassOID, err)
@@ -54,21 +60,48 @@ func (c *Catalog) CreateAttribute(classOID int64, name string, typeOID int64, no
 	})
 }
 
-// DropAttribute removes a column from a relation.
+// SetAttributeComment records the comment on a column; an empty comment
+// removes it.
+func (c *Catalog) SetAttributeComment(classOID int64, name, comment string) error {
+	n, err := c.q.SetAttributeComment(context.Background(), catalogdb.SetAttributeCommentParams{
+		ClassOid: classOID,
+		Name:     name,
+		Comment:  comment,
+	})
+	if err != nil {
+		return fmt.Errorf("set comment of attribute %q on class %d: %w", name, classOID, err)
+	}
+	if n == 0 {
+		return fmt.Errorf("column %q does not exist", name)
+	}
+	return nil
+}
+
+// DropAttribute removes a column from a relation, along with the privileges
+// granted on it.
 func (c *Catalog) DropAttribute(classOID int64, name string) error {
-	err := c.q.DeleteAttribute(context.Background(), catalogdb.DeleteAttributeParams{
+	ctx := context.Background()
+	err := c.q.DeleteAttribute(ctx, catalogdb.DeleteAttributeParams{
 		ClassOid: classOID,
 		Name:     name,
 	})
 	if err != nil {
 		return fmt.Errorf("drop attribute %q on class %d: %w", name, classOID, err)
 	}
+	err = c.q.DeletePrivilegesByColumn(ctx, catalogdb.DeletePrivilegesByColumnParams{
+		ClassOid:   classOID,
+		ColumnName: name,
+	})
+	if err != nil {
+		return fmt.Errorf("drop privileges on attribute %q of class %d: %w", name, classOID, err)
+	}
 	return nil
 }
 
-// RenameAttribute renames a column.
+// RenameAttribute renames a column. The privileges granted on it follow it.
 func (c *Catalog) RenameAttribute(classOID int64, name, newName string) error {
-	err := c.q.RenameAttribute(context.Background(), catalogdb.RenameAttributeParams{
+	ctx := context.Background()
+	err := c.q.RenameAttribute(ctx, catalogdb.RenameAttributeParams{
 		ClassOid: classOID,
 		Name:     name,
 		NewName:  newName,
@@ -76,6 +109,14 @@ func (c *Catalog) RenameAttribute(classOID int64, name, newName string) error {
 	if err != nil {
 		return fmt.Errorf("rename attribute %q on class %d: %w", name, classOID, err)
 	}
+	err = c.q.RenamePrivilegeColumn(ctx, catalogdb.RenamePrivilegeColumnParams{
+		ClassOid: classOID,
+		Name:     name,
+		NewName:  newName,
+	})
+	if err != nil {
+		return fmt.Errorf("rename privileges on attribute %q of class %d: %w", name, classOID, err)
+	}
 	return nil
 }
 
@@ -213,6 +254,10 @@ type ClassColumn struct {
 	Name    string
 	TypeOID int64
 	NotNull bool
+	Num     int
+	// Generated is set for a column computed from an expression, which an
+	// INSERT does not give a value.
+	Generated bool
 }
 
 // ClassColumns returns a relation's columns in ordinal order.
@@ -224,10 +269,12 @@ func (c *Catalog) ClassColumns(classOID int64) ([]ClassColumn, error) {
 	out := make([]ClassColumn, 0, len(rows))
 	for _, r := range rows {
 		out = append(out, ClassColumn{
-			AttOID:  r.Oid,
-			Name:    r.Name,
-			TypeOID: r.TypeOid,
-			NotNull: r.NotNull != 0,
+			AttOID:    r.Oid,
+			Name:      r.Name,
+			TypeOID:   r.TypeOid,
+			NotNull:   r.NotNull != 0,
+			Num:       int(r.Num),
+			Generated: r.IsGenerated != 0,
 		})
 	}
 	return out, nil
@@ -237,6 +284,8 @@ type CodegenColumn struct {
 	Name     string
 	TypeName string
 	NotNull  bool
+	Default  string
+	Comment  string
 }
 
 func (c *Catalog) ClassCodegenColumns(classOID int64) ([]CodegenColumn, error) {
@@ -250,12 +299,15 @@ func (c *Catalog) ClassCodegenColumns(classOID int64) ([]CodegenColumn, error) {
 			Name:     r.ColumnName,
 			TypeName: r.TypeName,
 			NotNull:  r.NotNull != 0,
+			Default:  r.DefaultExpr,
+			Comment:  r.Comment,
 		})
 	}
 	return out, nil
 }
 
 type AttributeDetails struct {
+	Database      string
 	Schema        string
 	Table         string
 	Column        string
@@ -275,6 +327,7 @@ func (c *Catalog) LookupAttribute(attOID int64) (AttributeDetails, error) {
 		return AttributeDetails{}, fmt.Errorf("lookup attribute %d: %w", attOID, err)
 	}
 	return AttributeDetails{
+		Database:      c.DatabaseName(r.DatabaseOid, r.DatabaseName),
 		Schema:        r.SchemaName,
 		Table:         r.TableName,
 		Column:        r.ColumnName,
@@ -35,18 +35,25 @@ type rawConn interface {
 // catalog is, so they are one key, and a hit means apply is never called.
 //
 // The dialect data is embedded in the sqlc binary, whose digest is an input to
-// every action, so the dialect only has to be named. The schema is hashed in
-// the order it is applied, since DDL order decides what the catalog holds.
+// every action, so the dialect only has to be named. The catalog files a
+// configuration adds on top of it are not, so their contents are hashed. The
+// schema is hashed in the order it is applied, since DDL order decides what
+// the catalog holds.
+//
+// The settings decide where the schema's unqualified names land, so they are
+// hashed too, and are installed on the catalog whether it is built or
+// restored.
 //
 // A catalog whose apply fails is returned but not cached, so that a caller can
 // report the failure against the catalog it got. A nil catalog means the
 // catalog could not be created at all. A cache that cannot be opened, read or
 // written is not an error: the catalog is simply built the long way.
-func NewCached(dialect string, schema []string, apply func(*Catalog) error, opts ...Option) (*Catalog, error) {
-	store, action := openAction(dialect, schema)
+func NewCached(dialect string, settings Settings, catalogs, schema []string, apply func(*Catalog) error, opts ...Option) (*Catalog, error) {
+	store, action := openAction(dialect, settings, catalogs, schema)
 	if store != nil {
 		defer store.Close()
 		if cat, err := restore(store, action); err == nil {
+			cat.Configure(settings)
 			return cat, nil
 		} else if !errors.Is(err, cache.ErrNotFound) {
 			slog.Debug("restoring the catalog from the cache failed", "err", err)
@@ -57,6 +64,7 @@ func NewCached(dialect string, schema []string, apply func(*Catalog) error, opts
 	if err != nil {
 		return nil, err
 	}
+	cat.Configure(settings)
 	if err := apply(cat); err != nil {
 		return cat, err
 	}
@@ -70,13 +78,20 @@ func NewCached(dialect string, schema []string, apply func(*Catalog) error, opts
 
 // openAction opens the cache and digests the action that produces a catalog. A
 // nil store means the cache is unavailable and the catalog has to be built.
-func openAction(dialect string, schema []string) (*cache.Cache, cache.Digest) {
+func openAction(dialect string, settings Settings, catalogs, schema []string) (*cache.Cache, cache.Digest) {
 	store, err := cache.Open()
 	if err != nil {
 		slog.Debug("opening the cache failed", "err", err)
 		return nil, cache.Digest{}
 	}
 	action := store.NewAction("CoreCatalog").AddInput("dialect", []byte(dialect))
+	action.AddInput("database", []byte(settings.Database))
+	for _, ns := range settings.SearchPath {
+		action.AddInput("search_path", []byte(ns))
+	}
+	for _, c := range catalogs {
+		action.AddInput("catalog", []byte(c))
+	}
 	for _, s := range schema {
 		action.AddInput("schema", []byte(s))
 	}
@@ -112,6 +127,10 @@ func restore(store *cache.Cache, action cache.Digest) (*Catalog, error) {
 		return nil, fmt.Errorf("core: restored catalog has no dialect: %w", err)
 	}
 	cat.dialectOID = row.Oid
+	if err := cat.loadSearchPath(); err != nil {
+		cat.Close()
+		return nil, fmt.Errorf("core: restored catalog: %w", err)
+	}
 	return cat, nil
 }
 
@@ -28,6 +28,15 @@ type Catalog struct {
 	// once per extension name: a schema is free to say CREATE EXTENSION twice.
 	loadExtension func(name string) error
 	extensions    map[string]bool
+
+	// settings decide how names resolve. They come from the configuration
+	// rather than the catalog data, so they are set on every catalog, built
+	// or restored.
+	settings Settings
+
+	// schemaPath is the search path the schema set, which sql_search_path
+	// holds too. It is kept here because every unqualified name reads it.
+	schemaPath []string
 }
 
 type Option func(*Catalog) error
@@ -152,7 +161,10 @@ func (c *Catalog) DB() *sql.DB {
 }
 
 func (c *Catalog) bootstrap() error {
-	_, err := c.CreateNamespace("public")
+	if _, err := c.CreateDatabase(""); err != nil {
+		return err
+	}
+	_, err := c.CreateNamespace(DefaultNamespace)
 	return err
 }
 
@@ -22,6 +22,14 @@ type SqlAttribute struct {
 	AutoIncrement int64
 	IsPrimaryKey  int64
 	IsUnique      int64
+	IsGenerated   int64
+	DefaultExpr   string
+	Comment       string
+}
+
+type SqlAuthMember struct {
+	Role   string
+	Member string
 }
 
 type SqlCast struct {
@@ -33,18 +41,31 @@ type SqlCast struct {
 }
 
 type SqlClass struct {
-	Oid          int64
-	NamespaceOid int64
-	Name         string
-	Kind         string
+	Oid              int64
+	NamespaceOid     int64
+	Name             string
+	Kind             string
+	Owner            string
+	RowSecurity      int64
+	ForceRowSecurity int64
+	IsPartition      int64
+	Comment          string
 }
 
 type SqlConstraint struct {
-	Oid      int64
-	ClassOid int64
-	Name     string
-	Kind     string
-	Columns  string
+	Oid        int64
+	ClassOid   int64
+	Name       string
+	Kind       string
+	Columns    string
+	RefSchema  string
+	RefTable   string
+	RefColumns string
+}
+
+type SqlDatabase struct {
+	Oid  int64
+	Name string
 }
 
 type SqlDialect struct {
@@ -58,9 +79,16 @@ type SqlDialectFlag struct {
 	Value      string
 }
 
+type SqlInherit struct {
+	ClassOid  int64
+	ParentOid int64
+	Seqno     int64
+}
+
 type SqlNamespace struct {
-	Oid  int64
-	Name string
+	Oid         int64
+	DatabaseOid int64
+	Name        string
 }
 
 type SqlOperator struct {
@@ -76,6 +104,25 @@ type SqlOperator struct {
 	NegatorOid    sql.NullInt64
 }
 
+type SqlPolicy struct {
+	Oid        int64
+	ClassOid   int64
+	Name       string
+	Command    string
+	Permissive int64
+	Roles      string
+	HasUsing   int64
+	HasCheck   int64
+}
+
+type SqlPrivilege struct {
+	ClassOid    int64
+	Grantee     string
+	Privilege   string
+	ColumnName  string
+	GrantOption int64
+}
+
 type SqlProc struct {
 	Oid            int64
 	NamespaceOid   sql.NullInt64
@@ -98,6 +145,20 @@ type SqlProcArg struct {
 	HasDefault int64
 }
 
+type SqlSearchPath struct {
+	Position int64
+	Name     string
+}
+
+type SqlTrigger struct {
+	Oid        int64
+	ClassOid   int64
+	Name       string
+	Timing     string
+	Events     string
+	ForEachRow int64
+}
+
 type SqlType struct {
 	Oid          int64
 	NamespaceOid int64
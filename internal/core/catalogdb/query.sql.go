@@ -11,18 +11,34 @@ import (
 	"strings"
 )
 
+const addSearchPath = `-- name: AddSearchPath :exec
+INSERT INTO sql_search_path (position, name) VALUES (?, ?)
+`
+
+type AddSearchPathParams struct {
+	Position int64
+	Name     string
+}
+
+func (q *Queries) AddSearchPath(ctx context.Context, arg AddSearchPathParams) error {
+	_, err := q.db.ExecContext(ctx, addSearchPath, arg.Position, arg.Name)
+	return err
+}
+
 const classAttributes = `-- name: ClassAttributes :many
-SELECT oid, name, type_oid, not_null
+SELECT oid, name, type_oid, not_null, num, is_generated
 FROM sql_attribute
 WHERE class_oid = ?
 ORDER BY num
 `
 
 type ClassAttributesRow struct {
-	Oid     int64
-	Name    string
-	TypeOid int64
-	NotNull int64
+	Oid         int64
+	Name        string
+	TypeOid     int64
+	NotNull     int64
+	Num         int64
+	IsGenerated int64
 }
 
 func (q *Queries) ClassAttributes(ctx context.Context, classOid int64) ([]ClassAttributesRow, error) {
@@ -39,6 +55,125 @@ func (q *Queries) ClassAttributes(ctx context.Context, classOid int64) ([]ClassA
 			&i.Name,
 			&i.TypeOid,
 			&i.NotNull,
+			&i.Num,
+			&i.IsGenerated,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const classChildren = `-- name: ClassChildren :many
+SELECT class_oid FROM sql_inherits
+WHERE parent_oid = ?
+ORDER BY class_oid
+`
+
+func (q *Queries) ClassChildren(ctx context.Context, parentOid int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, classChildren, parentOid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var class_oid int64
+		if err := rows.Scan(&class_oid); err != nil {
+			return nil, err
+		}
+		items = append(items, class_oid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const classComment = `-- name: ClassComment :one
+SELECT comment FROM sql_class WHERE oid = ?
+`
+
+func (q *Queries) ClassComment(ctx context.Context, oid int64) (string, error) {
+	row := q.db.QueryRowContext(ctx, classComment, oid)
+	var comment string
+	err := row.Scan(&comment)
+	return comment, err
+}
+
+const classConstraints = `-- name: ClassConstraints :many
+SELECT kind, columns FROM sql_constraint
+WHERE class_oid = ?
+ORDER BY oid
+`
+
+type ClassConstraintsRow struct {
+	Kind    string
+	Columns string
+}
+
+func (q *Queries) ClassConstraints(ctx context.Context, classOid int64) ([]ClassConstraintsRow, error) {
+	rows, err := q.db.QueryContext(ctx, classConstraints, classOid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClassConstraintsRow
+	for rows.Next() {
+		var i ClassConstraintsRow
+		if err := rows.Scan(&i.Kind, &i.Columns); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const classForeignKeys = `-- name: ClassForeignKeys :many
+SELECT name, columns, ref_schema, ref_table, ref_columns FROM sql_constraint
+WHERE class_oid = ? AND kind = 'f'
+ORDER BY oid
+`
+
+type ClassForeignKeysRow struct {
+	Name       string
+	Columns    string
+	RefSchema  string
+	RefTable   string
+	RefColumns string
+}
+
+func (q *Queries) ClassForeignKeys(ctx context.Context, classOid int64) ([]ClassForeignKeysRow, error) {
+	rows, err := q.db.QueryContext(ctx, classForeignKeys, classOid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClassForeignKeysRow
+	for rows.Next() {
+		var i ClassForeignKeysRow
+		if err := rows.Scan(
+			&i.Name,
+			&i.Columns,
+			&i.RefSchema,
+			&i.RefTable,
+			&i.RefColumns,
 		); err != nil {
 			return nil, err
 		}
@@ -53,6 +188,39 @@ func (q *Queries) ClassAttributes(ctx context.Context, classOid int64) ([]ClassA
 	return items, nil
 }
 
+const classIsPartition = `-- name: ClassIsPartition :one
+SELECT is_partition FROM sql_class WHERE oid = ?
+`
+
+func (q *Queries) ClassIsPartition(ctx context.Context, oid int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, classIsPartition, oid)
+	var is_partition int64
+	err := row.Scan(&is_partition)
+	return is_partition, err
+}
+
+const classKind = `-- name: ClassKind :one
+SELECT kind FROM sql_class WHERE oid = ?
+`
+
+func (q *Queries) ClassKind(ctx context.Context, oid int64) (string, error) {
+	row := q.db.QueryRowContext(ctx, classKind, oid)
+	var kind string
+	err := row.Scan(&kind)
+	return kind, err
+}
+
+const classNamespaceOID = `-- name: ClassNamespaceOID :one
+SELECT namespace_oid FROM sql_class WHERE oid = ?
+`
+
+func (q *Queries) ClassNamespaceOID(ctx context.Context, oid int64) (int64, error) {
+	row := q.db.QueryRowContext(ctx, classNamespaceOID, oid)
+	var namespace_oid int64
+	err := row.Scan(&namespace_oid)
+	return namespace_oid, err
+}
+
 const classOID = `-- name: ClassOID :one
 SELECT oid FROM sql_class WHERE namespace_oid = ? AND name = ?
 `
@@ -80,13 +248,168 @@ func (q *Queries) ClassOIDByName(ctx context.Context, name string) (int64, error
 	return oid, err
 }
 
+const classParents = `-- name: ClassParents :many
+SELECT parent_oid FROM sql_inherits
+WHERE class_oid = ?
+ORDER BY seqno
+`
+
+func (q *Queries) ClassParents(ctx context.Context, classOid int64) ([]int64, error) {
+	rows, err := q.db.QueryContext(ctx, classParents, classOid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []int64
+	for rows.Next() {
+		var parent_oid int64
+		if err := rows.Scan(&parent_oid); err != nil {
+			return nil, err
+		}
+		items = append(items, parent_oid)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const classPolicies = `-- name: ClassPolicies :many
+SELECT name, command, permissive, roles, has_using, has_check FROM sql_policy
+WHERE class_oid = ?
+ORDER BY name
+`
+
+type ClassPoliciesRow struct {
+	Name       string
+	Command    string
+	Permissive int64
+	Roles      string
+	HasUsing   int64
+	HasCheck   int64
+}
+
+func (q *Queries) ClassPolicies(ctx context.Context, classOid int64) ([]ClassPoliciesRow, error) {
+	rows, err := q.db.QueryContext(ctx, classPolicies, classOid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClassPoliciesRow
+	for rows.Next() {
+		var i ClassPoliciesRow
+		if err := rows.Scan(
+			&i.Name,
+			&i.Command,
+			&i.Permissive,
+			&i.Roles,
+			&i.HasUsing,
+			&i.HasCheck,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const classPrivileges = `-- name: ClassPrivileges :many
+SELECT grantee, privilege, column_name, grant_option FROM sql_privilege
+WHERE class_oid = ?
+ORDER BY grantee, privilege, column_name
+`
+
+type ClassPrivilegesRow struct {
+	Grantee     string
+	Privilege   string
+	ColumnName  string
+	GrantOption int64
+}
+
+func (q *Queries) ClassPrivileges(ctx context.Context, classOid int64) ([]ClassPrivilegesRow, error) {
+	rows, err := q.db.QueryContext(ctx, classPrivileges, classOid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ClassPrivilegesRow
+	for rows.Next() {
+		var i ClassPrivilegesRow
+		if err := rows.Scan(
+			&i.Grantee,
+			&i.Privilege,
+			&i.ColumnName,
+			&i.GrantOption,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const classSecurity = `-- name: ClassSecurity :one
+SELECT ns.name AS schema_name, c.name, c.owner, c.row_security, c.force_row_security
+FROM sql_class c
+JOIN sql_namespace ns ON ns.oid = c.namespace_oid
+WHERE c.oid = ?
+`
+
+type ClassSecurityRow struct {
+	SchemaName       string
+	Name             string
+	Owner            string
+	RowSecurity      int64
+	ForceRowSecurity int64
+}
+
+func (q *Queries) ClassSecurity(ctx context.Context, oid int64) (ClassSecurityRow, error) {
+	row := q.db.QueryRowContext(ctx, classSecurity, oid)
+	var i ClassSecurityRow
+	err := row.Scan(
+		&i.SchemaName,
+		&i.Name,
+		&i.Owner,
+		&i.RowSecurity,
+		&i.ForceRowSecurity,
+	)
+	return i, err
+}
+
+const clearSearchPath = `-- name: ClearSearchPath :exec
+
+DELETE FROM sql_search_path
+`
+
+// =========================== sql_search_path ===========================
+func (q *Queries) ClearSearchPath(ctx context.Context) error {
+	_, err := q.db.ExecContext(ctx, clearSearchPath)
+	return err
+}
+
 const createAttribute = `-- name: CreateAttribute :exec
 
 INSERT INTO sql_attribute (
     class_oid, name, type_oid, not_null, has_default, num,
     decl_type, type_length, type_scale,
-    auto_increment, is_primary_key, is_unique
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
+    auto_increment, is_primary_key, is_unique, is_generated,
+    default_expr, comment
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 `
 
 type CreateAttributeParams struct {
@@ -102,6 +425,9 @@ type CreateAttributeParams struct {
 	AutoIncrement int64
 	IsPrimaryKey  int64
 	IsUnique      int64
+	IsGenerated   int64
+	DefaultExpr   string
+	Comment       string
 }
 
 // ============================= sql_attribute ===========================
@@ -119,6 +445,9 @@ func (q *Queries) CreateAttribute(ctx context.Context, arg CreateAttributeParams
 		arg.AutoIncrement,
 		arg.IsPrimaryKey,
 		arg.IsUnique,
+		arg.IsGenerated,
+		arg.DefaultExpr,
+		arg.Comment,
 	)
 	return err
 }
@@ -192,6 +521,20 @@ func (q *Queries) CreateConstraint(ctx context.Context, arg CreateConstraintPara
 	return err
 }
 
+const createDatabase = `-- name: CreateDatabase :execlastid
+
+INSERT INTO sql_database (name) VALUES (?)
+`
+
+// ============================ sql_database =============================
+func (q *Queries) CreateDatabase(ctx context.Context, name string) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createDatabase, name)
+	if err != nil {
+		return 0, err
+	}
+	return result.LastInsertId()
+}
+
 const createDialect = `-- name: CreateDialect :execlastid
 
 INSERT INTO sql_dialect (name) VALUES (?)
@@ -206,17 +549,65 @@ func (q *Queries) CreateDialect(ctx context.Context, name string) (int64, error)
 	return result.LastInsertId()
 }
 
+const createForeignKey = `-- name: CreateForeignKey :exec
+INSERT INTO sql_constraint (class_oid, name, kind, columns, ref_schema, ref_table, ref_columns)
+VALUES (?, ?, 'f', ?, ?, ?, ?)
+`
+
+type CreateForeignKeyParams struct {
+	ClassOid   int64
+	Name       string
+	Columns    string
+	RefSchema  string
+	RefTable   string
+	RefColumns string
+}
+
+func (q *Queries) CreateForeignKey(ctx context.Context, arg CreateForeignKeyParams) error {
+	_, err := q.db.ExecContext(ctx, createForeignKey,
+		arg.ClassOid,
+		arg.Name,
+		arg.Columns,
+		arg.RefSchema,
+		arg.RefTable,
+		arg.RefColumns,
+	)
+	return err
+}
+
+const createInherits = `-- name: CreateInherits :exec
+
+INSERT INTO sql_inherits (class_oid, parent_oid, seqno) VALUES (?, ?, ?)
+`
+
+type CreateInheritsParams struct {
+	ClassOid  int64
+	ParentOid int64
+	Seqno     int64
+}
+
+// ============================= sql_inherits ============================
+func (q *Queries) CreateInherits(ctx context.Context, arg CreateInheritsParams) error {
+	_, err := q.db.ExecContext(ctx, createInherits, arg.ClassOid, arg.ParentOid, arg.Seqno)
+	return err
+}
+
 const createNamespace = `-- name: CreateNamespace :execlastid
 
 
-INSERT INTO sql_namespace (name) VALUES (?)
+INSERT INTO sql_namespace (database_oid, name) VALUES (?, ?)
 `
 
+type CreateNamespaceParams struct {
+	DatabaseOid int64
+	Name        string
+}
+
 // Queries against sqlc's own sql_* catalog tables, compiled by sqlc's
 // SQLite engine. Regenerate with `go generate ./internal/core/...`.
 // ============================ sql_namespace ============================
-func (q *Queries) CreateNamespace(ctx context.Context, name string) (int64, error) {
-	result, err := q.db.ExecContext(ctx, createNamespace, name)
+func (q *Queries) CreateNamespace(ctx context.Context, arg CreateNamespaceParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, createNamespace, arg.DatabaseOid, arg.Name)
 	if err != nil {
 		return 0, err
 	}
@@ -258,6 +649,36 @@ func (q *Queries) CreateOperator(ctx context.Context, arg CreateOperatorParams)
 	return result.LastInsertId()
 }
 
+const createPolicy = `-- name: CreatePolicy :exec
+
+INSERT INTO sql_policy (class_oid, name, command, permissive, roles, has_using, has_check)
+VALUES (?, ?, ?, ?, ?, ?, ?)
+`
+
+type CreatePolicyParams struct {
+	ClassOid   int64
+	Name       string
+	Command    string
+	Permissive int64
+	Roles      string
+	HasUsing   int64
+	HasCheck   int64
+}
+
+// ============================== sql_policy =============================
+func (q *Queries) CreatePolicy(ctx context.Context, arg CreatePolicyParams) error {
+	_, err := q.db.ExecContext(ctx, createPolicy,
+		arg.ClassOid,
+		arg.Name,
+		arg.Command,
+		arg.Permissive,
+		arg.Roles,
+		arg.HasUsing,
+		arg.HasCheck,
+	)
+	return err
+}
+
 const createProc = `-- name: CreateProc :execlastid
 
 INSERT INTO sql_proc
@@ -323,6 +744,32 @@ func (q *Queries) CreateProcArg(ctx context.Context, arg CreateProcArgParams) er
 	return err
 }
 
+const createTrigger = `-- name: CreateTrigger :exec
+
+INSERT INTO sql_trigger (class_oid, name, timing, events, for_each_row)
+VALUES (?, ?, ?, ?, ?)
+`
+
+type CreateTriggerParams struct {
+	ClassOid   int64
+	Name       string
+	Timing     string
+	Events     string
+	ForEachRow int64
+}
+
+// ============================== sql_trigger ============================
+func (q *Queries) CreateTrigger(ctx context.Context, arg CreateTriggerParams) error {
+	_, err := q.db.ExecContext(ctx, createTrigger,
+		arg.ClassOid,
+		arg.Name,
+		arg.Timing,
+		arg.Events,
+		arg.ForEachRow,
+	)
+	return err
+}
+
 const createType = `-- name: CreateType :execlastid
 
 INSERT INTO sql_type
@@ -359,6 +806,17 @@ func (q *Queries) CreateType(ctx context.Context, arg CreateTypeParams) (int64,
 	return result.LastInsertId()
 }
 
+const databaseOID = `-- name: DatabaseOID :one
+SELECT oid FROM sql_database WHERE name = ?
+`
+
+func (q *Queries) DatabaseOID(ctx context.Context, name string) (int64, error) {
+	row := q.db.QueryRowContext(ctx, databaseOID, name)
+	var oid int64
+	err := row.Scan(&oid)
+	return oid, err
+}
+
 const deleteAttribute = `-- name: DeleteAttribute :exec
 DELETE FROM sql_attribute WHERE class_oid = ? AND name = ?
 `
@@ -391,6 +849,88 @@ func (q *Queries) DeleteClass(ctx context.Context, oid int64) error {
 	return err
 }
 
+const deleteConstraintsByClass = `-- name: DeleteConstraintsByClass :exec
+DELETE FROM sql_constraint WHERE class_oid = ?
+`
+
+func (q *Queries) DeleteConstraintsByClass(ctx context.Context, classOid int64) error {
+	_, err := q.db.ExecContext(ctx, deleteConstraintsByClass, classOid)
+	return err
+}
+
+const deleteInherits = `-- name: DeleteInherits :exec
+DELETE FROM sql_inherits WHERE class_oid = ? AND parent_oid = ?
+`
+
+type DeleteInheritsParams struct {
+	ClassOid  int64
+	ParentOid int64
+}
+
+func (q *Queries) DeleteInherits(ctx context.Context, arg DeleteInheritsParams) error {
+	_, err := q.db.ExecContext(ctx, deleteInherits, arg.ClassOid, arg.ParentOid)
+	return err
+}
+
+const deleteInheritsByClass = `-- name: DeleteInheritsByClass :exec
+DELETE FROM sql_inherits WHERE class_oid = ?
+`
+
+func (q *Queries) DeleteInheritsByClass(ctx context.Context, classOid int64) error {
+	_, err := q.db.ExecContext(ctx, deleteInheritsByClass, classOid)
+	return err
+}
+
+const deleteInheritsByParent = `-- name: DeleteInheritsByParent :exec
+DELETE FROM sql_inherits WHERE parent_oid = ?
+`
+
+func (q *Queries) DeleteInheritsByParent(ctx context.Context, parentOid int64) error {
+	_, err := q.db.ExecContext(ctx, deleteInheritsByParent, parentOid)
+	return err
+}
+
+const deletePoliciesByClass = `-- name: DeletePoliciesByClass :exec
+DELETE FROM sql_policy WHERE class_oid = ?
+`
+
+func (q *Queries) DeletePoliciesByClass(ctx context.Context, classOid int64) error {
+	_, err := q.db.ExecContext(ctx, deletePoliciesByClass, classOid)
+	return err
+}
+
+const deletePrivilegesByClass = `-- name: DeletePrivilegesByClass :exec
+DELETE FROM sql_privilege WHERE class_oid = ?
+`
+
+func (q *Queries) DeletePrivilegesByClass(ctx context.Context, classOid int64) error {
+	_, err := q.db.ExecContext(ctx, deletePrivilegesByClass, classOid)
+	return err
+}
+
+const deletePrivilegesByColumn = `-- name: DeletePrivilegesByColumn :exec
+DELETE FROM sql_privilege WHERE class_oid = ? AND column_name = ?
+`
+
+type DeletePrivilegesByColumnParams struct {
+	ClassOid   int64
+	ColumnName string
+}
+
+func (q *Queries) DeletePrivilegesByColumn(ctx context.Context, arg DeletePrivilegesByColumnParams) error {
+	_, err := q.db.ExecContext(ctx, deletePrivilegesByColumn, arg.ClassOid, arg.ColumnName)
+	return err
+}
+
+const deleteTriggersByClass = `-- name: DeleteTriggersByClass :exec
+DELETE FROM sql_trigger WHERE class_oid = ?
+`
+
+func (q *Queries) DeleteTriggersByClass(ctx context.Context, classOid int64) error {
+	_, err := q.db.ExecContext(ctx, deleteTriggersByClass, classOid)
+	return err
+}
+
 const dialectFlag = `-- name: DialectFlag :one
 SELECT value FROM sql_dialect_flag WHERE dialect_oid = ? AND key = ?
 `
@@ -496,7 +1036,7 @@ func (q *Queries) FindOperators(ctx context.Context, arg FindOperatorsParams) ([
 }
 
 const findProcsAnyNamespace = `-- name: FindProcsAnyNamespace :many
-SELECT oid, name, kind, return_type_oid, return_nullable
+SELECT oid, name, kind, return_type_oid, return_nullable, strict
 FROM sql_proc
 WHERE name = ?
 `
@@ -507,6 +1047,7 @@ type FindProcsAnyNamespaceRow struct {
 	Kind           string
 	ReturnTypeOid  int64
 	ReturnNullable int64
+	Strict         int64
 }
 
 func (q *Queries) FindProcsAnyNamespace(ctx context.Context, name string) ([]FindProcsAnyNamespaceRow, error) {
@@ -524,6 +1065,7 @@ func (q *Queries) FindProcsAnyNamespace(ctx context.Context, name string) ([]Fin
 			&i.Kind,
 			&i.ReturnTypeOid,
 			&i.ReturnNullable,
+			&i.Strict,
 		); err != nil {
 			return nil, err
 		}
@@ -539,7 +1081,7 @@ func (q *Queries) FindProcsAnyNamespace(ctx context.Context, name string) ([]Fin
 }
 
 const findProcsInNamespaces = `-- name: FindProcsInNamespaces :many
-SELECT oid, name, kind, return_type_oid, return_nullable
+SELECT oid, name, kind, return_type_oid, return_nullable, strict
 FROM sql_proc
 WHERE name = ?1
   AND namespace_oid IN (/*SLICE:namespace_oids*/?)
@@ -556,35 +1098,187 @@ type FindProcsInNamespacesRow struct {
 	Kind           string
 	ReturnTypeOid  int64
 	ReturnNullable int64
+	Strict         int64
+}
+
+func (q *Queries) FindProcsInNamespaces(ctx context.Context, arg FindProcsInNamespacesParams) ([]FindProcsInNamespacesRow, error) {
+	query := findProcsInNamespaces
+	var queryParams []interface{}
+	queryParams = append(queryParams, arg.Name)
+	if len(arg.NamespaceOids) > 0 {
+		for _, v := range arg.NamespaceOids {
+			queryParams = append(queryParams, v)
+		}
+		query = strings.Replace(query, "/*SLICE:namespace_oids*/?", strings.Repeat(",?", len(arg.NamespaceOids))[1:], 1)
+	} else {
+		query = strings.Replace(query, "/*SLICE:namespace_oids*/?", "NULL", 1)
+	}
+	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []FindProcsInNamespacesRow
+	for rows.Next() {
+		var i FindProcsInNamespacesRow
+		if err := rows.Scan(
+			&i.Oid,
+			&i.Name,
+			&i.Kind,
+			&i.ReturnTypeOid,
+			&i.ReturnNullable,
+			&i.Strict,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const grantPrivilege = `-- name: GrantPrivilege :exec
+
+INSERT INTO sql_privilege (class_oid, grantee, privilege, column_name, grant_option)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(class_oid, grantee, privilege, column_name)
+DO UPDATE SET grant_option = max(grant_option, excluded.grant_option)
+`
+
+type GrantPrivilegeParams struct {
+	ClassOid    int64
+	Grantee     string
+	Privilege   string
+	ColumnName  string
+	GrantOption int64
+}
+
+// ============================ sql_privilege ============================
+func (q *Queries) GrantPrivilege(ctx context.Context, arg GrantPrivilegeParams) error {
+	_, err := q.db.ExecContext(ctx, grantPrivilege,
+		arg.ClassOid,
+		arg.Grantee,
+		arg.Privilege,
+		arg.ColumnName,
+		arg.GrantOption,
+	)
+	return err
+}
+
+const grantRole = `-- name: GrantRole :exec
+
+INSERT INTO sql_auth_members (role, member) VALUES (?, ?)
+ON CONFLICT(role, member) DO NOTHING
+`
+
+type GrantRoleParams struct {
+	Role   string
+	Member string
+}
+
+// =========================== sql_auth_members ==========================
+func (q *Queries) GrantRole(ctx context.Context, arg GrantRoleParams) error {
+	_, err := q.db.ExecContext(ctx, grantRole, arg.Role, arg.Member)
+	return err
+}
+
+const indexExists = `-- name: IndexExists :one
+SELECT EXISTS (
+    SELECT 1 FROM sql_constraint c
+    JOIN sql_class r ON r.oid = c.class_oid
+    WHERE r.namespace_oid = ? AND c.name = ? AND c.kind IN ('i', 'u')
+)
+`
+
+type IndexExistsParams struct {
+	NamespaceOid int64
+	Name         string
+}
+
+func (q *Queries) IndexExists(ctx context.Context, arg IndexExistsParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, indexExists, arg.NamespaceOid, arg.Name)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
+const listClassColumns = `-- name: ListClassColumns :many
+SELECT a.name AS column_name, t.name AS type_name, a.not_null,
+       a.default_expr, a.comment
+FROM sql_attribute a
+JOIN sql_type t ON t.oid = a.type_oid
+WHERE a.class_oid = ?
+ORDER BY a.num
+`
+
+type ListClassColumnsRow struct {
+	ColumnName  string
+	TypeName    string
+	NotNull     int64
+	DefaultExpr string
+	Comment     string
+}
+
+func (q *Queries) ListClassColumns(ctx context.Context, classOid int64) ([]ListClassColumnsRow, error) {
+	rows, err := q.db.QueryContext(ctx, listClassColumns, classOid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListClassColumnsRow
+	for rows.Next() {
+		var i ListClassColumnsRow
+		if err := rows.Scan(
+			&i.ColumnName,
+			&i.TypeName,
+			&i.NotNull,
+			&i.DefaultExpr,
+			&i.Comment,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const listClassesOfKind = `-- name: ListClassesOfKind :many
+SELECT oid, name FROM sql_class
+WHERE namespace_oid = ? AND kind = ?
+ORDER BY oid
+`
+
+type ListClassesOfKindParams struct {
+	NamespaceOid int64
+	Kind         string
 }
 
-func (q *Queries) FindProcsInNamespaces(ctx context.Context, arg FindProcsInNamespacesParams) ([]FindProcsInNamespacesRow, error) {
-	query := findProcsInNamespaces
-	var queryParams []interface{}
-	queryParams = append(queryParams, arg.Name)
-	if len(arg.NamespaceOids) > 0 {
-		for _, v := range arg.NamespaceOids {
-			queryParams = append(queryParams, v)
-		}
-		query = strings.Replace(query, "/*SLICE:namespace_oids*/?", strings.Repeat(",?", len(arg.NamespaceOids))[1:], 1)
-	} else {
-		query = strings.Replace(query, "/*SLICE:namespace_oids*/?", "NULL", 1)
-	}
-	rows, err := q.db.QueryContext(ctx, query, queryParams...)
+type ListClassesOfKindRow struct {
+	Oid  int64
+	Name string
+}
+
+func (q *Queries) ListClassesOfKind(ctx context.Context, arg ListClassesOfKindParams) ([]ListClassesOfKindRow, error) {
+	rows, err := q.db.QueryContext(ctx, listClassesOfKind, arg.NamespaceOid, arg.Kind)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []FindProcsInNamespacesRow
+	var items []ListClassesOfKindRow
 	for rows.Next() {
-		var i FindProcsInNamespacesRow
-		if err := rows.Scan(
-			&i.Oid,
-			&i.Name,
-			&i.Kind,
-			&i.ReturnTypeOid,
-			&i.ReturnNullable,
-		); err != nil {
+		var i ListClassesOfKindRow
+		if err := rows.Scan(&i.Oid, &i.Name); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -598,30 +1292,25 @@ func (q *Queries) FindProcsInNamespaces(ctx context.Context, arg FindProcsInName
 	return items, nil
 }
 
-const listClassColumns = `-- name: ListClassColumns :many
-SELECT a.name AS column_name, t.name AS type_name, a.not_null
-FROM sql_attribute a
-JOIN sql_type t ON t.oid = a.type_oid
-WHERE a.class_oid = ?
-ORDER BY a.num
+const listNamespaces = `-- name: ListNamespaces :many
+SELECT oid, name FROM sql_namespace WHERE database_oid = ? ORDER BY oid
 `
 
-type ListClassColumnsRow struct {
-	ColumnName string
-	TypeName   string
-	NotNull    int64
+type ListNamespacesRow struct {
+	Oid  int64
+	Name string
 }
 
-func (q *Queries) ListClassColumns(ctx context.Context, classOid int64) ([]ListClassColumnsRow, error) {
-	rows, err := q.db.QueryContext(ctx, listClassColumns, classOid)
+func (q *Queries) ListNamespaces(ctx context.Context, databaseOid int64) ([]ListNamespacesRow, error) {
+	rows, err := q.db.QueryContext(ctx, listNamespaces, databaseOid)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []ListClassColumnsRow
+	var items []ListNamespacesRow
 	for rows.Next() {
-		var i ListClassColumnsRow
-		if err := rows.Scan(&i.ColumnName, &i.TypeName, &i.NotNull); err != nil {
+		var i ListNamespacesRow
+		if err := rows.Scan(&i.Oid, &i.Name); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -635,23 +1324,23 @@ func (q *Queries) ListClassColumns(ctx context.Context, classOid int64) ([]ListC
 	return items, nil
 }
 
-const listNamespaces = `-- name: ListNamespaces :many
-SELECT oid, name FROM sql_namespace ORDER BY oid
+const listSearchPath = `-- name: ListSearchPath :many
+SELECT name FROM sql_search_path ORDER BY position
 `
 
-func (q *Queries) ListNamespaces(ctx context.Context) ([]SqlNamespace, error) {
-	rows, err := q.db.QueryContext(ctx, listNamespaces)
+func (q *Queries) ListSearchPath(ctx context.Context) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, listSearchPath)
 	if err != nil {
 		return nil, err
 	}
 	defer rows.Close()
-	var items []SqlNamespace
+	var items []string
 	for rows.Next() {
-		var i SqlNamespace
-		if err := rows.Scan(&i.Oid, &i.Name); err != nil {
+		var name string
+		if err := rows.Scan(&name); err != nil {
 			return nil, err
 		}
-		items = append(items, i)
+		items = append(items, name)
 	}
 	if err := rows.Close(); err != nil {
 		return nil, err
@@ -664,7 +1353,7 @@ func (q *Queries) ListNamespaces(ctx context.Context) ([]SqlNamespace, error) {
 
 const listTablesInNamespace = `-- name: ListTablesInNamespace :many
 SELECT oid, name FROM sql_class
-WHERE namespace_oid = ? AND kind = 'r'
+WHERE namespace_oid = ? AND kind IN ('r', 'p')
 ORDER BY oid
 `
 
@@ -697,16 +1386,20 @@ func (q *Queries) ListTablesInNamespace(ctx context.Context, namespaceOid int64)
 }
 
 const lookupAttribute = `-- name: LookupAttribute :one
-SELECT ns.name AS schema_name, cls.name AS table_name, a.name AS column_name, a.num,
+SELECT ns.database_oid, d.name AS database_name, ns.name AS schema_name,
+       cls.name AS table_name, a.name AS column_name, a.num,
        a.decl_type, a.type_length, a.type_scale,
        a.auto_increment, a.is_primary_key, a.is_unique, a.not_null
 FROM sql_attribute a
 JOIN sql_class cls ON cls.oid = a.class_oid
 JOIN sql_namespace ns ON ns.oid = cls.namespace_oid
+JOIN sql_database d ON d.oid = ns.database_oid
 WHERE a.oid = ?
 `
 
 type LookupAttributeRow struct {
+	DatabaseOid   int64
+	DatabaseName  string
 	SchemaName    string
 	TableName     string
 	ColumnName    string
@@ -724,6 +1417,8 @@ func (q *Queries) LookupAttribute(ctx context.Context, oid int64) (LookupAttribu
 	row := q.db.QueryRowContext(ctx, lookupAttribute, oid)
 	var i LookupAttributeRow
 	err := row.Scan(
+		&i.DatabaseOid,
+		&i.DatabaseName,
 		&i.SchemaName,
 		&i.TableName,
 		&i.ColumnName,
@@ -739,18 +1434,52 @@ func (q *Queries) LookupAttribute(ctx context.Context, oid int64) (LookupAttribu
 	return i, err
 }
 
+const lookupPolicy = `-- name: LookupPolicy :one
+SELECT name, command, permissive, roles, has_using, has_check FROM sql_policy
+WHERE class_oid = ? AND name = ?
+`
+
+type LookupPolicyParams struct {
+	ClassOid int64
+	Name     string
+}
+
+type LookupPolicyRow struct {
+	Name       string
+	Command    string
+	Permissive int64
+	Roles      string
+	HasUsing   int64
+	HasCheck   int64
+}
+
+func (q *Queries) LookupPolicy(ctx context.Context, arg LookupPolicyParams) (LookupPolicyRow, error) {
+	row := q.db.QueryRowContext(ctx, lookupPolicy, arg.ClassOid, arg.Name)
+	var i LookupPolicyRow
+	err := row.Scan(
+		&i.Name,
+		&i.Command,
+		&i.Permissive,
+		&i.Roles,
+		&i.HasUsing,
+		&i.HasCheck,
+	)
+	return i, err
+}
+
 const lookupType = `-- name: LookupType :one
-SELECT oid, name, category, typtype, preferred
+SELECT oid, name, category, typtype, preferred, element_oid
 FROM sql_type
 WHERE oid = ?
 `
 
 type LookupTypeRow struct {
-	Oid       int64
-	Name      string
-	Category  sql.NullString
-	Typtype   string
-	Preferred int64
+	Oid        int64
+	Name       string
+	Category   sql.NullString
+	Typtype    string
+	Preferred  int64
+	ElementOid sql.NullInt64
 }
 
 func (q *Queries) LookupType(ctx context.Context, oid int64) (LookupTypeRow, error) {
@@ -762,6 +1491,7 @@ func (q *Queries) LookupType(ctx context.Context, oid int64) (LookupTypeRow, err
 		&i.Category,
 		&i.Typtype,
 		&i.Preferred,
+		&i.ElementOid,
 	)
 	return i, err
 }
@@ -778,16 +1508,39 @@ func (q *Queries) MaxAttributeNum(ctx context.Context, classOid int64) (int64, e
 }
 
 const namespaceOID = `-- name: NamespaceOID :one
-SELECT oid FROM sql_namespace WHERE name = ?
+SELECT oid FROM sql_namespace WHERE database_oid = ? AND name = ?
 `
 
-func (q *Queries) NamespaceOID(ctx context.Context, name string) (int64, error) {
-	row := q.db.QueryRowContext(ctx, namespaceOID, name)
+type NamespaceOIDParams struct {
+	DatabaseOid int64
+	Name        string
+}
+
+func (q *Queries) NamespaceOID(ctx context.Context, arg NamespaceOIDParams) (int64, error) {
+	row := q.db.QueryRowContext(ctx, namespaceOID, arg.DatabaseOid, arg.Name)
 	var oid int64
 	err := row.Scan(&oid)
 	return oid, err
 }
 
+const policyExists = `-- name: PolicyExists :one
+SELECT EXISTS (
+    SELECT 1 FROM sql_policy WHERE class_oid = ? AND name = ?
+)
+`
+
+type PolicyExistsParams struct {
+	ClassOid int64
+	Name     string
+}
+
+func (q *Queries) PolicyExists(ctx context.Context, arg PolicyExistsParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, policyExists, arg.ClassOid, arg.Name)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const procArgTypes = `-- name: ProcArgTypes :many
 SELECT type_oid FROM sql_proc_arg
 WHERE proc_oid = ? AND mode IN ('i', 'b', 'v')
@@ -817,6 +1570,47 @@ func (q *Queries) ProcArgTypes(ctx context.Context, procOid int64) ([]int64, err
 	return items, nil
 }
 
+const procArgs = `-- name: ProcArgs :many
+SELECT name, type_oid, mode, has_default FROM sql_proc_arg
+WHERE proc_oid = ?
+ORDER BY ord
+`
+
+type ProcArgsRow struct {
+	Name       string
+	TypeOid    int64
+	Mode       string
+	HasDefault int64
+}
+
+func (q *Queries) ProcArgs(ctx context.Context, procOid int64) ([]ProcArgsRow, error) {
+	rows, err := q.db.QueryContext(ctx, procArgs, procOid)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ProcArgsRow
+	for rows.Next() {
+		var i ProcArgsRow
+		if err := rows.Scan(
+			&i.Name,
+			&i.TypeOid,
+			&i.Mode,
+			&i.HasDefault,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const renameAttribute = `-- name: RenameAttribute :exec
 UPDATE sql_attribute SET name = ?1
 WHERE class_oid = ?2 AND name = ?3
@@ -847,6 +1641,22 @@ func (q *Queries) RenameClass(ctx context.Context, arg RenameClassParams) error
 	return err
 }
 
+const renamePrivilegeColumn = `-- name: RenamePrivilegeColumn :exec
+UPDATE sql_privilege SET column_name = ?1
+WHERE class_oid = ?2 AND column_name = ?3
+`
+
+type RenamePrivilegeColumnParams struct {
+	NewName  string
+	ClassOid int64
+	Name     string
+}
+
+func (q *Queries) RenamePrivilegeColumn(ctx context.Context, arg RenamePrivilegeColumnParams) error {
+	_, err := q.db.ExecContext(ctx, renamePrivilegeColumn, arg.NewName, arg.ClassOid, arg.Name)
+	return err
+}
+
 const resolveColumn = `-- name: ResolveColumn :one
 SELECT a.oid, a.class_oid, a.name, t.name AS type_name, a.type_oid, a.not_null,
        a.decl_type, a.type_length, a.type_scale,
@@ -897,6 +1707,123 @@ func (q *Queries) ResolveColumn(ctx context.Context, arg ResolveColumnParams) (R
 	return i, err
 }
 
+const revokeColumnGrantOption = `-- name: RevokeColumnGrantOption :exec
+UPDATE sql_privilege SET grant_option = 0
+WHERE class_oid = ? AND grantee = ? AND privilege = ? AND column_name = ?
+`
+
+type RevokeColumnGrantOptionParams struct {
+	ClassOid   int64
+	Grantee    string
+	Privilege  string
+	ColumnName string
+}
+
+func (q *Queries) RevokeColumnGrantOption(ctx context.Context, arg RevokeColumnGrantOptionParams) error {
+	_, err := q.db.ExecContext(ctx, revokeColumnGrantOption,
+		arg.ClassOid,
+		arg.Grantee,
+		arg.Privilege,
+		arg.ColumnName,
+	)
+	return err
+}
+
+const revokeColumnPrivilege = `-- name: RevokeColumnPrivilege :exec
+DELETE FROM sql_privilege
+WHERE class_oid = ? AND grantee = ? AND privilege = ? AND column_name = ?
+`
+
+type RevokeColumnPrivilegeParams struct {
+	ClassOid   int64
+	Grantee    string
+	Privilege  string
+	ColumnName string
+}
+
+func (q *Queries) RevokeColumnPrivilege(ctx context.Context, arg RevokeColumnPrivilegeParams) error {
+	_, err := q.db.ExecContext(ctx, revokeColumnPrivilege,
+		arg.ClassOid,
+		arg.Grantee,
+		arg.Privilege,
+		arg.ColumnName,
+	)
+	return err
+}
+
+const revokeGrantOption = `-- name: RevokeGrantOption :exec
+UPDATE sql_privilege SET grant_option = 0
+WHERE class_oid = ? AND grantee = ? AND privilege = ?
+`
+
+type RevokeGrantOptionParams struct {
+	ClassOid  int64
+	Grantee   string
+	Privilege string
+}
+
+func (q *Queries) RevokeGrantOption(ctx context.Context, arg RevokeGrantOptionParams) error {
+	_, err := q.db.ExecContext(ctx, revokeGrantOption, arg.ClassOid, arg.Grantee, arg.Privilege)
+	return err
+}
+
+const revokePrivilege = `-- name: RevokePrivilege :exec
+DELETE FROM sql_privilege WHERE class_oid = ? AND grantee = ? AND privilege = ?
+`
+
+type RevokePrivilegeParams struct {
+	ClassOid  int64
+	Grantee   string
+	Privilege string
+}
+
+// Revoking a privilege on a relation revokes it on each of its columns too.
+func (q *Queries) RevokePrivilege(ctx context.Context, arg RevokePrivilegeParams) error {
+	_, err := q.db.ExecContext(ctx, revokePrivilege, arg.ClassOid, arg.Grantee, arg.Privilege)
+	return err
+}
+
+const revokeRole = `-- name: RevokeRole :exec
+DELETE FROM sql_auth_members WHERE role = ? AND member = ?
+`
+
+type RevokeRoleParams struct {
+	Role   string
+	Member string
+}
+
+func (q *Queries) RevokeRole(ctx context.Context, arg RevokeRoleParams) error {
+	_, err := q.db.ExecContext(ctx, revokeRole, arg.Role, arg.Member)
+	return err
+}
+
+const rolesOfMember = `-- name: RolesOfMember :many
+SELECT role FROM sql_auth_members WHERE member = ? ORDER BY role
+`
+
+func (q *Queries) RolesOfMember(ctx context.Context, member string) ([]string, error) {
+	rows, err := q.db.QueryContext(ctx, rolesOfMember, member)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []string
+	for rows.Next() {
+		var role string
+		if err := rows.Scan(&role); err != nil {
+			return nil, err
+		}
+		items = append(items, role)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const seededDialect = `-- name: SeededDialect :one
 SELECT oid, name FROM sql_dialect ORDER BY oid LIMIT 1
 `
@@ -910,6 +1837,25 @@ func (q *Queries) SeededDialect(ctx context.Context) (SqlDialect, error) {
 	return i, err
 }
 
+const setAttributeComment = `-- name: SetAttributeComment :execrows
+UPDATE sql_attribute SET comment = ?1
+WHERE class_oid = ?2 AND name = ?3
+`
+
+type SetAttributeCommentParams struct {
+	Comment  string
+	ClassOid int64
+	Name     string
+}
+
+func (q *Queries) SetAttributeComment(ctx context.Context, arg SetAttributeCommentParams) (int64, error) {
+	result, err := q.db.ExecContext(ctx, setAttributeComment, arg.Comment, arg.ClassOid, arg.Name)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const setAttributeNotNull = `-- name: SetAttributeNotNull :exec
 UPDATE sql_attribute SET not_null = ?1
 WHERE class_oid = ?2 AND name = ?3
@@ -978,6 +1924,76 @@ func (q *Queries) SetAttributeUnique(ctx context.Context, arg SetAttributeUnique
 	return err
 }
 
+const setClassComment = `-- name: SetClassComment :exec
+UPDATE sql_class SET comment = ?1 WHERE oid = ?2
+`
+
+type SetClassCommentParams struct {
+	Comment string
+	Oid     int64
+}
+
+func (q *Queries) SetClassComment(ctx context.Context, arg SetClassCommentParams) error {
+	_, err := q.db.ExecContext(ctx, setClassComment, arg.Comment, arg.Oid)
+	return err
+}
+
+const setClassForceRowSecurity = `-- name: SetClassForceRowSecurity :exec
+UPDATE sql_class SET force_row_security = ?1 WHERE oid = ?2
+`
+
+type SetClassForceRowSecurityParams struct {
+	ForceRowSecurity int64
+	Oid              int64
+}
+
+func (q *Queries) SetClassForceRowSecurity(ctx context.Context, arg SetClassForceRowSecurityParams) error {
+	_, err := q.db.ExecContext(ctx, setClassForceRowSecurity, arg.ForceRowSecurity, arg.Oid)
+	return err
+}
+
+const setClassIsPartition = `-- name: SetClassIsPartition :exec
+UPDATE sql_class SET is_partition = ?1 WHERE oid = ?2
+`
+
+type SetClassIsPartitionParams struct {
+	IsPartition int64
+	Oid         int64
+}
+
+func (q *Queries) SetClassIsPartition(ctx context.Context, arg SetClassIsPartitionParams) error {
+	_, err := q.db.ExecContext(ctx, setClassIsPartition, arg.IsPartition, arg.Oid)
+	return err
+}
+
+const setClassOwner = `-- name: SetClassOwner :exec
+UPDATE sql_class SET owner = ?1 WHERE oid = ?2
+`
+
+type SetClassOwnerParams struct {
+	Owner string
+	Oid   int64
+}
+
+func (q *Queries) SetClassOwner(ctx context.Context, arg SetClassOwnerParams) error {
+	_, err := q.db.ExecContext(ctx, setClassOwner, arg.Owner, arg.Oid)
+	return err
+}
+
+const setClassRowSecurity = `-- name: SetClassRowSecurity :exec
+UPDATE sql_class SET row_security = ?1 WHERE oid = ?2
+`
+
+type SetClassRowSecurityParams struct {
+	RowSecurity int64
+	Oid         int64
+}
+
+func (q *Queries) SetClassRowSecurity(ctx context.Context, arg SetClassRowSecurityParams) error {
+	_, err := q.db.ExecContext(ctx, setClassRowSecurity, arg.RowSecurity, arg.Oid)
+	return err
+}
+
 const setDialectFlag = `-- name: SetDialectFlag :exec
 INSERT INTO sql_dialect_flag (dialect_oid, key, value)
 VALUES (?, ?, ?)
@@ -1057,6 +2073,24 @@ func (q *Queries) TableColumns(ctx context.Context, name string) ([]TableColumns
 	return items, nil
 }
 
+const triggerExists = `-- name: TriggerExists :one
+SELECT EXISTS (
+    SELECT 1 FROM sql_trigger WHERE class_oid = ? AND name = ?
+)
+`
+
+type TriggerExistsParams struct {
+	ClassOid int64
+	Name     string
+}
+
+func (q *Queries) TriggerExists(ctx context.Context, arg TriggerExistsParams) (bool, error) {
+	row := q.db.QueryRowContext(ctx, triggerExists, arg.ClassOid, arg.Name)
+	var exists bool
+	err := row.Scan(&exists)
+	return exists, err
+}
+
 const typeNameByOID = `-- name: TypeNameByOID :one
 SELECT name FROM sql_type WHERE oid = ?
 `
@@ -1123,3 +2157,41 @@ func (q *Queries) TypeOIDsInCategory(ctx context.Context, arg TypeOIDsInCategory
 	}
 	return items, nil
 }
+
+const typeSchemaByOID = `-- name: TypeSchemaByOID :one
+SELECT ns.name
+FROM sql_type t
+JOIN sql_namespace ns ON ns.oid = t.namespace_oid
+WHERE t.oid = ?
+`
+
+func (q *Queries) TypeSchemaByOID(ctx context.Context, oid int64) (string, error) {
+	row := q.db.QueryRowContext(ctx, typeSchemaByOID, oid)
+	var name string
+	err := row.Scan(&name)
+	return name, err
+}
+
+const updatePolicy = `-- name: UpdatePolicy :exec
+UPDATE sql_policy SET roles = ?, has_using = ?, has_check = ?
+WHERE class_oid = ? AND name = ?
+`
+
+type UpdatePolicyParams struct {
+	Roles    string
+	HasUsing int64
+	HasCheck int64
+	ClassOid int64
+	Name     string
+}
+
+func (q *Queries) UpdatePolicy(ctx context.Context, arg UpdatePolicyParams) error {
+	_, err := q.db.ExecContext(ctx, updatePolicy,
+		arg.Roles,
+		arg.HasUsing,
+		arg.HasCheck,
+		arg.ClassOid,
+		arg.Name,
+	)
+	return err
+}
@@ -4,13 +4,32 @@
 -- ============================ sql_namespace ============================
 
 -- name: CreateNamespace :execlastid
-INSERT INTO sql_namespace (name) VALUES (?);
+INSERT INTO sql_namespace (database_oid, name) VALUES (?, ?);
 
 -- name: NamespaceOID :one
-SELECT oid FROM sql_namespace WHERE name = ?;
+SELECT oid FROM sql_namespace WHERE database_oid = ? AND name = ?;
 
 -- name: ListNamespaces :many
-SELECT oid, name FROM sql_namespace ORDER BY oid;
+SELECT oid, name FROM sql_namespace WHERE database_oid = ? ORDER BY oid;
+
+-- =========================== sql_search_path ===========================
+
+-- name: ClearSearchPath :exec
+DELETE FROM sql_search_path;
+
+-- name: AddSearchPath :exec
+INSERT INTO sql_search_path (position, name) VALUES (?, ?);
+
+-- name: ListSearchPath :many
+SELECT name FROM sql_search_path ORDER BY position;
+
+-- ============================ sql_database =============================
+
+-- name: CreateDatabase :execlastid
+INSERT INTO sql_database (name) VALUES (?);
+
+-- name: DatabaseOID :one
+SELECT oid FROM sql_database WHERE name = ?;
 
 -- ============================== sql_dialect ============================
 
@@ -57,13 +76,19 @@ LIMIT 1;
 -- name: TypeNameByOID :one
 SELECT name FROM sql_type WHERE oid = ?;
 
+-- name: TypeSchemaByOID :one
+SELECT ns.name
+FROM sql_type t
+JOIN sql_namespace ns ON ns.oid = t.namespace_oid
+WHERE t.oid = ?;
+
 -- name: TypeOIDsInCategory :many
 SELECT oid FROM sql_type
 WHERE dialect_oid = ? AND category = ?
 ORDER BY oid;
 
 -- name: LookupType :one
-SELECT oid, name, category, typtype, preferred
+SELECT oid, name, category, typtype, preferred, element_oid
 FROM sql_type
 WHERE oid = ?;
 
@@ -78,25 +103,92 @@ SELECT oid FROM sql_class WHERE namespace_oid = ? AND name = ?;
 -- name: ClassOIDByName :one
 SELECT oid FROM sql_class WHERE name = ? LIMIT 1;
 
+-- name: ClassNamespaceOID :one
+SELECT namespace_oid FROM sql_class WHERE oid = ?;
+
 -- name: ListTablesInNamespace :many
 SELECT oid, name FROM sql_class
-WHERE namespace_oid = ? AND kind = 'r'
+WHERE namespace_oid = ? AND kind IN ('r', 'p')
 ORDER BY oid;
 
+-- name: ListClassesOfKind :many
+SELECT oid, name FROM sql_class
+WHERE namespace_oid = ? AND kind = ?
+ORDER BY oid;
+
+-- name: ClassKind :one
+SELECT kind FROM sql_class WHERE oid = ?;
+
 -- name: DeleteClass :exec
 DELETE FROM sql_class WHERE oid = ?;
 
 -- name: RenameClass :exec
 UPDATE sql_class SET name = sqlc.arg(new_name) WHERE oid = sqlc.arg(oid);
 
+-- name: SetClassOwner :exec
+UPDATE sql_class SET owner = sqlc.arg(owner) WHERE oid = sqlc.arg(oid);
+
+-- name: SetClassRowSecurity :exec
+UPDATE sql_class SET row_security = sqlc.arg(row_security) WHERE oid = sqlc.arg(oid);
+
+-- name: SetClassForceRowSecurity :exec
+UPDATE sql_class SET force_row_security = sqlc.arg(force_row_security) WHERE oid = sqlc.arg(oid);
+
+-- name: ClassSecurity :one
+SELECT ns.name AS schema_name, c.name, c.owner, c.row_security, c.force_row_security
+FROM sql_class c
+JOIN sql_namespace ns ON ns.oid = c.namespace_oid
+WHERE c.oid = ?;
+
+-- name: SetClassIsPartition :exec
+UPDATE sql_class SET is_partition = sqlc.arg(is_partition) WHERE oid = sqlc.arg(oid);
+
+-- name: ClassIsPartition :one
+SELECT is_partition FROM sql_class WHERE oid = ?;
+
+-- name: SetClassComment :exec
+UPDATE sql_class SET comment = sqlc.arg(comment) WHERE oid = sqlc.arg(oid);
+
+-- name: ClassComment :one
+SELECT comment FROM sql_class WHERE oid = ?;
+
+-- ============================= sql_inherits ============================
+
+-- name: CreateInherits :exec
+INSERT INTO sql_inherits (class_oid, parent_oid, seqno) VALUES (?, ?, ?);
+
+-- name: DeleteInherits :exec
+DELETE FROM sql_inherits WHERE class_oid = ? AND parent_oid = ?;
+
+-- name: DeleteInheritsByClass :exec
+DELETE FROM sql_inherits WHERE class_oid = ?;
+
+-- name: DeleteInheritsByParent :exec
+DELETE FROM sql_inherits WHERE parent_oid = ?;
+
+-- name: ClassParents :many
+SELECT parent_oid FROM sql_inherits
+WHERE class_oid = ?
+ORDER BY seqno;
+
+-- name: ClassChildren :many
+SELECT class_oid FROM sql_inherits
+WHERE parent_oid = ?
+ORDER BY class_oid;
+
 -- ============================= sql_attribute ===========================
 
 -- name: CreateAttribute :exec
 INSERT INTO sql_attribute (
     class_oid, name, type_oid, not_null, has_default, num,
     decl_type, type_length, type_scale,
-    auto_increment, is_primary_key, is_unique
-) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+    auto_increment, is_primary_key, is_unique, is_generated,
+    default_expr, comment
+) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?);
+
+-- name: SetAttributeComment :execrows
+UPDATE sql_attribute SET comment = sqlc.arg(comment)
+WHERE class_oid = sqlc.arg(class_oid) AND name = sqlc.arg(name);
 
 -- name: SetAttributePrimaryKey :exec
 UPDATE sql_attribute SET is_primary_key = 1, not_null = 1
@@ -147,25 +239,28 @@ WHERE c.name = ?
 ORDER BY a.num;
 
 -- name: ClassAttributes :many
-SELECT oid, name, type_oid, not_null
+SELECT oid, name, type_oid, not_null, num, is_generated
 FROM sql_attribute
 WHERE class_oid = ?
 ORDER BY num;
 
 -- name: ListClassColumns :many
-SELECT a.name AS column_name, t.name AS type_name, a.not_null
+SELECT a.name AS column_name, t.name AS type_name, a.not_null,
+       a.default_expr, a.comment
 FROM sql_attribute a
 JOIN sql_type t ON t.oid = a.type_oid
 WHERE a.class_oid = ?
 ORDER BY a.num;
 
 -- name: LookupAttribute :one
-SELECT ns.name AS schema_name, cls.name AS table_name, a.name AS column_name, a.num,
+SELECT ns.database_oid, d.name AS database_name, ns.name AS schema_name,
+       cls.name AS table_name, a.name AS column_name, a.num,
        a.decl_type, a.type_length, a.type_scale,
        a.auto_increment, a.is_primary_key, a.is_unique, a.not_null
 FROM sql_attribute a
 JOIN sql_class cls ON cls.oid = a.class_oid
 JOIN sql_namespace ns ON ns.oid = cls.namespace_oid
+JOIN sql_database d ON d.oid = ns.database_oid
 WHERE a.oid = ?;
 
 -- ============================ sql_constraint ===========================
@@ -173,6 +268,122 @@ WHERE a.oid = ?;
 -- name: CreateConstraint :exec
 INSERT INTO sql_constraint (class_oid, name, kind, columns) VALUES (?, ?, ?, ?);
 
+-- name: CreateForeignKey :exec
+INSERT INTO sql_constraint (class_oid, name, kind, columns, ref_schema, ref_table, ref_columns)
+VALUES (?, ?, 'f', ?, ?, ?, ?);
+
+-- name: ClassForeignKeys :many
+SELECT name, columns, ref_schema, ref_table, ref_columns FROM sql_constraint
+WHERE class_oid = ? AND kind = 'f'
+ORDER BY oid;
+
+-- name: ClassConstraints :many
+SELECT kind, columns FROM sql_constraint
+WHERE class_oid = ?
+ORDER BY oid;
+
+-- name: DeleteConstraintsByClass :exec
+DELETE FROM sql_constraint WHERE class_oid = ?;
+
+-- name: IndexExists :one
+SELECT EXISTS (
+    SELECT 1 FROM sql_constraint c
+    JOIN sql_class r ON r.oid = c.class_oid
+    WHERE r.namespace_oid = ? AND c.name = ? AND c.kind IN ('i', 'u')
+);
+
+-- ============================== sql_trigger ============================
+
+-- name: CreateTrigger :exec
+INSERT INTO sql_trigger (class_oid, name, timing, events, for_each_row)
+VALUES (?, ?, ?, ?, ?);
+
+-- name: TriggerExists :one
+SELECT EXISTS (
+    SELECT 1 FROM sql_trigger WHERE class_oid = ? AND name = ?
+);
+
+-- name: DeleteTriggersByClass :exec
+DELETE FROM sql_trigger WHERE class_oid = ?;
+
+-- ============================ sql_privilege ============================
+
+-- name: GrantPrivilege :exec
+INSERT INTO sql_privilege (class_oid, grantee, privilege, column_name, grant_option)
+VALUES (?, ?, ?, ?, ?)
+ON CONFLICT(class_oid, grantee, privilege, column_name)
+DO UPDATE SET grant_option = max(grant_option, excluded.grant_option);
+
+-- name: RevokePrivilege :exec
+-- Revoking a privilege on a relation revokes it on each of its columns too.
+DELETE FROM sql_privilege WHERE class_oid = ? AND grantee = ? AND privilege = ?;
+
+-- name: RevokeColumnPrivilege :exec
+DELETE FROM sql_privilege
+WHERE class_oid = ? AND grantee = ? AND privilege = ? AND column_name = ?;
+
+-- name: RevokeGrantOption :exec
+UPDATE sql_privilege SET grant_option = 0
+WHERE class_oid = ? AND grantee = ? AND privilege = ?;
+
+-- name: RevokeColumnGrantOption :exec
+UPDATE sql_privilege SET grant_option = 0
+WHERE class_oid = ? AND grantee = ? AND privilege = ? AND column_name = ?;
+
+-- name: ClassPrivileges :many
+SELECT grantee, privilege, column_name, grant_option FROM sql_privilege
+WHERE class_oid = ?
+ORDER BY grantee, privilege, column_name;
+
+-- name: RenamePrivilegeColumn :exec
+UPDATE sql_privilege SET column_name = sqlc.arg(new_name)
+WHERE class_oid = sqlc.arg(class_oid) AND column_name = sqlc.arg(name);
+
+-- name: DeletePrivilegesByColumn :exec
+DELETE FROM sql_privilege WHERE class_oid = ? AND column_name = ?;
+
+-- name: DeletePrivilegesByClass :exec
+DELETE FROM sql_privilege WHERE class_oid = ?;
+
+-- ============================== sql_policy =============================
+
+-- name: CreatePolicy :exec
+INSERT INTO sql_policy (class_oid, name, command, permissive, roles, has_using, has_check)
+VALUES (?, ?, ?, ?, ?, ?, ?);
+
+-- name: UpdatePolicy :exec
+UPDATE sql_policy SET roles = ?, has_using = ?, has_check = ?
+WHERE class_oid = ? AND name = ?;
+
+-- name: PolicyExists :one
+SELECT EXISTS (
+    SELECT 1 FROM sql_policy WHERE class_oid = ? AND name = ?
+);
+
+-- name: LookupPolicy :one
+SELECT name, command, permissive, roles, has_using, has_check FROM sql_policy
+WHERE class_oid = ? AND name = ?;
+
+-- name: ClassPolicies :many
+SELECT name, command, permissive, roles, has_using, has_check FROM sql_policy
+WHERE class_oid = ?
+ORDER BY name;
+
+-- name: DeletePoliciesByClass :exec
+DELETE FROM sql_policy WHERE class_oid = ?;
+
+-- =========================== sql_auth_members ==========================
+
+-- name: GrantRole :exec
+INSERT INTO sql_auth_members (role, member) VALUES (?, ?)
+ON CONFLICT(role, member) DO NOTHING;
+
+-- name: RevokeRole :exec
+DELETE FROM sql_auth_members WHERE role = ? AND member = ?;
+
+-- name: RolesOfMember :many
+SELECT role FROM sql_auth_members WHERE member = ? ORDER BY role;
+
 -- =============================== sql_proc ==============================
 
 -- name: CreateProc :execlastid
@@ -190,13 +401,18 @@ SELECT type_oid FROM sql_proc_arg
 WHERE proc_oid = ? AND mode IN ('i', 'b', 'v')
 ORDER BY ord;
 
+-- name: ProcArgs :many
+SELECT name, type_oid, mode, has_default FROM sql_proc_arg
+WHERE proc_oid = ?
+ORDER BY ord;
+
 -- name: FindProcsAnyNamespace :many
-SELECT oid, name, kind, return_type_oid, return_nullable
+SELECT oid, name, kind, return_type_oid, return_nullable, strict
 FROM sql_proc
 WHERE name = ?;
 
 -- name: FindProcsInNamespaces :many
-SELECT oid, name, kind, return_type_oid, return_nullable
+SELECT oid, name, kind, return_type_oid, return_nullable, strict
 FROM sql_proc
 WHERE name = sqlc.arg(name)
   AND namespace_oid IN (sqlc.slice(namespace_oids));
@@ -1,9 +1,27 @@
--- sql_namespace: schemas / namespaces
-CREATE TABLE sql_namespace (
+-- sql_database: databases, or catalogs, the level above a namespace that a
+-- three-part name starts with. The catalog's own database is created first,
+-- so it is always oid 1, and is recorded with an empty name: which name a
+-- query uses for it is configuration, not catalog data.
+CREATE TABLE sql_database (
     oid     INTEGER PRIMARY KEY AUTOINCREMENT,
     name    TEXT NOT NULL UNIQUE
 );
 
+-- sql_namespace: schemas / namespaces
+CREATE TABLE sql_namespace (
+    oid          INTEGER PRIMARY KEY AUTOINCREMENT,
+    database_oid INTEGER NOT NULL DEFAULT 1 REFERENCES sql_database(oid),
+    name         TEXT NOT NULL,
+    UNIQUE (database_oid, name)
+);
+
+-- sql_search_path: the search path the schema last set with SET search_path,
+-- in order. No rows means the schema never set one, or reset it.
+CREATE TABLE sql_search_path (
+    position INTEGER PRIMARY KEY,
+    name     TEXT NOT NULL
+);
+
 -- sql_dialect: registered SQL dialects (postgresql, sqlite, mysql, ...).
 CREATE TABLE sql_dialect (
     oid     INTEGER PRIMARY KEY AUTOINCREMENT,
@@ -42,15 +60,36 @@ CREATE TABLE sql_type (
 CREATE INDEX idx_sql_type_name ON sql_type(name);
 
 -- sql_class: relations (tables, views, indexes).
---   kind: 'r' = table, 'v' = view, 'i' = index, 'c' = composite type, 'f' = foreign
+--   kind: 'r' = table, 'p' = partitioned table, 'v' = view, 'i' = index,
+--         'c' = composite type, 'f' = foreign
+--   owner:              the role ALTER TABLE ... OWNER TO names, '' = unknown
+--   row_security:       1 once ENABLE ROW LEVEL SECURITY applies
+--   force_row_security: 1 once FORCE ROW LEVEL SECURITY applies
+--   is_partition:       1 for a partition of a partitioned table
+--   comment:            the text COMMENT ON TABLE sets, '' = none
 CREATE TABLE sql_class (
-    oid           INTEGER PRIMARY KEY AUTOINCREMENT,
-    namespace_oid INTEGER NOT NULL REFERENCES sql_namespace(oid),
-    name          TEXT NOT NULL,
-    kind          TEXT NOT NULL DEFAULT 'r',
+    oid                INTEGER PRIMARY KEY AUTOINCREMENT,
+    namespace_oid      INTEGER NOT NULL REFERENCES sql_namespace(oid),
+    name               TEXT NOT NULL,
+    kind               TEXT NOT NULL DEFAULT 'r',
+    owner              TEXT NOT NULL DEFAULT '',
+    row_security       INTEGER NOT NULL DEFAULT 0,
+    force_row_security INTEGER NOT NULL DEFAULT 0,
+    is_partition       INTEGER NOT NULL DEFAULT 0,
+    comment            TEXT NOT NULL DEFAULT '',
     UNIQUE(namespace_oid, name)
 );
 
+-- sql_inherits: the parents a relation inherits its columns from, through
+-- INHERITS or PARTITION OF. Modeled on pg_inherits.
+--   seqno: the parent's position in the INHERITS list, from 1
+CREATE TABLE sql_inherits (
+    class_oid  INTEGER NOT NULL REFERENCES sql_class(oid),
+    parent_oid INTEGER NOT NULL REFERENCES sql_class(oid),
+    seqno      INTEGER NOT NULL,
+    PRIMARY KEY (class_oid, parent_oid)
+);
+
 -- sql_attribute: columns of a relation.
 --   decl_type:       original declared type string before normalization
 --                    (e.g. VARCHAR(10), BIGINT UNSIGNED, INTEGER PRIMARY KEY).
@@ -65,6 +104,8 @@ CREATE TABLE sql_class (
 --                    Set both for inline-column PK and for table-level PK.
 --   is_unique:       column has a UNIQUE constraint or a single-column UNIQUE
 --                    table constraint.
+--   is_generated:    the column is computed from an expression (GENERATED
+--                    ALWAYS AS), so an INSERT cannot give it a value.
 CREATE TABLE sql_attribute (
     oid            INTEGER PRIMARY KEY AUTOINCREMENT,
     class_oid      INTEGER NOT NULL REFERENCES sql_class(oid),
@@ -79,18 +120,84 @@ CREATE TABLE sql_attribute (
     auto_increment INTEGER NOT NULL DEFAULT 0,
     is_primary_key INTEGER NOT NULL DEFAULT 0,
     is_unique      INTEGER NOT NULL DEFAULT 0,
+    is_generated   INTEGER NOT NULL DEFAULT 0,
+    default_expr   TEXT    NOT NULL DEFAULT '', -- the DEFAULT expression as SQL
+    comment        TEXT    NOT NULL DEFAULT '',
     UNIQUE(class_oid, name),
     UNIQUE(class_oid, num)
 );
 
--- sql_constraint: constraints on a relation.
---   kind: 'p' = primary key, 'f' = foreign key, 'u' = unique, 'c' = check
+-- sql_constraint: constraints and indexes on a relation.
+--   kind:    'p' = primary key, 'f' = foreign key, 'u' = unique, 'c' = check,
+--            'i' = index. A unique index over plain columns is a 'u'.
+--   columns: the attributes the constraint or index covers; for a check, the
+--            ones its expression references. 0 stands for an index expression.
+--   ref_*:   for a foreign key, the table it references and that table's
+--            columns by name, as written; ref_columns is '' when the key
+--            references the primary key without naming it.
 CREATE TABLE sql_constraint (
-    oid       INTEGER PRIMARY KEY AUTOINCREMENT,
-    class_oid INTEGER NOT NULL REFERENCES sql_class(oid),
-    name      TEXT NOT NULL DEFAULT '',
-    kind      TEXT NOT NULL,
-    columns   TEXT NOT NULL DEFAULT '' -- comma-separated attribute nums
+    oid         INTEGER PRIMARY KEY AUTOINCREMENT,
+    class_oid   INTEGER NOT NULL REFERENCES sql_class(oid),
+    name        TEXT NOT NULL DEFAULT '',
+    kind        TEXT NOT NULL,
+    columns     TEXT NOT NULL DEFAULT '', -- comma-separated attribute nums
+    ref_schema  TEXT NOT NULL DEFAULT '',
+    ref_table   TEXT NOT NULL DEFAULT '',
+    ref_columns TEXT NOT NULL DEFAULT '' -- comma-separated column names
+);
+
+-- sql_trigger: triggers on a relation. Modeled on pg_trigger.
+--   timing: 'b' = before, 'a' = after, 'i' = instead of
+--   events: the events the trigger fires on, any of 'i' = insert,
+--           'u' = update, 'd' = delete, 't' = truncate
+CREATE TABLE sql_trigger (
+    oid          INTEGER PRIMARY KEY AUTOINCREMENT,
+    class_oid    INTEGER NOT NULL REFERENCES sql_class(oid),
+    name         TEXT NOT NULL,
+    timing       TEXT NOT NULL,
+    events       TEXT NOT NULL,
+    for_each_row INTEGER NOT NULL DEFAULT 0,
+    UNIQUE(class_oid, name)
+);
+
+-- sql_privilege: privileges granted on a relation, one row per grantee and
+-- privilege. Modeled on the entries of pg_class.relacl and pg_attribute.attacl.
+--   grantee:     a role name, or 'public' for PUBLIC
+--   privilege:   SELECT, INSERT, UPDATE, DELETE, TRUNCATE, REFERENCES or TRIGGER
+--   column_name: '' = the whole relation, otherwise the column the grant names
+CREATE TABLE sql_privilege (
+    class_oid    INTEGER NOT NULL REFERENCES sql_class(oid),
+    grantee      TEXT NOT NULL,
+    privilege    TEXT NOT NULL,
+    column_name  TEXT NOT NULL DEFAULT '',
+    grant_option INTEGER NOT NULL DEFAULT 0,
+    PRIMARY KEY (class_oid, grantee, privilege, column_name)
+);
+
+-- sql_policy: row-level security policies. Modeled on pg_policy.
+--   command:   '*' = all, 'r' = select, 'a' = insert, 'w' = update, 'd' = delete
+--   roles:     comma-separated role names the policy applies to, '' = PUBLIC
+--   has_using: 1 if the policy filters the rows a command sees (USING)
+--   has_check: 1 if the policy checks the rows a command writes (WITH CHECK)
+CREATE TABLE sql_policy (
+    oid        INTEGER PRIMARY KEY AUTOINCREMENT,
+    class_oid  INTEGER NOT NULL REFERENCES sql_class(oid),
+    name       TEXT NOT NULL,
+    command    TEXT NOT NULL DEFAULT '*',
+    permissive INTEGER NOT NULL DEFAULT 1,
+    roles      TEXT NOT NULL DEFAULT '',
+    has_using  INTEGER NOT NULL DEFAULT 0,
+    has_check  INTEGER NOT NULL DEFAULT 0,
+    UNIQUE(class_oid, name)
+);
+
+-- sql_auth_members: role memberships GRANT role TO member records. Modeled on
+-- pg_auth_members, with roles held by name since the catalog has no roles of
+-- its own.
+CREATE TABLE sql_auth_members (
+    role   TEXT NOT NULL,
+    member TEXT NOT NULL,
+    PRIMARY KEY (role, member)
 );
 
 -- sql_proc: functions, aggregates, window functions, procedures.
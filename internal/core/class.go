@@ -30,6 +30,15 @@ func (c *Catalog) ClassOID(namespaceOID int64, name string) (int64, error) {
 	return oid, nil
 }
 
+// ClassNamespaceOID returns the namespace a relation is in.
+func (c *Catalog) ClassNamespaceOID(classOID int64) (int64, error) {
+	oid, err := c.q.ClassNamespaceOID(context.Background(), classOID)
+	if err != nil {
+		return 0, fmt.Errorf("class %d namespace: %w", classOID, err)
+	}
+	return oid, nil
+}
+
 func (c *Catalog) ClassOIDByName(name string) (int64, error) {
 	oid, err := c.q.ClassOIDByName(context.Background(), name)
 	if err != nil {
@@ -40,6 +49,24 @@ func (c *Catalog) ClassOIDByName(name string) (int64, error) {
 
 func (c *Catalog) DropClass(classOID int64) error {
 	ctx := context.Background()
+	if err := c.q.DeleteConstraintsByClass(ctx, classOID); err != nil {
+		return fmt.Errorf("drop class %d constraints: %w", classOID, err)
+	}
+	if err := c.q.DeleteTriggersByClass(ctx, classOID); err != nil {
+		return fmt.Errorf("drop class %d triggers: %w", classOID, err)
+	}
+	if err := c.q.DeletePrivilegesByClass(ctx, classOID); err != nil {
+		return fmt.Errorf("drop class %d privileges: %w", classOID, err)
+	}
+	if err := c.q.DeletePoliciesByClass(ctx, classOID); err != nil {
+		return fmt.Errorf("drop class %d policies: %w", classOID, err)
+	}
+	if err := c.q.DeleteInheritsByClass(ctx, classOID); err != nil {
+		return fmt.Errorf("drop class %d parents: %w", classOID, err)
+	}
+	if err := c.q.DeleteInheritsByParent(ctx, classOID); err != nil {
+		return fmt.Errorf("drop class %d children: %w", classOID, err)
+	}
 	if err := c.q.DeleteAttributesByClass(ctx, classOID); err != nil {
 		return fmt.Errorf("drop class %d attributes: %w", classOID, err)
 	}
@@ -76,3 +103,110 @@ func (c *Catalog) TablesInNamespace(namespaceOID int64) ([]ClassInfo, error) {
 	}
 	return out, nil
 }
+
+// ClassesOfKind returns a namespace's relations of one kind, such as the
+// composite types ('c') or the sequences ('S').
+func (c *Catalog) ClassesOfKind(namespaceOID int64, kind string) ([]ClassInfo, error) {
+	rows, err := c.q.ListClassesOfKind(context.Background(), catalogdb.ListClassesOfKindParams{
+		NamespaceOid: namespaceOID,
+		Kind:         kind,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("list classes of kind %q in namespace %d: %w", kind, namespaceOID, err)
+	}
+	out := make([]ClassInfo, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, ClassInfo{OID: r.Oid, Name: r.Name})
+	}
+	return out, nil
+}
+
+func (c *Catalog) ClassKind(classOID int64) (string, error) {
+	kind, err := c.q.ClassKind(context.Background(), classOID)
+	if err != nil {
+		return "", fmt.Errorf("class %d: %w", classOID, err)
+	}
+	return kind, nil
+}
+
+// ClassSecurity is who owns a relation and whether row-level security
+// governs it.
+type ClassSecurity struct {
+	Schema           string
+	Name             string
+	Owner            string
+	RowSecurity      bool
+	ForceRowSecurity bool
+}
+
+func (c *Catalog) ClassSecurity(classOID int64) (ClassSecurity, error) {
+	r, err := c.q.ClassSecurity(context.Background(), classOID)
+	if err != nil {
+		return ClassSecurity{}, fmt.Errorf("class %d: %w", classOID, err)
+	}
+	return ClassSecurity{
+		Schema:           r.SchemaName,
+		Name:             r.Name,
+		Owner:            r.Owner,
+		RowSecurity:      r.RowSecurity != 0,
+		ForceRowSecurity: r.ForceRowSecurity != 0,
+	}, nil
+}
+
+// SetClassOwner records the role that owns a relation.
+func (c *Catalog) SetClassOwner(classOID int64, owner string) error {
+	err := c.q.SetClassOwner(context.Background(), catalogdb.SetClassOwnerParams{
+		Oid:   classOID,
+		Owner: owner,
+	})
+	if err != nil {
+		return fmt.Errorf("set owner of class %d: %w", classOID, err)
+	}
+	return nil
+}
+
+// SetClassRowSecurity enables or disables row-level security on a relation.
+func (c *Catalog) SetClassRowSecurity(classOID int64, enabled bool) error {
+	err := c.q.SetClassRowSecurity(context.Background(), catalogdb.SetClassRowSecurityParams{
+		Oid:         classOID,
+		RowSecurity: boolToInt64(enabled),
+	})
+	if err != nil {
+		return fmt.Errorf("set row security of class %d: %w", classOID, err)
+	}
+	return nil
+}
+
+// SetClassForceRowSecurity sets whether row-level security applies to the
+// relation's owner as well.
+func (c *Catalog) SetClassForceRowSecurity(classOID int64, force bool) error {
+	err := c.q.SetClassForceRowSecurity(context.Background(), catalogdb.SetClassForceRowSecurityParams{
+		Oid:              classOID,
+		ForceRowSecurity: boolToInt64(force),
+	})
+	if err != nil {
+		return fmt.Errorf("set force row security of class %d: %w", classOID, err)
+	}
+	return nil
+}
+
+// SetClassComment records the comment on a relation; an empty comment
+// removes it.
+func (c *Catalog) SetClassComment(classOID int64, comment string) error {
+	err := c.q.SetClassComment(context.Background(), catalogdb.SetClassCommentParams{
+		Oid:     classOID,
+		Comment: comment,
+	})
+	if err != nil {
+		return fmt.Errorf("set comment of class %d: %w", classOID, err)
+	}
+	return nil
+}
+
+func (c *Catalog) ClassComment(classOID int64) (string, error) {
+	comment, err := c.q.ClassComment(context.Background(), classOID)
+	if err != nil {
+		return "", fmt.Errorf("class %d: %w", classOID, err)
+	}
+	return comment, nil
+}
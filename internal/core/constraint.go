@@ -3,6 +3,8 @@ package core
 import (
 	"context"
 	"fmt"
+	"strconv"
+	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/core/catalogdb"
 )
@@ -19,3 +21,134 @@ func (c *Catalog) CreateConstraint(classOID int64, name string, kind string, col
 	}
 	return nil
 }
+
+// ForeignKey is a foreign key of a relation. RefColumns is empty when the key
+// references the primary key of RefTable without naming its columns.
+type ForeignKey struct {
+	Name       string
+	Columns    []string
+	RefSchema  string
+	RefTable   string
+	RefColumns []string
+}
+
+// CreateForeignKey records a foreign key over the comma-separated attribute
+// nums in columns. The referenced table is kept by name, as written, since
+// it need not exist yet.
+func (c *Catalog) CreateForeignKey(classOID int64, columns string, fk ForeignKey) error {
+	err := c.q.CreateForeignKey(context.Background(), catalogdb.CreateForeignKeyParams{
+		ClassOid:   classOID,
+		Name:       fk.Name,
+		Columns:    columns,
+		RefSchema:  fk.RefSchema,
+		RefTable:   fk.RefTable,
+		RefColumns: strings.Join(fk.RefColumns, ","),
+	})
+	if err != nil {
+		return fmt.Errorf("create foreign key %q on class %d: %w", fk.Name, classOID, err)
+	}
+	return nil
+}
+
+// ClassForeignKeys returns a relation's foreign keys in the order they were
+// declared. A key that names a dropped column is left out.
+func (c *Catalog) ClassForeignKeys(classOID int64) ([]ForeignKey, error) {
+	rows, err := c.q.ClassForeignKeys(context.Background(), classOID)
+	if err != nil {
+		return nil, fmt.Errorf("foreign keys of class %d: %w", classOID, err)
+	}
+	if len(rows) == 0 {
+		return nil, nil
+	}
+	names, err := c.columnNamesByNum(classOID)
+	if err != nil {
+		return nil, err
+	}
+	var out []ForeignKey
+	for _, r := range rows {
+		cols, ok := columnNames(names, r.Columns)
+		if !ok {
+			continue
+		}
+		fk := ForeignKey{
+			Name:      r.Name,
+			Columns:   cols,
+			RefSchema: r.RefSchema,
+			RefTable:  r.RefTable,
+		}
+		if r.RefColumns != "" {
+			fk.RefColumns = strings.Split(r.RefColumns, ",")
+		}
+		out = append(out, fk)
+	}
+	return out, nil
+}
+
+// columnNamesByNum maps the attribute nums of a relation's columns, as
+// constraints store them, to the columns' names.
+func (c *Catalog) columnNamesByNum(classOID int64) (map[string]string, error) {
+	cols, err := c.ClassColumns(classOID)
+	if err != nil {
+		return nil, err
+	}
+	names := make(map[string]string, len(cols))
+	for _, col := range cols {
+		names[strconv.Itoa(col.Num)] = col.Name
+	}
+	return names, nil
+}
+
+// columnNames resolves comma-separated attribute nums to column names. It
+// reports false when a num names no column, such as one that was dropped.
+func columnNames(names map[string]string, nums string) ([]string, bool) {
+	var out []string
+	for num := range strings.SplitSeq(nums, ",") {
+		name, ok := names[num]
+		if !ok {
+			return nil, false
+		}
+		out = append(out, name)
+	}
+	return out, len(out) > 0
+}
+
+// ClassKeys returns the column sets of a relation's primary key and unique
+// constraints. A constraint that names a dropped column is left out.
+func (c *Catalog) ClassKeys(classOID int64) (primary []string, unique [][]string, err error) {
+	rows, err := c.q.ClassConstraints(context.Background(), classOID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("constraints of class %d: %w", classOID, err)
+	}
+	names, err := c.columnNamesByNum(classOID)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, r := range rows {
+		if r.Kind != "p" && r.Kind != "u" {
+			continue
+		}
+		key, ok := columnNames(names, r.Columns)
+		if !ok {
+			continue
+		}
+		if r.Kind == "p" {
+			primary = key
+		} else {
+			unique = append(unique, key)
+		}
+	}
+	return primary, unique, nil
+}
+
+// IndexExists reports whether a relation in the namespace has an index named
+// name.
+func (c *Catalog) IndexExists(namespaceOID int64, name string) (bool, error) {
+	exists, err := c.q.IndexExists(context.Background(), catalogdb.IndexExistsParams{
+		NamespaceOid: namespaceOID,
+		Name:         name,
+	})
+	if err != nil {
+		return false, fmt.Errorf("index %q: %w", name, err)
+	}
+	return exists, nil
+}
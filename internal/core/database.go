@@ -0,0 +1,225 @@
+package core
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/core/catalogdb"
+)
+
+// defaultDatabaseOID is the catalog's own database: the one the schema is
+// applied to, and the one a name without a database part resolves in.
+// Bootstrap creates it before anything else, so its oid is fixed.
+const defaultDatabaseOID = 1
+
+// DefaultNamespace is where an unqualified name is looked up when nothing on
+// the search path holds it, and where one is created when nothing on the
+// search path exists.
+const DefaultNamespace = "public"
+
+// Settings are the parts of a configuration that decide how names resolve.
+// They are not catalog data, so a restored catalog has to be given them
+// again, and they are part of what a cached catalog is keyed on.
+type Settings struct {
+	// Database is the name queries use for the catalog's own database. A
+	// name that gives this database, or none at all, resolves against the
+	// relations the schema creates. Engines that fold identifiers disagree on
+	// case, so it matches without regard to it.
+	Database string
+
+	// SearchPath lists the namespaces an unqualified name is looked up in, in
+	// order, until the schema sets a path of its own. DefaultNamespace is
+	// searched last when it is not listed.
+	SearchPath []string
+}
+
+// Configure installs the settings names resolve with.
+func (c *Catalog) Configure(s Settings) {
+	c.settings = s
+}
+
+// Settings returns the settings names resolve with.
+func (c *Catalog) Settings() Settings {
+	return c.settings
+}
+
+// SearchPath returns the namespaces an unqualified name is looked up in: the
+// path the schema last set, or the settings' when it set none.
+func (c *Catalog) SearchPath() []string {
+	base := c.settings.SearchPath
+	if len(c.schemaPath) > 0 {
+		base = c.schemaPath
+	}
+	path := make([]string, 0, len(base)+1)
+	for _, ns := range base {
+		if ns != "" && !slices.Contains(path, ns) {
+			path = append(path, ns)
+		}
+	}
+	if !slices.Contains(path, DefaultNamespace) {
+		path = append(path, DefaultNamespace)
+	}
+	return path
+}
+
+// SetSearchPath records the path a SET search_path in the schema sets. It
+// holds for the rest of the schema and for the queries analyzed against it,
+// as it would for a session that ran the schema and then the queries. A nil
+// path, which RESET sets, goes back to the settings' path.
+func (c *Catalog) SetSearchPath(path []string) error {
+	ctx := context.Background()
+	if err := c.q.ClearSearchPath(ctx); err != nil {
+		return fmt.Errorf("set search path: %w", err)
+	}
+	for i, ns := range path {
+		if err := c.q.AddSearchPath(ctx, catalogdb.AddSearchPathParams{Position: int64(i), Name: ns}); err != nil {
+			return fmt.Errorf("set search path: %w", err)
+		}
+	}
+	c.schemaPath = slices.Clone(path)
+	return nil
+}
+
+// loadSearchPath reads back the path the schema set, for a restored catalog.
+func (c *Catalog) loadSearchPath() error {
+	path, err := c.q.ListSearchPath(context.Background())
+	if err != nil {
+		return fmt.Errorf("search path: %w", err)
+	}
+	c.schemaPath = path
+	return nil
+}
+
+// CurrentSchema returns the namespace PostgreSQL's current_schema() would:
+// the first on the search path that exists, which is where an unqualified
+// CREATE puts what it creates.
+func (c *Catalog) CurrentSchema() string {
+	for _, ns := range c.SearchPath() {
+		if _, err := c.NamespaceOID(ns); err == nil {
+			return ns
+		}
+	}
+	return DefaultNamespace
+}
+
+func (c *Catalog) isDefaultDatabase(name string) bool {
+	return name == "" || strings.EqualFold(name, c.settings.Database)
+}
+
+// DatabaseOID looks up the database a name's database part names.
+func (c *Catalog) DatabaseOID(name string) (int64, error) {
+	if c.isDefaultDatabase(name) {
+		return defaultDatabaseOID, nil
+	}
+	oid, err := c.q.DatabaseOID(context.Background(), name)
+	if err != nil {
+		return 0, fmt.Errorf("database %q: %w", name, err)
+	}
+	return oid, nil
+}
+
+// CreateDatabase records a database other than the catalog's own.
+func (c *Catalog) CreateDatabase(name string) (int64, error) {
+	oid, err := c.q.CreateDatabase(context.Background(), name)
+	if err != nil {
+		return 0, fmt.Errorf("create database %q: %w", name, err)
+	}
+	return oid, nil
+}
+
+// DatabaseName returns the name queries use for a database, which for the
+// catalog's own is whatever the settings call it.
+func (c *Catalog) DatabaseName(oid int64, name string) string {
+	if oid == defaultDatabaseOID {
+		return c.settings.Database
+	}
+	return name
+}
+
+// LookupClass finds the relation a name refers to. A name with a schema part
+// is looked up in that namespace; one without is looked up along the search
+// path, and the first namespace that holds it wins. Either way the namespace
+// is one of the database the name gives, or of the catalog's own.
+func (c *Catalog) LookupClass(database, schema, name string) (int64, error) {
+	dbOID, err := c.DatabaseOID(database)
+	if err != nil {
+		return 0, fmt.Errorf("database %q does not exist", database)
+	}
+	if schema != "" && !c.namesDatabase(database, schema) {
+		nsOID, err := c.NamespaceOIDIn(dbOID, schema)
+		if err != nil {
+			return 0, fmt.Errorf("schema %q does not exist", schema)
+		}
+		oid, err := c.ClassOID(nsOID, name)
+		if err != nil {
+			return 0, fmt.Errorf("relation %q does not exist", qualifiedName(database, schema, name))
+		}
+		return oid, nil
+	}
+	for _, ns := range c.SearchPath() {
+		nsOID, err := c.NamespaceOIDIn(dbOID, ns)
+		if err != nil {
+			continue
+		}
+		if oid, err := c.ClassOID(nsOID, name); err == nil {
+			return oid, nil
+		}
+	}
+	return 0, fmt.Errorf("relation %q does not exist", qualifiedName(database, schema, name))
+}
+
+// namesDatabase reports whether the schema part of a two-part name is the
+// catalog's own database rather than a namespace in it. MySQL and ClickHouse
+// have no level between a database and its tables, so db.table is the
+// database and the table; a namespace by that name wins, as it always has.
+func (c *Catalog) namesDatabase(database, schema string) bool {
+	if database != "" || c.settings.Database == "" || !strings.EqualFold(schema, c.settings.Database) {
+		return false
+	}
+	_, err := c.NamespaceOIDIn(defaultDatabaseOID, schema)
+	return err != nil
+}
+
+// qualifiedName joins the parts of a name as a query would write it, leaving
+// out the leading parts it does not give.
+func qualifiedName(database, schema, name string) string {
+	switch {
+	case database != "":
+		return database + "." + schema + "." + name
+	case schema != "":
+		return schema + "." + name
+	}
+	return name
+}
+
+// CreationNamespace returns the namespace a relation a DDL statement names is
+// created in, creating the database and namespace when the name is the first
+// to mention them. A name without a schema part goes to the first namespace
+// on the search path that exists, as PostgreSQL does.
+func (c *Catalog) CreationNamespace(database, schema string) (int64, error) {
+	dbOID, err := c.DatabaseOID(database)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return 0, err
+		}
+		if dbOID, err = c.CreateDatabase(database); err != nil {
+			return 0, err
+		}
+	}
+	if schema == "" || c.namesDatabase(database, schema) {
+		for _, ns := range c.SearchPath() {
+			if oid, err := c.NamespaceOIDIn(dbOID, ns); err == nil {
+				return oid, nil
+			}
+		}
+		schema = DefaultNamespace
+	}
+	if oid, err := c.NamespaceOIDIn(dbOID, schema); err == nil {
+		return oid, nil
+	}
+	return c.CreateNamespaceIn(dbOID, schema)
+}
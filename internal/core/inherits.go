@@ -0,0 +1,108 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/core/catalogdb"
+)
+
+// AddInherits records that a relation inherits the columns of parent, after
+// any parents it already has. A partition is the one child of a partitioned
+// table that PARTITION OF or ATTACH PARTITION makes it.
+func (c *Catalog) AddInherits(classOID, parentOID int64, partition bool) error {
+	ctx := context.Background()
+	parents, err := c.q.ClassParents(ctx, classOID)
+	if err != nil {
+		return fmt.Errorf("class %d parents: %w", classOID, err)
+	}
+	err = c.q.CreateInherits(ctx, catalogdb.CreateInheritsParams{
+		ClassOid:  classOID,
+		ParentOid: parentOID,
+		Seqno:     int64(len(parents) + 1),
+	})
+	if err != nil {
+		return fmt.Errorf("class %d inherits %d: %w", classOID, parentOID, err)
+	}
+	if !partition {
+		return nil
+	}
+	return c.setClassIsPartition(classOID, true)
+}
+
+// RemoveInherits undoes AddInherits, as DETACH PARTITION does. The relation
+// keeps the columns it inherited.
+func (c *Catalog) RemoveInherits(classOID, parentOID int64) error {
+	err := c.q.DeleteInherits(context.Background(), catalogdb.DeleteInheritsParams{
+		ClassOid:  classOID,
+		ParentOid: parentOID,
+	})
+	if err != nil {
+		return fmt.Errorf("class %d no longer inherits %d: %w", classOID, parentOID, err)
+	}
+	return c.setClassIsPartition(classOID, false)
+}
+
+func (c *Catalog) setClassIsPartition(classOID int64, partition bool) error {
+	err := c.q.SetClassIsPartition(context.Background(), catalogdb.SetClassIsPartitionParams{
+		Oid:         classOID,
+		IsPartition: boolToInt64(partition),
+	})
+	if err != nil {
+		return fmt.Errorf("set is_partition of class %d: %w", classOID, err)
+	}
+	return nil
+}
+
+// IsPartition reports whether a relation is a partition of a partitioned
+// table.
+func (c *Catalog) IsPartition(classOID int64) (bool, error) {
+	v, err := c.q.ClassIsPartition(context.Background(), classOID)
+	if err != nil {
+		return false, fmt.Errorf("class %d: %w", classOID, err)
+	}
+	return v != 0, nil
+}
+
+// ClassParents returns the relations a relation inherits from, in the order
+// it names them.
+func (c *Catalog) ClassParents(classOID int64) ([]int64, error) {
+	parents, err := c.q.ClassParents(context.Background(), classOID)
+	if err != nil {
+		return nil, fmt.Errorf("class %d parents: %w", classOID, err)
+	}
+	return parents, nil
+}
+
+// ClassChildren returns the relations that inherit from a relation directly.
+func (c *Catalog) ClassChildren(parentOID int64) ([]int64, error) {
+	children, err := c.q.ClassChildren(context.Background(), parentOID)
+	if err != nil {
+		return nil, fmt.Errorf("class %d children: %w", parentOID, err)
+	}
+	return children, nil
+}
+
+// Descendants returns every relation that inherits from a relation, directly
+// or through another, each once, parents before their children.
+func (c *Catalog) Descendants(classOID int64) ([]int64, error) {
+	var out []int64
+	seen := map[int64]bool{classOID: true}
+	queue := []int64{classOID}
+	for len(queue) > 0 {
+		children, err := c.ClassChildren(queue[0])
+		if err != nil {
+			return nil, err
+		}
+		queue = queue[1:]
+		for _, child := range children {
+			if seen[child] {
+				continue
+			}
+			seen[child] = true
+			out = append(out, child)
+			queue = append(queue, child)
+		}
+	}
+	return out, nil
+}
@@ -3,18 +3,38 @@ package core
 import (
 	"context"
 	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/core/catalogdb"
 )
 
+// CreateNamespace creates a namespace in the catalog's own database.
 func (c *Catalog) CreateNamespace(name string) (int64, error) {
-	oid, err := c.q.CreateNamespace(context.Background(), name)
+	return c.CreateNamespaceIn(defaultDatabaseOID, name)
+}
+
+// CreateNamespaceIn creates a namespace in the database databaseOID names.
+func (c *Catalog) CreateNamespaceIn(databaseOID int64, name string) (int64, error) {
+	oid, err := c.q.CreateNamespace(context.Background(), catalogdb.CreateNamespaceParams{
+		DatabaseOid: databaseOID,
+		Name:        name,
+	})
 	if err != nil {
 		return 0, fmt.Errorf("create namespace %q: %w", name, err)
 	}
 	return oid, nil
 }
 
+// NamespaceOID looks a namespace up in the catalog's own database.
 func (c *Catalog) NamespaceOID(name string) (int64, error) {
-	oid, err := c.q.NamespaceOID(context.Background(), name)
+	return c.NamespaceOIDIn(defaultDatabaseOID, name)
+}
+
+// NamespaceOIDIn looks a namespace up in the database databaseOID names.
+func (c *Catalog) NamespaceOIDIn(databaseOID int64, name string) (int64, error) {
+	oid, err := c.q.NamespaceOID(context.Background(), catalogdb.NamespaceOIDParams{
+		DatabaseOid: databaseOID,
+		Name:        name,
+	})
 	if err != nil {
 		return 0, fmt.Errorf("namespace %q: %w", name, err)
 	}
@@ -26,8 +46,9 @@ type NamespaceInfo struct {
 	Name string
 }
 
+// Namespaces lists the namespaces of the catalog's own database.
 func (c *Catalog) Namespaces() ([]NamespaceInfo, error) {
-	rows, err := c.q.ListNamespaces(context.Background())
+	rows, err := c.q.ListNamespaces(context.Background(), defaultDatabaseOID)
 	if err != nil {
 		return nil, fmt.Errorf("list namespaces: %w", err)
 	}
@@ -0,0 +1,106 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/core/catalogdb"
+)
+
+// PolicySpec describes a row-level security policy on a relation. Command
+// uses the letters sql_policy documents. No roles means the policy applies to
+// PUBLIC.
+type PolicySpec struct {
+	ClassOID   int64
+	Name       string
+	Command    string
+	Permissive bool
+	Roles      []string
+	HasUsing   bool
+	HasCheck   bool
+}
+
+func (c *Catalog) CreatePolicy(s PolicySpec) error {
+	err := c.q.CreatePolicy(context.Background(), catalogdb.CreatePolicyParams{
+		ClassOid:   s.ClassOID,
+		Name:       s.Name,
+		Command:    s.Command,
+		Permissive: boolToInt64(s.Permissive),
+		Roles:      strings.Join(s.Roles, ","),
+		HasUsing:   boolToInt64(s.HasUsing),
+		HasCheck:   boolToInt64(s.HasCheck),
+	})
+	if err != nil {
+		return fmt.Errorf("create policy %q on class %d: %w", s.Name, s.ClassOID, err)
+	}
+	return nil
+}
+
+// UpdatePolicy replaces the roles and expressions of the policy s names.
+func (c *Catalog) UpdatePolicy(s PolicySpec) error {
+	err := c.q.UpdatePolicy(context.Background(), catalogdb.UpdatePolicyParams{
+		ClassOid: s.ClassOID,
+		Name:     s.Name,
+		Roles:    strings.Join(s.Roles, ","),
+		HasUsing: boolToInt64(s.HasUsing),
+		HasCheck: boolToInt64(s.HasCheck),
+	})
+	if err != nil {
+		return fmt.Errorf("alter policy %q on class %d: %w", s.Name, s.ClassOID, err)
+	}
+	return nil
+}
+
+// PolicyExists reports whether a relation has a policy named name.
+func (c *Catalog) PolicyExists(classOID int64, name string) (bool, error) {
+	exists, err := c.q.PolicyExists(context.Background(), catalogdb.PolicyExistsParams{
+		ClassOid: classOID,
+		Name:     name,
+	})
+	if err != nil {
+		return false, fmt.Errorf("policy %q on class %d: %w", name, classOID, err)
+	}
+	return exists, nil
+}
+
+// LookupPolicy returns the policy named name on a relation.
+func (c *Catalog) LookupPolicy(classOID int64, name string) (PolicySpec, error) {
+	r, err := c.q.LookupPolicy(context.Background(), catalogdb.LookupPolicyParams{
+		ClassOid: classOID,
+		Name:     name,
+	})
+	if err != nil {
+		return PolicySpec{}, fmt.Errorf("policy %q on class %d: %w", name, classOID, err)
+	}
+	return policySpec(classOID, catalogdb.ClassPoliciesRow(r)), nil
+}
+
+// ClassPolicies returns the policies on a relation, by name.
+func (c *Catalog) ClassPolicies(classOID int64) ([]PolicySpec, error) {
+	rows, err := c.q.ClassPolicies(context.Background(), classOID)
+	if err != nil {
+		return nil, fmt.Errorf("policies on class %d: %w", classOID, err)
+	}
+	out := make([]PolicySpec, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, policySpec(classOID, r))
+	}
+	return out, nil
+}
+
+func policySpec(classOID int64, r catalogdb.ClassPoliciesRow) PolicySpec {
+	var roles []string
+	if r.Roles != "" {
+		roles = strings.Split(r.Roles, ",")
+	}
+	return PolicySpec{
+		ClassOID:   classOID,
+		Name:       r.Name,
+		Command:    r.Command,
+		Permissive: r.Permissive != 0,
+		Roles:      roles,
+		HasUsing:   r.HasUsing != 0,
+		HasCheck:   r.HasCheck != 0,
+	}
+}
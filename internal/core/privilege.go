@@ -0,0 +1,173 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/core/catalogdb"
+)
+
+// PublicRole is the grantee a privilege granted to PUBLIC is recorded under.
+const PublicRole = "public"
+
+// GrantSpec is a privilege granted to a role on a relation, or on one of its
+// columns when Column is set.
+type GrantSpec struct {
+	ClassOID    int64
+	Grantee     string
+	Privilege   string
+	Column      string
+	GrantOption bool
+}
+
+func (c *Catalog) Grant(s GrantSpec) error {
+	err := c.q.GrantPrivilege(context.Background(), catalogdb.GrantPrivilegeParams{
+		ClassOid:    s.ClassOID,
+		Grantee:     s.Grantee,
+		Privilege:   s.Privilege,
+		ColumnName:  s.Column,
+		GrantOption: boolToInt64(s.GrantOption),
+	})
+	if err != nil {
+		return fmt.Errorf("grant %s on class %d to %q: %w", s.Privilege, s.ClassOID, s.Grantee, err)
+	}
+	return nil
+}
+
+// Revoke takes back a privilege. Revoking it on a relation takes it back on
+// every column as well. With GrantOption set, only the right to grant it on
+// is taken back.
+func (c *Catalog) Revoke(s GrantSpec) error {
+	ctx := context.Background()
+	var err error
+	switch {
+	case s.GrantOption && s.Column == "":
+		err = c.q.RevokeGrantOption(ctx, catalogdb.RevokeGrantOptionParams{
+			ClassOid:  s.ClassOID,
+			Grantee:   s.Grantee,
+			Privilege: s.Privilege,
+		})
+	case s.GrantOption:
+		err = c.q.RevokeColumnGrantOption(ctx, catalogdb.RevokeColumnGrantOptionParams{
+			ClassOid:   s.ClassOID,
+			Grantee:    s.Grantee,
+			Privilege:  s.Privilege,
+			ColumnName: s.Column,
+		})
+	case s.Column == "":
+		err = c.q.RevokePrivilege(ctx, catalogdb.RevokePrivilegeParams{
+			ClassOid:  s.ClassOID,
+			Grantee:   s.Grantee,
+			Privilege: s.Privilege,
+		})
+	default:
+		err = c.q.RevokeColumnPrivilege(ctx, catalogdb.RevokeColumnPrivilegeParams{
+			ClassOid:   s.ClassOID,
+			Grantee:    s.Grantee,
+			Privilege:  s.Privilege,
+			ColumnName: s.Column,
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("revoke %s on class %d from %q: %w", s.Privilege, s.ClassOID, s.Grantee, err)
+	}
+	return nil
+}
+
+// ClassPrivileges returns the privileges granted on a relation and its
+// columns.
+func (c *Catalog) ClassPrivileges(classOID int64) ([]GrantSpec, error) {
+	rows, err := c.q.ClassPrivileges(context.Background(), classOID)
+	if err != nil {
+		return nil, fmt.Errorf("privileges on class %d: %w", classOID, err)
+	}
+	out := make([]GrantSpec, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, GrantSpec{
+			ClassOID:    classOID,
+			Grantee:     r.Grantee,
+			Privilege:   r.Privilege,
+			Column:      r.ColumnName,
+			GrantOption: r.GrantOption != 0,
+		})
+	}
+	return out, nil
+}
+
+// GrantRole makes member a member of role, so that it holds whatever role
+// holds.
+func (c *Catalog) GrantRole(role, member string) error {
+	err := c.q.GrantRole(context.Background(), catalogdb.GrantRoleParams{
+		Role:   role,
+		Member: member,
+	})
+	if err != nil {
+		return fmt.Errorf("grant role %q to %q: %w", role, member, err)
+	}
+	return nil
+}
+
+func (c *Catalog) RevokeRole(role, member string) error {
+	err := c.q.RevokeRole(context.Background(), catalogdb.RevokeRoleParams{
+		Role:   role,
+		Member: member,
+	})
+	if err != nil {
+		return fmt.Errorf("revoke role %q from %q: %w", role, member, err)
+	}
+	return nil
+}
+
+// roles returns role, every role it is a member of directly or through
+// another, and PUBLIC, which every role is a member of.
+func (c *Catalog) roles(role string) (map[string]bool, error) {
+	held := map[string]bool{role: true, PublicRole: true}
+	queue := []string{role}
+	for len(queue) > 0 {
+		member := queue[0]
+		queue = queue[1:]
+		parents, err := c.q.RolesOfMember(context.Background(), member)
+		if err != nil {
+			return nil, fmt.Errorf("roles of %q: %w", member, err)
+		}
+		for _, p := range parents {
+			if !held[p] {
+				held[p] = true
+				queue = append(queue, p)
+			}
+		}
+	}
+	return held, nil
+}
+
+// HasPrivilege reports whether role holds privilege on a relation, or on
+// column when one is named. A role holds what it is granted, what the roles
+// it is a member of and PUBLIC are granted, and every privilege on the
+// relations any of those own. Without a column, a grant on any one column is
+// enough, as it is for PostgreSQL to let SELECT count(*) through.
+func (c *Catalog) HasPrivilege(role string, classOID int64, privilege, column string) (bool, error) {
+	held, err := c.roles(role)
+	if err != nil {
+		return false, err
+	}
+	sec, err := c.ClassSecurity(classOID)
+	if err != nil {
+		return false, err
+	}
+	if sec.Owner != "" && held[sec.Owner] {
+		return true, nil
+	}
+	grants, err := c.ClassPrivileges(classOID)
+	if err != nil {
+		return false, err
+	}
+	for _, g := range grants {
+		if !held[g.Grantee] || g.Privilege != privilege {
+			continue
+		}
+		if g.Column == "" || column == "" || g.Column == column {
+			return true, nil
+		}
+	}
+	return false, nil
+}
@@ -77,7 +77,9 @@ type ProcOverload struct {
 	Kind           string
 	ReturnTypeOID  int64
 	ReturnNullable bool
-	ArgTypes       []int64
+	// Strict overloads return NULL when any argument is NULL.
+	Strict   bool
+	ArgTypes []int64
 }
 
 // FindProcs returns the overloads of name, optionally restricted to the given
@@ -100,6 +102,7 @@ func (c *Catalog) FindProcs(name string, namespaceOIDs []int64) ([]ProcOverload,
 				Kind:           r.Kind,
 				ReturnTypeOID:  r.ReturnTypeOid,
 				ReturnNullable: r.ReturnNullable != 0,
+				Strict:         r.Strict != 0,
 			})
 		}
 	} else {
@@ -122,6 +125,7 @@ func (c *Catalog) FindProcs(name string, namespaceOIDs []int64) ([]ProcOverload,
 				Kind:           r.Kind,
 				ReturnTypeOID:  r.ReturnTypeOid,
 				ReturnNullable: r.ReturnNullable != 0,
+				Strict:         r.Strict != 0,
 			})
 		}
 	}
@@ -136,6 +140,25 @@ func (c *Catalog) FindProcs(name string, namespaceOIDs []int64) ([]ProcOverload,
 	return out, nil
 }
 
+// ProcArgs returns every argument of a proc in order, including the ones
+// only a procedure's caller passes, such as OUTPUT arguments.
+func (c *Catalog) ProcArgs(procOID int64) ([]ProcArg, error) {
+	rows, err := c.q.ProcArgs(context.Background(), procOID)
+	if err != nil {
+		return nil, fmt.Errorf("proc %d args: %w", procOID, err)
+	}
+	out := make([]ProcArg, 0, len(rows))
+	for _, r := range rows {
+		out = append(out, ProcArg{
+			Name:       r.Name,
+			TypeOID:    r.TypeOid,
+			Mode:       r.Mode,
+			HasDefault: r.HasDefault != 0,
+		})
+	}
+	return out, nil
+}
+
 func (c *Catalog) procArgTypes(procOID int64) ([]int64, error) {
 	return c.q.ProcArgTypes(context.Background(), procOID)
 }
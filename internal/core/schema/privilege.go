@@ -0,0 +1,301 @@
+package schema
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/core"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+)
+
+// tablePrivileges are what GRANT ALL grants on a table, and columnPrivileges
+// the ones among them that can be granted on a column.
+var (
+	tablePrivileges  = []string{"SELECT", "INSERT", "UPDATE", "DELETE", "TRUNCATE", "REFERENCES", "TRIGGER"}
+	columnPrivileges = []string{"SELECT", "INSERT", "UPDATE", "REFERENCES"}
+)
+
+// policyCommands maps the command a policy is for to the letter sql_policy
+// records it as.
+var policyCommands = map[string]string{
+	"all":    "*",
+	"select": "r",
+	"insert": "a",
+	"update": "w",
+	"delete": "d",
+}
+
+// roleName returns the role a role spec names, with PUBLIC as core.PublicRole.
+// CURRENT_USER and the like depend on who runs the schema, which the catalog
+// does not know, so they name no role.
+func roleName(rs *ast.RoleSpec) (string, bool) {
+	if rs == nil {
+		return "", false
+	}
+	switch rs.Roletype {
+	case ast.RoleSpecPublic:
+		return core.PublicRole, true
+	case ast.RoleSpecCString:
+		if rs.Rolename != nil && *rs.Rolename != "" {
+			return *rs.Rolename, true
+		}
+	}
+	return "", false
+}
+
+func roleNames(l *ast.List) []string {
+	var out []string
+	for _, item := range listItems(l) {
+		if rs, ok := item.(*ast.RoleSpec); ok {
+			if name, ok := roleName(rs); ok {
+				out = append(out, name)
+			}
+		}
+	}
+	return out
+}
+
+// privilege is one privilege a GRANT or REVOKE names, on the columns it
+// lists or, without any, on the whole relation.
+type privilege struct {
+	name string
+	cols []string
+}
+
+// applyGrant records GRANT and REVOKE on tables and views. ALL TABLES IN
+// SCHEMA covers the relations the schema holds when the statement runs, as it
+// does in PostgreSQL. Privileges on sequences, functions and schemas, and
+// default privileges, are not recorded.
+func applyGrant(cat *core.Catalog, stmt *ast.GrantStmt) error {
+	if stmt.Objtype != ast.GrantObjectTypeTable {
+		return nil
+	}
+	var classOIDs []int64
+	switch stmt.Targtype {
+	case ast.GrantTargetObject:
+		for _, item := range listItems(stmt.Objects) {
+			rv, ok := item.(*ast.RangeVar)
+			if !ok {
+				continue
+			}
+			classOID, err := lookupClass(cat, rangeVarTableName(rv))
+			if err != nil {
+				return err
+			}
+			classOIDs = append(classOIDs, classOID)
+		}
+	case ast.GrantTargetAllInSchema:
+		for _, schema := range listStrings(stmt.Objects) {
+			nsOID, err := cat.NamespaceOID(schema)
+			if err != nil {
+				return fmt.Errorf("schema %q does not exist", schema)
+			}
+			for _, kind := range []string{"r", "p", "v"} {
+				classes, err := cat.ClassesOfKind(nsOID, kind)
+				if err != nil {
+					return err
+				}
+				for _, class := range classes {
+					classOIDs = append(classOIDs, class.OID)
+				}
+			}
+		}
+	default:
+		return nil
+	}
+
+	// A nil privilege list is ALL PRIVILEGES. ALL with a column list, which
+	// arrives as a privilege without a name, is every privilege a column has.
+	var privileges []privilege
+	if stmt.Privileges == nil {
+		for _, name := range tablePrivileges {
+			privileges = append(privileges, privilege{name: name})
+		}
+	}
+	for _, item := range listItems(stmt.Privileges) {
+		ap, ok := item.(*ast.AccessPriv)
+		if !ok {
+			continue
+		}
+		cols := listStrings(ap.Cols)
+		if ap.PrivName == nil {
+			for _, name := range columnPrivileges {
+				privileges = append(privileges, privilege{name: name, cols: cols})
+			}
+			continue
+		}
+		privileges = append(privileges, privilege{name: strings.ToUpper(*ap.PrivName), cols: cols})
+	}
+
+	grantees := roleNames(stmt.Grantees)
+	for _, classOID := range classOIDs {
+		if err := checkPrivilegeColumns(cat, classOID, privileges); err != nil {
+			return err
+		}
+		for _, p := range privileges {
+			cols := p.cols
+			if len(cols) == 0 {
+				cols = []string{""}
+			}
+			for _, grantee := range grantees {
+				for _, col := range cols {
+					spec := core.GrantSpec{
+						ClassOID:    classOID,
+						Grantee:     grantee,
+						Privilege:   p.name,
+						Column:      col,
+						GrantOption: stmt.GrantOption,
+					}
+					var err error
+					if stmt.IsGrant {
+						err = cat.Grant(spec)
+					} else {
+						err = cat.Revoke(spec)
+					}
+					if err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// checkPrivilegeColumns reports a column a privilege names that the relation
+// does not have.
+func checkPrivilegeColumns(cat *core.Catalog, classOID int64, privileges []privilege) error {
+	var nums map[string]int
+	for _, p := range privileges {
+		if len(p.cols) == 0 {
+			continue
+		}
+		if nums == nil {
+			var err error
+			if nums, err = columnNums(cat, classOID); err != nil {
+				return err
+			}
+		}
+		for _, col := range p.cols {
+			if _, ok := nums[col]; !ok {
+				return fmt.Errorf("column %q does not exist", col)
+			}
+		}
+	}
+	return nil
+}
+
+// applyGrantRole records GRANT role TO member and its REVOKE.
+func applyGrantRole(cat *core.Catalog, stmt *ast.GrantRoleStmt) error {
+	var roles []string
+	for _, item := range listItems(stmt.GrantedRoles) {
+		switch v := item.(type) {
+		case *ast.AccessPriv:
+			if v.PrivName != nil && *v.PrivName != "" {
+				roles = append(roles, *v.PrivName)
+			}
+		case *ast.RoleSpec:
+			if name, ok := roleName(v); ok {
+				roles = append(roles, name)
+			}
+		}
+	}
+	for _, member := range roleNames(stmt.GranteeRoles) {
+		for _, role := range roles {
+			var err error
+			if stmt.IsGrant {
+				err = cat.GrantRole(role, member)
+			} else {
+				err = cat.RevokeRole(role, member)
+			}
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyCreatePolicy records a row-level security policy. Its expressions are
+// not analyzed; the catalog records only whether each is present.
+func applyCreatePolicy(cat *core.Catalog, stmt *ast.CreatePolicyStmt) error {
+	if stmt.PolicyName == nil || stmt.Table == nil {
+		return fmt.Errorf("create policy with nil name")
+	}
+	table := rangeVarTableName(stmt.Table)
+	classOID, err := lookupClass(cat, table)
+	if err != nil {
+		return err
+	}
+	exists, err := cat.PolicyExists(classOID, *stmt.PolicyName)
+	if err != nil {
+		return err
+	}
+	if exists {
+		return fmt.Errorf("policy %q for table %q already exists", *stmt.PolicyName, table.Name)
+	}
+	command := "*"
+	if stmt.CmdName != nil {
+		c, ok := policyCommands[strings.ToLower(*stmt.CmdName)]
+		if !ok {
+			return fmt.Errorf("unrecognized policy command %q", *stmt.CmdName)
+		}
+		command = c
+	}
+	return cat.CreatePolicy(core.PolicySpec{
+		ClassOID:   classOID,
+		Name:       *stmt.PolicyName,
+		Command:    command,
+		Permissive: stmt.Permissive,
+		Roles:      policyRoles(stmt.Roles),
+		HasUsing:   stmt.Qual != nil,
+		HasCheck:   stmt.WithCheck != nil,
+	})
+}
+
+// applyAlterPolicy replaces the parts of a policy the statement gives and
+// keeps the rest.
+func applyAlterPolicy(cat *core.Catalog, stmt *ast.AlterPolicyStmt) error {
+	if stmt.PolicyName == nil || stmt.Table == nil {
+		return fmt.Errorf("alter policy with nil name")
+	}
+	table := rangeVarTableName(stmt.Table)
+	classOID, err := lookupClass(cat, table)
+	if err != nil {
+		return err
+	}
+	exists, err := cat.PolicyExists(classOID, *stmt.PolicyName)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return fmt.Errorf("policy %q for table %q does not exist", *stmt.PolicyName, table.Name)
+	}
+	spec, err := cat.LookupPolicy(classOID, *stmt.PolicyName)
+	if err != nil {
+		return err
+	}
+	if stmt.Roles != nil {
+		spec.Roles = policyRoles(stmt.Roles)
+	}
+	if stmt.Qual != nil {
+		spec.HasUsing = true
+	}
+	if stmt.WithCheck != nil {
+		spec.HasCheck = true
+	}
+	return cat.UpdatePolicy(spec)
+}
+
+// policyRoles returns the roles a policy applies to. A policy for PUBLIC
+// applies to every role, which sql_policy records as no roles at all.
+func policyRoles(l *ast.List) []string {
+	var out []string
+	for _, name := range roleNames(l) {
+		if name == core.PublicRole {
+			return nil
+		}
+		out = append(out, name)
+	}
+	return out
+}
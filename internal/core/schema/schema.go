@@ -2,11 +2,13 @@ package schema
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/core"
 	"github.com/sqlc-dev/sqlc/internal/core/analyzer"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
 )
 
 func Apply(cat *core.Catalog, n ast.Node) error {
@@ -24,10 +26,22 @@ func Apply(cat *core.Catalog, n ast.Node) error {
 		return nil
 	case *ast.CreateTableStmt:
 		return applyCreateTable(cat, v)
+	case *ast.IndexStmt:
+		return applyCreateIndex(cat, v)
+	case *ast.CreateTrigStmt:
+		return applyCreateTrigger(cat, v)
 	case *ast.DropTableStmt:
 		return applyDropTable(cat, v)
 	case *ast.CreateEnumStmt:
 		return applyCreateEnum(cat, v)
+	case *ast.CreateSchemaStmt:
+		return applyCreateSchema(cat, v)
+	case *ast.CreateDomainStmt:
+		return applyCreateDomain(cat, v)
+	case *ast.CompositeTypeStmt:
+		return applyCompositeType(cat, v)
+	case *ast.CreateSeqStmt:
+		return applyCreateSequence(cat, v)
 	case *ast.CreateExtensionStmt:
 		if v.Extname == nil {
 			return nil
@@ -37,6 +51,14 @@ func Apply(cat *core.Catalog, n ast.Node) error {
 		return applyCreateFunction(cat, v)
 	case *ast.AlterTableStmt:
 		return applyAlterTable(cat, v)
+	case *ast.GrantStmt:
+		return applyGrant(cat, v)
+	case *ast.GrantRoleStmt:
+		return applyGrantRole(cat, v)
+	case *ast.CreatePolicyStmt:
+		return applyCreatePolicy(cat, v)
+	case *ast.AlterPolicyStmt:
+		return applyAlterPolicy(cat, v)
 	case *ast.RenameColumnStmt:
 		return applyRenameColumn(cat, v)
 	case *ast.RenameTableStmt:
@@ -48,6 +70,69 @@ func Apply(cat *core.Catalog, n ast.Node) error {
 			return nil
 		}
 		return applyView(cat, v.Into.Rel, v.Into.ColNames, v.Query, false)
+	case *ast.VariableSetStmt:
+		return applyVariableSet(cat, v)
+	case *ast.CommentOnTableStmt:
+		return applyCommentOnTable(cat, v)
+	case *ast.CommentOnColumnStmt:
+		return applyCommentOnColumn(cat, v)
+	}
+	return nil
+}
+
+// applyCommentOnTable records the comment COMMENT ON TABLE sets; COMMENT ON
+// TABLE ... IS NULL removes it.
+func applyCommentOnTable(cat *core.Catalog, stmt *ast.CommentOnTableStmt) error {
+	classOID, err := lookupClass(cat, stmt.Table)
+	if err != nil {
+		return err
+	}
+	var comment string
+	if stmt.Comment != nil {
+		comment = *stmt.Comment
+	}
+	return cat.SetClassComment(classOID, comment)
+}
+
+func applyCommentOnColumn(cat *core.Catalog, stmt *ast.CommentOnColumnStmt) error {
+	if stmt.Col == nil {
+		return nil
+	}
+	classOID, err := lookupClass(cat, stmt.Table)
+	if err != nil {
+		return err
+	}
+	var comment string
+	if stmt.Comment != nil {
+		comment = *stmt.Comment
+	}
+	return cat.SetAttributeComment(classOID, stmt.Col.Name, comment)
+}
+
+// applyVariableSet follows SET search_path, which decides where the rest of
+// the schema's unqualified names land. SET LOCAL is followed the same way:
+// the catalog does not track transactions. No other setting changes what the
+// catalog holds.
+func applyVariableSet(cat *core.Catalog, stmt *ast.VariableSetStmt) error {
+	if stmt.Kind == ast.VariableSetKind_RESET_ALL {
+		return cat.SetSearchPath(nil)
+	}
+	if stmt.Name == nil || !strings.EqualFold(*stmt.Name, "search_path") {
+		return nil
+	}
+	switch stmt.Kind {
+	case ast.VariableSetKind_VALUE:
+		var path []string
+		for _, item := range listItems(stmt.Args) {
+			if c, ok := item.(*ast.A_Const); ok {
+				if str, ok := c.Val.(*ast.String); ok {
+					path = append(path, str.Str)
+				}
+			}
+		}
+		return cat.SetSearchPath(path)
+	case ast.VariableSetKind_DEFAULT, ast.VariableSetKind_RESET:
+		return cat.SetSearchPath(nil)
 	}
 	return nil
 }
@@ -59,11 +144,8 @@ func applyView(cat *core.Catalog, rel *ast.RangeVar, aliases *ast.List, query as
 		return fmt.Errorf("create view with nil name")
 	}
 	name := *rel.Relname
-	schema := ""
-	if rel.Schemaname != nil {
-		schema = *rel.Schemaname
-	}
-	nsOID, err := resolveOrCreateNamespace(cat, schema)
+	table := rangeVarTableName(rel)
+	nsOID, err := cat.CreationNamespace(table.Catalog, table.Schema)
 	if err != nil {
 		return err
 	}
@@ -133,7 +215,7 @@ func applyCreateTable(cat *core.Catalog, stmt *ast.CreateTableStmt) error {
 	if stmt.Name == nil {
 		return fmt.Errorf("create table with nil name")
 	}
-	nsOID, err := resolveOrCreateNamespace(cat, stmt.Name.Schema)
+	nsOID, err := cat.CreationNamespace(stmt.Name.Catalog, stmt.Name.Schema)
 	if err != nil {
 		return err
 	}
@@ -143,54 +225,470 @@ func applyCreateTable(cat *core.Catalog, stmt *ast.CreateTableStmt) error {
 		}
 		return fmt.Errorf("relation %q already exists", stmt.Name.Name)
 	}
-	classOID, err := cat.CreateClass(nsOID, stmt.Name.Name, "r")
+	var parents []int64
+	for _, tn := range stmt.Inherits {
+		parentOID, err := lookupClass(cat, tn)
+		if err != nil {
+			return fmt.Errorf("inherit %q: %w", tn.Name, err)
+		}
+		parents = append(parents, parentOID)
+	}
+	partition := stmt.Partbound != nil
+	if partition {
+		if len(parents) != 1 {
+			return fmt.Errorf("partition %q: missing parent", stmt.Name.Name)
+		}
+		if err := checkPartitioned(cat, parents[0], stmt.Inherits[0].Name); err != nil {
+			return err
+		}
+	}
+	kind := "r"
+	if stmt.Partspec != nil {
+		kind = "p"
+	}
+	classOID, err := cat.CreateClass(nsOID, stmt.Name.Name, kind)
 	if err != nil {
 		return err
 	}
+	if stmt.Comment != "" {
+		if err := cat.SetClassComment(classOID, stmt.Comment); err != nil {
+			return err
+		}
+	}
+
+	// Inherited columns come first, and a column the table declares again
+	// is merged into the one it inherits.
+	cols, err := inheritedColumns(cat, parents, partition)
+	if err != nil {
+		return fmt.Errorf("create table %q: %w", stmt.Name.Name, err)
+	}
+	inherited := make(map[string]int, len(cols))
+	for i, col := range cols {
+		inherited[col.Name] = i
+	}
 	for i, col := range stmt.Cols {
-		if col == nil || col.TypeName == nil {
+		if col == nil {
+			return fmt.Errorf("column %d on %q: missing type", i+1, stmt.Name.Name)
+		}
+		if j, ok := inherited[col.Colname]; ok {
+			if col.TypeName != nil {
+				typeOID, err := columnTypeOID(cat, col)
+				if err != nil {
+					return fmt.Errorf("column %s.%s: %w", stmt.Name.Name, col.Colname, err)
+				}
+				if typeOID != cols[j].TypeOID {
+					return fmt.Errorf("column %q has a type conflict", col.Colname)
+				}
+			}
+			cols[j].NotNull = cols[j].NotNull || col.IsNotNull || col.PrimaryKey
+			cols[j].IsPrimaryKey = cols[j].IsPrimaryKey || col.PrimaryKey
+			continue
+		}
+		if col.TypeName == nil {
+			if partition {
+				return fmt.Errorf("column %q named in partition does not exist", col.Colname)
+			}
 			return fmt.Errorf("column %d on %q: missing type", i+1, stmt.Name.Name)
 		}
 		typeOID, err := columnTypeOID(cat, col)
 		if err != nil {
 			return fmt.Errorf("column %s.%s: %w", stmt.Name.Name, col.Colname, err)
 		}
-		if err := cat.CreateAttributeSpec(core.AttributeSpec{
-			ClassOID:     classOID,
+		cols = append(cols, core.AttributeSpec{
 			Name:         col.Colname,
 			TypeOID:      typeOID,
-			Num:          i + 1,
 			NotNull:      col.IsNotNull || col.PrimaryKey,
 			IsPrimaryKey: col.PrimaryKey,
+			IsGenerated:  col.Generated != nil,
 			DeclType:     col.TypeName.Name,
-		}); err != nil {
-			return fmt.Errorf("attr %s.%s: %w", stmt.Name.Name, col.Colname, err)
+			Default:      col.Default,
+			Comment:      col.Comment,
+		})
+	}
+	for i, col := range cols {
+		col.ClassOID = classOID
+		col.Num = i + 1
+		if err := cat.CreateAttributeSpec(col); err != nil {
+			return fmt.Errorf("attr %s.%s: %w", stmt.Name.Name, col.Name, err)
+		}
+	}
+	for _, parentOID := range parents {
+		if err := cat.AddInherits(classOID, parentOID, partition); err != nil {
+			return err
+		}
+	}
+	// A generated column or a check may refer to any of the table's columns,
+	// so their expressions are typed once they all exist.
+	for _, col := range stmt.Cols {
+		if err := typeGenerated(cat, stmt.Name, col); err != nil {
+			return err
+		}
+	}
+	for _, check := range stmt.Checks {
+		if err := applyCheck(cat, classOID, stmt.Name, check); err != nil {
+			return err
+		}
+	}
+	return applyTableKeys(cat, classOID, stmt)
+}
+
+// inheritedColumns returns the columns a table inherits from its parents, in
+// the order the parents are listed. A column more than one parent has is
+// inherited once, and must have the same type in each. A table inherits
+// whether its parents' columns accept NULL but not their keys, which a
+// partition has as well.
+func inheritedColumns(cat *core.Catalog, parents []int64, partition bool) ([]core.AttributeSpec, error) {
+	var out []core.AttributeSpec
+	index := map[string]int{}
+	for _, parentOID := range parents {
+		cols, err := cat.ClassColumns(parentOID)
+		if err != nil {
+			return nil, err
+		}
+		for _, col := range cols {
+			if i, ok := index[col.Name]; ok {
+				if out[i].TypeOID != col.TypeOID {
+					return nil, fmt.Errorf("column %q has a type conflict", col.Name)
+				}
+				out[i].NotNull = out[i].NotNull || col.NotNull
+				continue
+			}
+			att, err := cat.LookupAttribute(col.AttOID)
+			if err != nil {
+				return nil, err
+			}
+			index[col.Name] = len(out)
+			out = append(out, core.AttributeSpec{
+				Name:         col.Name,
+				TypeOID:      col.TypeOID,
+				NotNull:      col.NotNull,
+				DeclType:     att.DeclType,
+				TypeLength:   att.TypeLength,
+				TypeScale:    att.TypeScale,
+				IsPrimaryKey: partition && att.IsPrimaryKey,
+				IsGenerated:  col.Generated,
+			})
+		}
+	}
+	return out, nil
+}
+
+// checkPartitioned reports a table that PARTITION OF or ATTACH PARTITION
+// names as a parent but that has no PARTITION BY.
+func checkPartitioned(cat *core.Catalog, classOID int64, name string) error {
+	kind, err := cat.ClassKind(classOID)
+	if err != nil {
+		return err
+	}
+	if kind != "p" {
+		return fmt.Errorf("table %q is not partitioned", name)
+	}
+	return nil
+}
+
+// typeGenerated types the expression a generated column is computed from.
+func typeGenerated(cat *core.Catalog, table *ast.TableName, col *ast.ColumnDef) error {
+	if col == nil || col.Generated == nil {
+		return nil
+	}
+	if err := typeTableExpr(cat, table, col.Generated); err != nil {
+		return fmt.Errorf("generated column %s.%s: %w", table.Name, col.Colname, err)
+	}
+	return nil
+}
+
+// typeTableExpr types an expression that belongs to a table's definition,
+// such as a generated column's or a check's, against the table. This is what
+// reports a reference to a column the table does not have.
+func typeTableExpr(cat *core.Catalog, table *ast.TableName, expr ast.Node) error {
+	schema, name := table.Schema, table.Name
+	sel := &ast.SelectStmt{
+		TargetList: &ast.List{Items: []ast.Node{&ast.ResTarget{Val: expr}}},
+		FromClause: &ast.List{Items: []ast.Node{&ast.RangeVar{Schemaname: &schema, Relname: &name}}},
+	}
+	_, err := analyzer.Prepare(cat, sel)
+	return err
+}
+
+// applyCheck records a CHECK constraint with the columns its expression
+// references.
+func applyCheck(cat *core.Catalog, classOID int64, table *ast.TableName, check *ast.Check) error {
+	if check == nil || check.Expr == nil {
+		return nil
+	}
+	if err := typeTableExpr(cat, table, check.Expr); err != nil {
+		if check.Name != "" {
+			return fmt.Errorf("check constraint %q on %s: %w", check.Name, table.Name, err)
+		}
+		return fmt.Errorf("check constraint on %s: %w", table.Name, err)
+	}
+	nums, err := columnNums(cat, classOID)
+	if err != nil {
+		return err
+	}
+	var parts []string
+	seen := map[int]bool{}
+	for _, ref := range astutils.Search(check.Expr, func(n ast.Node) bool {
+		_, ok := n.(*ast.ColumnRef)
+		return ok
+	}).Items {
+		fields := listStrings(ref.(*ast.ColumnRef).Fields)
+		if len(fields) == 0 {
+			continue
+		}
+		num, ok := nums[fields[len(fields)-1]]
+		if !ok || seen[num] {
+			continue
+		}
+		seen[num] = true
+		parts = append(parts, strconv.Itoa(num))
+	}
+	return cat.CreateConstraint(classOID, check.Name, "c", strings.Join(parts, ","))
+}
+
+// columnNums maps the names of a relation's columns to their ordinal
+// positions.
+func columnNums(cat *core.Catalog, classOID int64) (map[string]int, error) {
+	cols, err := cat.ClassColumns(classOID)
+	if err != nil {
+		return nil, err
+	}
+	nums := make(map[string]int, len(cols))
+	for _, col := range cols {
+		nums[col.Name] = col.Num
+	}
+	return nums, nil
+}
+
+// applyTableKeys records a new table's primary key and unique constraints.
+// Engines that only mark primary key columns inline leave PrimaryKey empty.
+func applyTableKeys(cat *core.Catalog, classOID int64, stmt *ast.CreateTableStmt) error {
+	nums := make(map[string]int, len(stmt.Cols))
+	primary := stmt.PrimaryKey
+	for i, col := range stmt.Cols {
+		if col == nil {
+			continue
+		}
+		nums[col.Colname] = i + 1
+		if col.PrimaryKey && len(stmt.PrimaryKey) == 0 {
+			primary = append(primary, col.Colname)
+		}
+	}
+	keyColumns := func(key []string) (string, bool) {
+		parts := make([]string, 0, len(key))
+		for _, name := range key {
+			num, ok := nums[name]
+			if !ok {
+				return "", false
+			}
+			parts = append(parts, strconv.Itoa(num))
+		}
+		return strings.Join(parts, ","), true
+	}
+	if cols, ok := keyColumns(primary); ok && len(primary) > 0 {
+		if err := cat.CreateConstraint(classOID, "", "p", cols); err != nil {
+			return err
+		}
+		if err := cat.SetAttributePrimaryKey(classOID, primary); err != nil {
+			return err
+		}
+	}
+	for _, key := range stmt.UniqueKeys {
+		cols, ok := keyColumns(key)
+		if !ok || len(key) == 0 {
+			continue
+		}
+		if err := cat.CreateConstraint(classOID, "", "u", cols); err != nil {
+			return err
+		}
+		if len(key) == 1 {
+			if err := cat.SetAttributeUnique(classOID, key); err != nil {
+				return err
+			}
+		}
+	}
+	for _, fk := range stmt.ForeignKeys {
+		cols, ok := keyColumns(fk.Columns)
+		if !ok || len(fk.Columns) == 0 || fk.RefTable == nil {
+			continue
+		}
+		err := cat.CreateForeignKey(classOID, cols, core.ForeignKey{
+			Name:       fk.Name,
+			RefSchema:  fk.RefTable.Schema,
+			RefTable:   fk.RefTable.Name,
+			RefColumns: fk.RefColumns,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applyCreateIndex records an index on the relation it indexes. A unique
+// index over plain columns is recorded as the unique key it enforces; one
+// that is partial or covers an expression is not a key.
+func applyCreateIndex(cat *core.Catalog, stmt *ast.IndexStmt) error {
+	if stmt.Relation == nil {
+		return fmt.Errorf("create index with nil relation")
+	}
+	table := rangeVarTableName(stmt.Relation)
+	classOID, err := lookupClass(cat, table)
+	if err != nil {
+		return err
+	}
+	var name string
+	if stmt.Idxname != nil {
+		name = *stmt.Idxname
+	}
+	if name != "" {
+		nsOID, err := cat.ClassNamespaceOID(classOID)
+		if err != nil {
+			return err
 		}
+		exists, err := cat.IndexExists(nsOID, name)
+		if err != nil {
+			return err
+		}
+		if exists {
+			if stmt.IfNotExists {
+				return nil
+			}
+			return fmt.Errorf("index %q already exists", name)
+		}
+	}
+	nums, err := columnNums(cat, classOID)
+	if err != nil {
+		return err
+	}
+	kind := "i"
+	if stmt.Unique && stmt.WhereClause == nil {
+		kind = "u"
+	}
+	var parts, key []string
+	for _, item := range listItems(stmt.IndexParams) {
+		elem, ok := item.(*ast.IndexElem)
+		if !ok {
+			continue
+		}
+		if elem.Name == nil || *elem.Name == "" {
+			if err := typeTableExpr(cat, table, elem.Expr); err != nil {
+				return fmt.Errorf("index %q: %w", name, err)
+			}
+			parts = append(parts, "0")
+			kind = "i"
+			continue
+		}
+		num, ok := nums[*elem.Name]
+		if !ok {
+			return fmt.Errorf("column %q does not exist", *elem.Name)
+		}
+		parts = append(parts, strconv.Itoa(num))
+		key = append(key, *elem.Name)
+	}
+	if stmt.WhereClause != nil {
+		if err := typeTableExpr(cat, table, stmt.WhereClause); err != nil {
+			return fmt.Errorf("index %q: %w", name, err)
+		}
+	}
+	if err := cat.CreateConstraint(classOID, name, kind, strings.Join(parts, ",")); err != nil {
+		return err
+	}
+	if kind == "u" && len(key) == 1 {
+		return cat.SetAttributeUnique(classOID, key)
 	}
 	return nil
 }
 
+// applyCreateTrigger records a trigger on the relation it fires for. The
+// statements or function a trigger runs are not recorded.
+func applyCreateTrigger(cat *core.Catalog, stmt *ast.CreateTrigStmt) error {
+	if stmt.Relation == nil || stmt.Trigname == nil {
+		return fmt.Errorf("create trigger with nil name")
+	}
+	table := rangeVarTableName(stmt.Relation)
+	classOID, err := lookupClass(cat, table)
+	if err != nil {
+		return err
+	}
+	exists, err := cat.TriggerExists(classOID, *stmt.Trigname)
+	if err != nil {
+		return err
+	}
+	if exists {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return fmt.Errorf("trigger %q for relation %q already exists", *stmt.Trigname, table.Name)
+	}
+	if cols := listStrings(stmt.Columns); len(cols) > 0 {
+		nums, err := columnNums(cat, classOID)
+		if err != nil {
+			return err
+		}
+		for _, col := range cols {
+			if _, ok := nums[col]; !ok {
+				return fmt.Errorf("column %q of relation %q does not exist", col, table.Name)
+			}
+		}
+	}
+	timing := "a"
+	switch {
+	case stmt.Timing&ast.TriggerTypeInstead != 0:
+		timing = "i"
+	case stmt.Timing&ast.TriggerTypeBefore != 0:
+		timing = "b"
+	}
+	var events strings.Builder
+	for _, e := range []struct {
+		bit    int16
+		letter byte
+	}{
+		{ast.TriggerTypeInsert, 'i'},
+		{ast.TriggerTypeUpdate, 'u'},
+		{ast.TriggerTypeDelete, 'd'},
+		{ast.TriggerTypeTruncate, 't'},
+	} {
+		if stmt.Events&e.bit != 0 {
+			events.WriteByte(e.letter)
+		}
+	}
+	return cat.CreateTrigger(core.TriggerSpec{
+		ClassOID:   classOID,
+		Name:       *stmt.Trigname,
+		Timing:     timing,
+		Events:     events.String(),
+		ForEachRow: stmt.Row,
+	})
+}
+
 func applyDropTable(cat *core.Catalog, stmt *ast.DropTableStmt) error {
 	for _, tn := range stmt.Tables {
 		if tn == nil {
 			continue
 		}
-		nsOID, err := cat.NamespaceOID(nsName(tn.Schema))
+		classOID, err := lookupClass(cat, tn)
 		if err != nil {
 			if stmt.IfExists {
 				continue
 			}
-			return err
+			return fmt.Errorf("drop table %q: %w", tn.Name, err)
 		}
-		classOID, err := cat.ClassOID(nsOID, tn.Name)
+		// A partitioned table's partitions go with it.
+		kind, err := cat.ClassKind(classOID)
 		if err != nil {
-			if stmt.IfExists {
-				continue
+			return err
+		}
+		drop := []int64{classOID}
+		if kind == "p" {
+			if drop, err = inheritors(cat, classOID); err != nil {
+				return err
 			}
-			return fmt.Errorf("drop table %q: %w", tn.Name, err)
 		}
-		if err := cat.DropClass(classOID); err != nil {
-			return fmt.Errorf("drop table %q: %w", tn.Name, err)
+		for i := len(drop) - 1; i >= 0; i-- {
+			if err := cat.DropClass(drop[i]); err != nil {
+				return fmt.Errorf("drop table %q: %w", tn.Name, err)
+			}
 		}
 	}
 	return nil
@@ -208,6 +706,12 @@ func applyAlterTable(cat *core.Catalog, stmt *ast.AlterTableStmt) error {
 		}
 		return err
 	}
+	// A change to a table's columns applies to the tables that inherit
+	// them as well.
+	targets, err := inheritors(cat, classOID)
+	if err != nil {
+		return err
+	}
 	for _, item := range listItems(stmt.Cmds) {
 		cmd, ok := item.(*ast.AlterTableCmd)
 		if !ok {
@@ -222,26 +726,47 @@ func applyAlterTable(cat *core.Catalog, stmt *ast.AlterTableStmt) error {
 			if err != nil {
 				return err
 			}
-			num, err := cat.NextAttributeNum(classOID)
-			if err != nil {
-				return err
+			for _, target := range targets {
+				// A table that inherits a column it already has keeps its own.
+				if target != classOID {
+					ok, err := hasColumn(cat, target, cmd.Def.Colname)
+					if err != nil {
+						return err
+					}
+					if ok {
+						continue
+					}
+				}
+				num, err := cat.NextAttributeNum(target)
+				if err != nil {
+					return err
+				}
+				if err := cat.CreateAttributeSpec(core.AttributeSpec{
+					ClassOID:     target,
+					Name:         cmd.Def.Colname,
+					TypeOID:      typeOID,
+					Num:          num,
+					NotNull:      cmd.Def.IsNotNull || cmd.Def.PrimaryKey,
+					IsPrimaryKey: cmd.Def.PrimaryKey && target == classOID,
+					IsGenerated:  cmd.Def.Generated != nil,
+					DeclType:     cmd.Def.TypeName.Name,
+					Default:      cmd.Def.Default,
+					Comment:      cmd.Def.Comment,
+				}); err != nil {
+					return err
+				}
 			}
-			if err := cat.CreateAttributeSpec(core.AttributeSpec{
-				ClassOID:     classOID,
-				Name:         cmd.Def.Colname,
-				TypeOID:      typeOID,
-				Num:          num,
-				NotNull:      cmd.Def.IsNotNull || cmd.Def.PrimaryKey,
-				IsPrimaryKey: cmd.Def.PrimaryKey,
-				DeclType:     cmd.Def.TypeName.Name,
-			}); err != nil {
+			if err := typeGenerated(cat, table, cmd.Def); err != nil {
 				return err
 			}
 		case ast.AT_DropColumn:
 			if cmd.Name == nil {
 				continue
 			}
-			if err := cat.DropAttribute(classOID, *cmd.Name); err != nil {
+			err := forInheritors(cat, targets, *cmd.Name, func(target int64) error {
+				return cat.DropAttribute(target, *cmd.Name)
+			})
+			if err != nil {
 				return err
 			}
 		case ast.AT_AlterColumnType:
@@ -256,28 +781,151 @@ func applyAlterTable(cat *core.Catalog, stmt *ast.AlterTableStmt) error {
 			if err != nil {
 				return err
 			}
-			if err := cat.SetAttributeType(classOID, name, typeOID, cmd.Def.TypeName.Name); err != nil {
-				return err
-			}
-			// An engine that reports a column's whole new definition also
-			// reports whether it still accepts NULL.
-			if cmd.Def.IsNotNull {
-				if err := cat.SetAttributeNotNull(classOID, name, true); err != nil {
+			err = forInheritors(cat, targets, name, func(target int64) error {
+				if err := cat.SetAttributeType(target, name, typeOID, cmd.Def.TypeName.Name); err != nil {
 					return err
 				}
+				// An engine that reports a column's whole new definition
+				// also reports whether it still accepts NULL.
+				if cmd.Def.IsNotNull {
+					return cat.SetAttributeNotNull(target, name, true)
+				}
+				return nil
+			})
+			if err != nil {
+				return err
 			}
 		case ast.AT_SetNotNull, ast.AT_DropNotNull:
 			if cmd.Name == nil {
 				continue
 			}
-			if err := cat.SetAttributeNotNull(classOID, *cmd.Name, cmd.Subtype == ast.AT_SetNotNull); err != nil {
+			err := forInheritors(cat, targets, *cmd.Name, func(target int64) error {
+				return cat.SetAttributeNotNull(target, *cmd.Name, cmd.Subtype == ast.AT_SetNotNull)
+			})
+			if err != nil {
+				return err
+			}
+		case ast.AT_AttachPartition, ast.AT_DetachPartition:
+			if cmd.Partition == nil || cmd.Partition.Name == nil {
+				continue
+			}
+			partName := rangeVarTableName(cmd.Partition.Name)
+			partOID, err := lookupClass(cat, partName)
+			if err != nil {
+				return err
+			}
+			if cmd.Subtype == ast.AT_DetachPartition {
+				if err := cat.RemoveInherits(partOID, classOID); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := attachPartition(cat, classOID, table.Name, partOID, partName.Name); err != nil {
+				return err
+			}
+		case ast.AT_ChangeOwner:
+			owner, ok := roleName(cmd.Newowner)
+			if !ok {
+				continue
+			}
+			if err := cat.SetClassOwner(classOID, owner); err != nil {
+				return err
+			}
+		case ast.AT_EnableRowSecurity, ast.AT_DisableRowSecurity:
+			if err := cat.SetClassRowSecurity(classOID, cmd.Subtype == ast.AT_EnableRowSecurity); err != nil {
+				return err
+			}
+		case ast.AT_ForceRowSecurity, ast.AT_NoForceRowSecurity:
+			if err := cat.SetClassForceRowSecurity(classOID, cmd.Subtype == ast.AT_ForceRowSecurity); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// inheritors returns a relation followed by every relation that inherits
+// from it.
+func inheritors(cat *core.Catalog, classOID int64) ([]int64, error) {
+	descendants, err := cat.Descendants(classOID)
+	if err != nil {
+		return nil, err
+	}
+	return append([]int64{classOID}, descendants...), nil
+}
+
+// forInheritors calls fn for the first of targets, and for each of the rest
+// that has the column name.
+func forInheritors(cat *core.Catalog, targets []int64, name string, fn func(classOID int64) error) error {
+	for i, target := range targets {
+		if i > 0 {
+			ok, err := hasColumn(cat, target, name)
+			if err != nil {
 				return err
 			}
+			if !ok {
+				continue
+			}
+		}
+		if err := fn(target); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
+func hasColumn(cat *core.Catalog, classOID int64, name string) (bool, error) {
+	nums, err := columnNums(cat, classOID)
+	if err != nil {
+		return false, err
+	}
+	_, ok := nums[name]
+	return ok, nil
+}
+
+// attachPartition makes an existing table a partition of a partitioned one,
+// which it can only be if it has exactly the parent's columns.
+func attachPartition(cat *core.Catalog, parentOID int64, parent string, partOID int64, part string) error {
+	if err := checkPartitioned(cat, parentOID, parent); err != nil {
+		return err
+	}
+	isPartition, err := cat.IsPartition(partOID)
+	if err != nil {
+		return err
+	}
+	if isPartition {
+		return fmt.Errorf("%q is already a partition", part)
+	}
+	parentCols, err := cat.ClassColumns(parentOID)
+	if err != nil {
+		return err
+	}
+	partCols, err := cat.ClassColumns(partOID)
+	if err != nil {
+		return err
+	}
+	types := make(map[string]int64, len(partCols))
+	for _, col := range partCols {
+		types[col.Name] = col.TypeOID
+	}
+	for _, col := range parentCols {
+		typeOID, ok := types[col.Name]
+		if !ok {
+			return fmt.Errorf("table %q is missing column %q of %q", part, col.Name, parent)
+		}
+		if typeOID != col.TypeOID {
+			return fmt.Errorf("table %q has a different type for column %q", part, col.Name)
+		}
+		delete(types, col.Name)
+	}
+	for _, col := range partCols {
+		if _, ok := types[col.Name]; ok {
+			return fmt.Errorf("table %q contains column %q not found in parent %q", part, col.Name, parent)
+		}
+	}
+	return cat.AddInherits(partOID, parentOID, true)
+}
+
 func applyRenameColumn(cat *core.Catalog, stmt *ast.RenameColumnStmt) error {
 	if stmt.Col == nil || stmt.NewName == nil {
 		return nil
@@ -297,7 +945,13 @@ func applyRenameColumn(cat *core.Catalog, stmt *ast.RenameColumnStmt) error {
 	if name == "" {
 		return nil
 	}
-	return cat.RenameAttribute(classOID, name, *stmt.NewName)
+	targets, err := inheritors(cat, classOID)
+	if err != nil {
+		return err
+	}
+	return forInheritors(cat, targets, name, func(target int64) error {
+		return cat.RenameAttribute(target, name, *stmt.NewName)
+	})
 }
 
 func applyRenameTable(cat *core.Catalog, stmt *ast.RenameTableStmt) error {
@@ -318,15 +972,14 @@ func lookupClass(cat *core.Catalog, table *ast.TableName) (int64, error) {
 	if table == nil {
 		return 0, fmt.Errorf("missing table name")
 	}
-	nsOID, err := cat.NamespaceOID(nsName(table.Schema))
-	if err != nil {
-		return 0, err
-	}
-	return cat.ClassOID(nsOID, table.Name)
+	return cat.LookupClass(table.Catalog, table.Schema, table.Name)
 }
 
 func rangeVarTableName(rv *ast.RangeVar) *ast.TableName {
 	tn := &ast.TableName{}
+	if rv.Catalogname != nil {
+		tn.Catalog = *rv.Catalogname
+	}
 	if rv.Schemaname != nil {
 		tn.Schema = *rv.Schemaname
 	}
@@ -358,12 +1011,161 @@ func applyCreateEnum(cat *core.Catalog, stmt *ast.CreateEnumStmt) error {
 	return err
 }
 
+func applyCreateSchema(cat *core.Catalog, stmt *ast.CreateSchemaStmt) error {
+	if stmt.Name == nil || *stmt.Name == "" {
+		return fmt.Errorf("create schema with empty name")
+	}
+	if _, err := cat.NamespaceOID(*stmt.Name); err == nil {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return fmt.Errorf("schema %q already exists", *stmt.Name)
+	}
+	_, err := cat.CreateNamespace(*stmt.Name)
+	return err
+}
+
+// qualifiedName splits a name given as a list of parts into its schema and
+// its last part.
+func qualifiedName(l *ast.List) (schema, name string) {
+	parts := listStrings(l)
+	if len(parts) == 0 {
+		return "", ""
+	}
+	name = parts[len(parts)-1]
+	if len(parts) > 1 {
+		schema = parts[len(parts)-2]
+	}
+	return schema, name
+}
+
+// applyCreateDomain records a type that is another under a new name, which
+// a column declared with it resolves to.
+func applyCreateDomain(cat *core.Catalog, stmt *ast.CreateDomainStmt) error {
+	schema, name := qualifiedName(stmt.Domainname)
+	if name == "" {
+		return fmt.Errorf("create type with empty name")
+	}
+	if _, err := cat.TypeOID(name); err == nil {
+		return nil
+	}
+	baseOID, err := cat.ResolveType(stmt.TypeName)
+	if err != nil {
+		return fmt.Errorf("type %q: %w", name, err)
+	}
+	nsOID, err := cat.CreationNamespace("", schema)
+	if err != nil {
+		return err
+	}
+	_, err = cat.CreateDomainType(nsOID, name, baseOID)
+	return err
+}
+
+// applyCompositeType records a composite type. The columns of one that
+// declares them are kept on a relation of the same name, the way a table's
+// are, so a value of the type can be described column by column.
+func applyCompositeType(cat *core.Catalog, stmt *ast.CompositeTypeStmt) error {
+	if stmt.TypeName == nil {
+		return fmt.Errorf("create type with nil name")
+	}
+	name := stmt.TypeName.Name
+	if name == "" {
+		return fmt.Errorf("create type with empty name")
+	}
+	if _, err := cat.TypeOID(name); err == nil {
+		return nil
+	}
+	nsOID, err := cat.CreationNamespace("", stmt.TypeName.Schema)
+	if err != nil {
+		return err
+	}
+	if _, err := cat.CreateTypeSpec(core.TypeSpec{
+		Name:         name,
+		Typtype:      "c",
+		Category:     "C",
+		NamespaceOID: nsOID,
+		DialectOID:   cat.SeededDialectOID(),
+	}); err != nil {
+		return err
+	}
+	classOID, err := cat.CreateClass(nsOID, name, "c")
+	if err != nil {
+		return err
+	}
+	for i, col := range stmt.Cols {
+		if col == nil || col.TypeName == nil {
+			return fmt.Errorf("column %d on %q: missing type", i+1, name)
+		}
+		typeOID, err := columnTypeOID(cat, col)
+		if err != nil {
+			return fmt.Errorf("column %s.%s: %w", name, col.Colname, err)
+		}
+		if err := cat.CreateAttributeSpec(core.AttributeSpec{
+			ClassOID: classOID,
+			Name:     col.Colname,
+			TypeOID:  typeOID,
+			Num:      i + 1,
+			NotNull:  col.IsNotNull || col.PrimaryKey,
+			DeclType: col.TypeName.Name,
+		}); err != nil {
+			return fmt.Errorf("attr %s.%s: %w", name, col.Colname, err)
+		}
+	}
+	return nil
+}
+
+// applyCreateSequence records a sequence as a relation whose one column has
+// the type of the values it hands out, bigint unless the statement says
+// otherwise.
+func applyCreateSequence(cat *core.Catalog, stmt *ast.CreateSeqStmt) error {
+	if stmt.Sequence == nil || stmt.Sequence.Relname == nil {
+		return fmt.Errorf("create sequence with nil name")
+	}
+	name := *stmt.Sequence.Relname
+	table := rangeVarTableName(stmt.Sequence)
+	nsOID, err := cat.CreationNamespace(table.Catalog, table.Schema)
+	if err != nil {
+		return err
+	}
+	if _, err := cat.ClassOID(nsOID, name); err == nil {
+		if stmt.IfNotExists {
+			return nil
+		}
+		return fmt.Errorf("relation %q already exists", name)
+	}
+	typeName := "bigint"
+	for _, item := range listItems(stmt.Options) {
+		if d, ok := item.(*ast.DefElem); ok && d.Defname != nil && *d.Defname == "as" {
+			if tn, ok := d.Arg.(*ast.TypeName); ok {
+				typeName = core.TypeNameString(tn)
+			}
+		}
+	}
+	typeOID, err := cat.ResolveTypeName(typeName)
+	if err != nil {
+		return fmt.Errorf("sequence %q: %w", name, err)
+	}
+	classOID, err := cat.CreateClass(nsOID, name, "S")
+	if err != nil {
+		return err
+	}
+	return cat.CreateAttributeSpec(core.AttributeSpec{
+		ClassOID: classOID,
+		Name:     "last_value",
+		TypeOID:  typeOID,
+		Num:      1,
+		NotNull:  true,
+		DeclType: typeName,
+	})
+}
+
 func applyCreateFunction(cat *core.Catalog, stmt *ast.CreateFunctionStmt) error {
-	// A procedure returns nothing, so there is no result for a query to
-	// select and nothing worth recording.
-	if stmt.Func == nil || stmt.Func.Name == "" || stmt.ReturnType == nil {
+	if stmt.Func == nil || stmt.Func.Name == "" {
 		return nil
 	}
+	if stmt.ReturnType == nil {
+		return applyCreateProcedure(cat, stmt)
+	}
 	returnOID, err := cat.ResolveType(stmt.ReturnType)
 	if err != nil {
 		return fmt.Errorf("function %q: %w", stmt.Func.Name, err)
@@ -375,10 +1177,6 @@ func applyCreateFunction(cat *core.Catalog, stmt *ast.CreateFunctionStmt) error
 			if !ok {
 				continue
 			}
-			switch p.Mode {
-			case ast.FuncParamOut, ast.FuncParamTable:
-				continue
-			}
 			argOID, err := cat.ResolveType(p.Type)
 			if err != nil {
 				return fmt.Errorf("function %q: %w", stmt.Func.Name, err)
@@ -387,6 +1185,16 @@ func applyCreateFunction(cat *core.Catalog, stmt *ast.CreateFunctionStmt) error
 			if p.Name != nil {
 				arg.Name = *p.Name
 			}
+			// The OUT and TABLE arguments are the columns of the rows the
+			// function returns; a call passes neither.
+			switch p.Mode {
+			case ast.FuncParamOut:
+				arg.Mode = "o"
+			case ast.FuncParamInOut:
+				arg.Mode = "b"
+			case ast.FuncParamTable:
+				arg.Mode = "t"
+			}
 			args = append(args, arg)
 		}
 	}
@@ -399,19 +1207,47 @@ func applyCreateFunction(cat *core.Catalog, stmt *ast.CreateFunctionStmt) error
 	return err
 }
 
-func nsName(schema string) string {
-	if schema == "" {
-		return "public"
+// applyCreateProcedure records a procedure, which returns nothing but takes
+// arguments a CALL or EXEC passes, OUTPUT arguments included.
+func applyCreateProcedure(cat *core.Catalog, stmt *ast.CreateFunctionStmt) error {
+	nsOID, err := cat.CreationNamespace(stmt.Func.Catalog, stmt.Func.Schema)
+	if err != nil {
+		return err
 	}
-	return schema
-}
-
-func resolveOrCreateNamespace(cat *core.Catalog, schema string) (int64, error) {
-	name := nsName(schema)
-	if oid, err := cat.NamespaceOID(name); err == nil {
-		return oid, nil
+	voidOID, err := cat.ResolveTypeName("void")
+	if err != nil {
+		return err
 	}
-	return cat.CreateNamespace(name)
+	var args []core.ProcArg
+	for _, item := range listItems(stmt.Params) {
+		p, ok := item.(*ast.FuncParam)
+		if !ok {
+			continue
+		}
+		argOID, err := cat.ResolveType(p.Type)
+		if err != nil {
+			return fmt.Errorf("procedure %q: %w", stmt.Func.Name, err)
+		}
+		arg := core.ProcArg{TypeOID: argOID, HasDefault: p.DefExpr != nil}
+		if p.Name != nil {
+			arg.Name = *p.Name
+		}
+		switch p.Mode {
+		case ast.FuncParamOut:
+			arg.Mode = "o"
+		case ast.FuncParamInOut:
+			arg.Mode = "b"
+		}
+		args = append(args, arg)
+	}
+	_, err = cat.CreateProc(core.ProcSpec{
+		Name:          stmt.Func.Name,
+		NamespaceOID:  nsOID,
+		Kind:          "p",
+		ReturnTypeOID: voidOID,
+		Args:          args,
+	})
+	return err
 }
 
 // columnTypeOID resolves a column's type. Engines report an array column
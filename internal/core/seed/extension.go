@@ -121,6 +121,7 @@ func (e *extension) addFunction(fn Function) error {
 		Kind:           fn.Kind,
 		ReturnTypeOID:  returnOID,
 		ReturnNullable: fn.Nullable,
+		Strict:         fn.Strict,
 		Args:           args,
 	})
 	if err != nil {
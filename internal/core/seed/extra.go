@@ -0,0 +1,136 @@
+package seed
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/core"
+)
+
+// Record is one line of a catalog file a configuration names: a type,
+// operator, cast or function that the dialect's seed does not ship, such as
+// one from an in-house extension. Exactly one of the fields is set.
+//
+//	{"type": {"name": "geography", "category": "U"}}
+//	{"function": {"name": "st_distance", "args": [{"type": "geography"}, {"type": "geography"}], "returns": "float8"}}
+type Record struct {
+	Type     *Type     `json:"type,omitempty"`
+	Operator *Operator `json:"operator,omitempty"`
+	Cast     *Cast     `json:"cast,omitempty"`
+	Function *Function `json:"function,omitempty"`
+}
+
+// Extra applies a catalog file, read from r and called name in errors, to a
+// catalog that has already been seeded. Records are applied in the order they
+// are written, so an operator or cast must come after the types it names. Like
+// an extension's, its types join the dialect's rules, and anything the dialect
+// already has is left as it is.
+func Extra(cat *core.Catalog, name string, r io.Reader) error {
+	e := &extension{cat: cat}
+	return decode(r, name, func(rec Record) error {
+		set := 0
+		for _, ok := range []bool{rec.Type != nil, rec.Operator != nil, rec.Cast != nil, rec.Function != nil} {
+			if ok {
+				set++
+			}
+		}
+		if set != 1 {
+			return errors.New(`want exactly one of "type", "operator", "cast" or "function"`)
+		}
+		switch {
+		case rec.Type != nil:
+			return e.addAliasedType(*rec.Type)
+		case rec.Operator != nil:
+			return e.addOperator(*rec.Operator)
+		case rec.Cast != nil:
+			return e.addCast(*rec.Cast)
+		default:
+			return e.addFunction(*rec.Function)
+		}
+	})
+}
+
+// addAliasedType registers a type and makes its spellings implicitly castable
+// to one another, as the dialect's own aliases are.
+func (e *extension) addAliasedType(t Type) error {
+	if t.Name == "" {
+		return errors.New("type has no name")
+	}
+	t.Name = strings.ToLower(t.Name)
+	for i, alias := range t.Aliases {
+		t.Aliases[i] = strings.ToLower(alias)
+	}
+	if err := e.addType(t); err != nil {
+		return err
+	}
+	for _, src := range t.Aliases {
+		if err := e.addCast(Cast{Source: t.Name, Target: src, Context: "i"}); err != nil {
+			return err
+		}
+		if err := e.addCast(Cast{Source: src, Target: t.Name, Context: "i"}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (e *extension) addOperator(op Operator) error {
+	leftOID, err := e.cat.TypeOID(op.Left)
+	if err != nil {
+		return fmt.Errorf("operator %q: unknown left type %q", op.Name, op.Left)
+	}
+	rightOID, err := e.cat.TypeOID(op.Right)
+	if err != nil {
+		return fmt.Errorf("operator %q: unknown right type %q", op.Name, op.Right)
+	}
+	resultOID, err := e.cat.TypeOID(op.Result)
+	if err != nil {
+		return fmt.Errorf("operator %q: unknown result type %q", op.Name, op.Result)
+	}
+	existing, err := e.cat.FindOperators(op.Name, leftOID, rightOID)
+	if err != nil {
+		return err
+	}
+	for _, o := range existing {
+		if o.LeftTypeOID == leftOID && o.RightTypeOID == rightOID {
+			return nil
+		}
+	}
+	_, err = e.cat.CreateOperator(core.OperatorSpec{
+		Name:          op.Name,
+		DialectOID:    e.cat.SeededDialectOID(),
+		LeftTypeOID:   leftOID,
+		RightTypeOID:  rightOID,
+		ResultTypeOID: resultOID,
+	})
+	return err
+}
+
+func (e *extension) addCast(c Cast) error {
+	srcOID, err := e.cat.TypeOID(c.Source)
+	if err != nil {
+		return fmt.Errorf("cast: unknown source type %q", c.Source)
+	}
+	tgtOID, err := e.cat.TypeOID(c.Target)
+	if err != nil {
+		return fmt.Errorf("cast: unknown target type %q", c.Target)
+	}
+	if srcOID == tgtOID {
+		return nil
+	}
+	if _, ok, err := e.cat.FindCast(srcOID, tgtOID); err != nil || ok {
+		return err
+	}
+	context := c.Context
+	if context == "" {
+		context = "i"
+	}
+	return e.cat.CreateCast(core.CastSpec{
+		SourceTypeOID: srcOID,
+		TargetTypeOID: tgtOID,
+		Context:       context,
+		DialectOID:    e.cat.SeededDialectOID(),
+	})
+}
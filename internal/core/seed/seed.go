@@ -19,6 +19,11 @@
 // A dialect may also hold an extensions/ directory with one directory per
 // extension, each a smaller bundle of the same files, applied when a schema
 // says CREATE EXTENSION.
+//
+// A dialect spoken by more than one database may hold a flavors/ directory
+// with one directory per database, each a bundle of the same files holding
+// what only that database defines. DialectFlavor seeds the dialect with one
+// of them on top of the rest.
 package seed
 
 import (
@@ -28,6 +33,7 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"path"
 	"slices"
 	"strings"
 
@@ -50,6 +56,10 @@ const (
 // extension the dialect knows.
 const ExtensionsDir = "extensions"
 
+// FlavorsDir is the directory under a dialect holding one directory per
+// flavor of the dialect.
+const FlavorsDir = "flavors"
+
 // Settings is dialect.json: what the dialect is called and the rules that
 // generate its operators and casts.
 type Settings struct {
@@ -106,13 +116,15 @@ type Cast struct {
 }
 
 // Function is a function the dialect ships with. Kind is 'f'unction,
-// 'a'ggregate, 'w'indow or 'p'rocedure.
+// 'a'ggregate, 'w'indow or 'p'rocedure. A strict function returns NULL when
+// any of its arguments is NULL, so its result is nullable when they are.
 type Function struct {
 	Name     string `json:"name"`
 	Kind     string `json:"kind,omitempty"`
 	Args     []Arg  `json:"args,omitempty"`
 	Returns  string `json:"returns"`
 	Nullable bool   `json:"nullable,omitempty"`
+	Strict   bool   `json:"strict,omitempty"`
 }
 
 // Relation is a table or view the dialect ships with, such as one of
@@ -150,12 +162,24 @@ type Arg struct {
 // JSONL files in dir. A dialect that ships extension data — a directory per
 // extension under extensions/ — also has CREATE EXTENSION wired up to it.
 func Dialect(fsys fs.FS, dir string) core.Option {
+	return DialectFlavor(fsys, dir, "")
+}
+
+// DialectFlavor is Dialect for one flavor of a dialect, seeding what the
+// flavor's directory under flavors/ adds along with the dialect's own files.
+// No flavor seeds the dialect with every flavor it has, so that a schema
+// written for any of them loads.
+func DialectFlavor(fsys fs.FS, dir, flavor string) core.Option {
 	return core.WithSeed(func(cat *core.Catalog) error {
 		sub, err := fs.Sub(fsys, dir)
 		if err != nil {
 			return fmt.Errorf("seed: %s: %w", dir, err)
 		}
-		if err := apply(cat, sub); err != nil {
+		layers, err := flavorLayers(sub, flavor)
+		if err != nil {
+			return fmt.Errorf("seed: %s: %w", dir, err)
+		}
+		if err := apply(cat, append([]fs.FS{sub}, layers...)); err != nil {
 			return err
 		}
 		cat.SetExtensionLoader(func(name string) error {
@@ -165,7 +189,42 @@ func Dialect(fsys fs.FS, dir string) core.Option {
 	})
 }
 
-func apply(cat *core.Catalog, fsys fs.FS) error {
+// flavorLayers returns the directories of a dialect's flavors to seed: the
+// named one, or all of them for none.
+func flavorLayers(fsys fs.FS, flavor string) ([]fs.FS, error) {
+	var names []string
+	if flavor != "" {
+		if _, err := fs.Stat(fsys, path.Join(FlavorsDir, flavor)); err != nil {
+			return nil, fmt.Errorf("no flavor %q", flavor)
+		}
+		names = []string{flavor}
+	} else {
+		entries, err := fs.ReadDir(fsys, FlavorsDir)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+		for _, e := range entries {
+			if e.IsDir() {
+				names = append(names, e.Name())
+			}
+		}
+	}
+	layers := make([]fs.FS, 0, len(names))
+	for _, name := range names {
+		sub, err := fs.Sub(fsys, path.Join(FlavorsDir, name))
+		if err != nil {
+			return nil, err
+		}
+		layers = append(layers, sub)
+	}
+	return layers, nil
+}
+
+// apply seeds a catalog from layers of dialect files, the dialect's own
+// directory first. Each list is read from every layer before the next list,
+// so a flavor's types are there for the rules and the functions to use.
+func apply(cat *core.Catalog, layers []fs.FS) error {
+	fsys := layers[0]
 	settings, err := loadSettings(fsys)
 	if err != nil {
 		return err
@@ -182,7 +241,7 @@ func apply(cat *core.Catalog, fsys fs.FS) error {
 	}
 
 	// Types come first: everything else names them.
-	if err := stream(fsys, TypesFile, b.addType); err != nil {
+	if err := streamLayers(layers, TypesFile, b.addType); err != nil {
 		return err
 	}
 	if err := b.consts(); err != nil {
@@ -191,19 +250,29 @@ func apply(cat *core.Catalog, fsys fs.FS) error {
 	if err := b.categoryOperators(); err != nil {
 		return err
 	}
-	if err := stream(fsys, OperatorsFile, b.addOperator); err != nil {
+	if err := streamLayers(layers, OperatorsFile, b.addOperator); err != nil {
 		return err
 	}
-	if err := stream(fsys, CastsFile, b.addCast); err != nil {
+	if err := streamLayers(layers, CastsFile, b.addCast); err != nil {
 		return err
 	}
 	if err := b.categoryCasts(); err != nil {
 		return err
 	}
-	if err := stream(fsys, FunctionsFile, b.addFunction); err != nil {
+	if err := streamLayers(layers, FunctionsFile, b.addFunction); err != nil {
 		return err
 	}
-	return stream(fsys, RelationsFile, b.addRelation)
+	return streamLayers(layers, RelationsFile, b.addRelation)
+}
+
+// streamLayers streams name from each of layers in turn.
+func streamLayers[T any](layers []fs.FS, name string, fn func(T) error) error {
+	for _, fsys := range layers {
+		if err := stream(fsys, name, fn); err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func loadSettings(fsys fs.FS) (Settings, error) {
@@ -238,8 +307,12 @@ func stream[T any](fsys fs.FS, name string, fn func(T) error) error {
 		return fmt.Errorf("seed: %w", err)
 	}
 	defer f.Close()
+	return decode(f, name, fn)
+}
 
-	dec := json.NewDecoder(bufio.NewReader(f))
+// decode is stream over a file that is already open, named name in errors.
+func decode[T any](r io.Reader, name string, fn func(T) error) error {
+	dec := json.NewDecoder(bufio.NewReader(r))
 	dec.DisallowUnknownFields()
 	for record := 1; ; record++ {
 		var rec T
@@ -602,6 +675,7 @@ func (b *builder) addFunction(fn Function) error {
 		Kind:           fn.Kind,
 		ReturnTypeOID:  returnOID,
 		ReturnNullable: fn.Nullable,
+		Strict:         fn.Strict,
 		Args:           args,
 	})
 	if err != nil {
@@ -654,7 +728,7 @@ func (b *builder) addRelation(rel Relation) error {
 // time it is named. A relation with no schema belongs to the default one.
 func (b *builder) namespace(schema string) (int64, error) {
 	if schema == "" {
-		schema = "public"
+		schema = core.DefaultNamespace
 	}
 	if oid, ok := b.namespaces[schema]; ok {
 		return oid, nil
@@ -0,0 +1,44 @@
+package core
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/sqlc-dev/sqlc/internal/core/catalogdb"
+)
+
+// TriggerSpec describes a trigger on a relation. Timing and Events use the
+// letters sql_trigger documents.
+type TriggerSpec struct {
+	ClassOID   int64
+	Name       string
+	Timing     string
+	Events     string
+	ForEachRow bool
+}
+
+func (c *Catalog) CreateTrigger(s TriggerSpec) error {
+	err := c.q.CreateTrigger(context.Background(), catalogdb.CreateTriggerParams{
+		ClassOid:   s.ClassOID,
+		Name:       s.Name,
+		Timing:     s.Timing,
+		Events:     s.Events,
+		ForEachRow: boolToInt64(s.ForEachRow),
+	})
+	if err != nil {
+		return fmt.Errorf("create trigger %q on class %d: %w", s.Name, s.ClassOID, err)
+	}
+	return nil
+}
+
+// TriggerExists reports whether a relation has a trigger named name.
+func (c *Catalog) TriggerExists(classOID int64, name string) (bool, error) {
+	exists, err := c.q.TriggerExists(context.Background(), catalogdb.TriggerExistsParams{
+		ClassOid: classOID,
+		Name:     name,
+	})
+	if err != nil {
+		return false, fmt.Errorf("trigger %q on class %d: %w", name, classOID, err)
+	}
+	return exists, nil
+}
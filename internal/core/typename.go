@@ -9,7 +9,8 @@ import (
 
 // TypeNameString is the catalog's name for the type an AST node names: the type
 // as it was written, lowercased, with "[]" appended for an array. Engines
-// report a type either as a plain name or as a list of qualifying parts.
+// report a type either as a plain name or as a list of qualifying parts, and
+// parameterized types in the spelling NormalizeTypeName reads.
 func TypeNameString(tn *ast.TypeName) string {
 	if tn == nil {
 		return ""
@@ -26,7 +27,7 @@ func TypeNameString(tn *ast.TypeName) string {
 		}
 		name = strings.Join(parts, ".")
 	}
-	name = strings.ToLower(name)
+	name = NormalizeTypeName(strings.ToLower(name))
 	if name != "" && tn.ArrayBounds != nil && len(tn.ArrayBounds.Items) > 0 {
 		name += ArraySuffix
 	}
@@ -45,7 +46,12 @@ func (c *Catalog) ResolveType(tn *ast.TypeName) (int64, error) {
 
 // ResolveTypeName is ResolveType for a type already reduced to its name.
 func (c *Catalog) ResolveTypeName(name string) (int64, error) {
+	name = NormalizeTypeName(name)
 	if oid, err := c.TypeOID(name); err == nil {
+		// A domain stands for the type it is based on.
+		if t, err := c.LookupType(oid); err == nil && t.Typtype == "d" && t.ElementOID != 0 {
+			return t.ElementOID, nil
+		}
 		return oid, nil
 	}
 	if element, ok := strings.CutSuffix(name, ArraySuffix); ok {
@@ -55,5 +61,118 @@ func (c *Catalog) ResolveTypeName(name string) (int64, error) {
 		}
 		return c.CreateArrayType(name, elementOID)
 	}
+	if fields, ok := StructFields(name); ok {
+		return c.CreateStructType(fields)
+	}
 	return c.CreateUserType(name, "U")
 }
+
+// NormalizeTypeName rewrites the parameterized spellings of arrays and
+// structs, ARRAY<T> and STRUCT<name T, ...>, to the names the catalog gives
+// them: ARRAY<T> is T with "[]" appended, as every other array is, and a
+// struct's field types are normalized in turn. Other names are returned as
+// they are.
+func NormalizeTypeName(name string) string {
+	if element, ok := typeParameters(name, "array"); ok {
+		return NormalizeTypeName(element) + ArraySuffix
+	}
+	if fields, ok := StructFields(name); ok {
+		return StructTypeName(fields)
+	}
+	return name
+}
+
+// StructField is a field of a struct type. A field may have no name.
+type StructField struct {
+	Name    string
+	Type    string
+	NotNull bool
+}
+
+// StructTypeName is the catalog's name for the struct type of fields, e.g.
+// "struct<id int64, tags string[]>".
+func StructTypeName(fields []StructField) string {
+	var b strings.Builder
+	b.WriteString("struct<")
+	for i, f := range fields {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		if f.Name != "" {
+			b.WriteString(f.Name)
+			b.WriteByte(' ')
+		}
+		b.WriteString(f.Type)
+		if f.NotNull {
+			b.WriteString(" not null")
+		}
+	}
+	b.WriteByte('>')
+	return b.String()
+}
+
+// StructFields returns the fields of a struct type, named as StructTypeName
+// or the STRUCT<...> spelling names it, with their types normalized. It
+// reports false for a name that is not a struct's.
+func StructFields(name string) ([]StructField, bool) {
+	params, ok := typeParameters(name, "struct")
+	if !ok {
+		return nil, false
+	}
+	var fields []StructField
+	for _, param := range splitTypeParameters(params) {
+		var f StructField
+		if rest, ok := strings.CutSuffix(param, " not null"); ok {
+			param, f.NotNull = strings.TrimSpace(rest), true
+		}
+		// A field is "name type", or only a type. A type that is itself
+		// parameterized is told from a name by the bracket its first word
+		// opens.
+		name, typ, ok := strings.Cut(param, " ")
+		if !ok || strings.ContainsAny(name, "<(") {
+			name, typ = "", param
+		}
+		f.Name = name
+		f.Type = NormalizeTypeName(strings.TrimSpace(typ))
+		fields = append(fields, f)
+	}
+	return fields, true
+}
+
+// typeParameters returns what is between the angle brackets of a name
+// spelled kind<...>.
+func typeParameters(name, kind string) (string, bool) {
+	rest, ok := strings.CutPrefix(name, kind)
+	if !ok {
+		return "", false
+	}
+	rest = strings.TrimSpace(rest)
+	if !strings.HasPrefix(rest, "<") || !strings.HasSuffix(rest, ">") {
+		return "", false
+	}
+	return strings.TrimSpace(rest[1 : len(rest)-1]), true
+}
+
+// splitTypeParameters splits a list of type parameters at the commas that
+// are not nested in another type's brackets.
+func splitTypeParameters(s string) []string {
+	var out []string
+	depth, start := 0, 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '<', '(':
+			depth++
+		case '>', ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				out = append(out, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(s[start:]); last != "" {
+		out = append(out, last)
+	}
+	return out
+}
@@ -29,7 +29,7 @@ func (c *Catalog) CreateTypeSpec(t TypeSpec) (int64, error) {
 		t.Typtype = "b"
 	}
 	if t.NamespaceOID == 0 {
-		oid, err := c.NamespaceOID("public")
+		oid, err := c.NamespaceOID(DefaultNamespace)
 		if err != nil {
 			return 0, fmt.Errorf("create type %q: default namespace: %w", t.Name, err)
 		}
@@ -78,6 +78,19 @@ func (c *Catalog) CreateUserType(name, category string) (int64, error) {
 	return oid, nil
 }
 
+// CreateDomainType registers a type that is another under a name of its own,
+// such as a SQL Server alias type. ResolveTypeName resolves it to the type it
+// is based on.
+func (c *Catalog) CreateDomainType(namespaceOID int64, name string, baseOID int64) (int64, error) {
+	return c.CreateTypeSpec(TypeSpec{
+		Name:         name,
+		Typtype:      "d",
+		NamespaceOID: namespaceOID,
+		DialectOID:   c.dialectOID,
+		ElementOID:   baseOID,
+	})
+}
+
 // CreateArrayType registers the array type over elementOID.
 func (c *Catalog) CreateArrayType(name string, elementOID int64) (int64, error) {
 	oid, err := c.CreateTypeSpec(TypeSpec{
@@ -96,6 +109,30 @@ func (c *Catalog) CreateArrayType(name string, elementOID int64) (int64, error)
 	return oid, nil
 }
 
+// CreateStructType registers the struct type of fields, resolving the type
+// of every field first. Its name, which StructTypeName gives, is all there is
+// to know about it: StructFields reads the fields back from the name.
+func (c *Catalog) CreateStructType(fields []StructField) (int64, error) {
+	for _, f := range fields {
+		if _, err := c.ResolveTypeName(f.Type); err != nil {
+			return 0, err
+		}
+	}
+	oid, err := c.CreateTypeSpec(TypeSpec{
+		Name:       StructTypeName(fields),
+		Typtype:    "c",
+		Category:   "C",
+		DialectOID: c.dialectOID,
+	})
+	if err != nil {
+		return 0, err
+	}
+	if err := c.createComparisons(oid); err != nil {
+		return 0, err
+	}
+	return oid, nil
+}
+
 // createComparisons gives a type the dialect's comparison operators, which the
 // seed registered for the types it knew about up front.
 func (c *Catalog) createComparisons(typeOID int64) error {
@@ -157,12 +194,23 @@ func (c *Catalog) TypeName(oid int64) (string, error) {
 	return name, nil
 }
 
+// TypeSchema returns the namespace a type was declared in.
+func (c *Catalog) TypeSchema(oid int64) (string, error) {
+	name, err := c.q.TypeSchemaByOID(context.Background(), oid)
+	if err != nil {
+		return "", fmt.Errorf("type oid %d: %w", oid, err)
+	}
+	return name, nil
+}
+
 type TypeInfo struct {
 	OID       int64
 	Name      string
 	Category  string
 	Typtype   string
 	Preferred bool
+	// ElementOID is an array's element type, or the type a domain is based on.
+	ElementOID int64
 }
 
 func (c *Catalog) LookupType(oid int64) (TypeInfo, error) {
@@ -171,11 +219,12 @@ func (c *Catalog) LookupType(oid int64) (TypeInfo, error) {
 		return TypeInfo{}, fmt.Errorf("lookup type oid %d: %w", oid, err)
 	}
 	return TypeInfo{
-		OID:       row.Oid,
-		Name:      row.Name,
-		Category:  row.Category.String,
-		Typtype:   row.Typtype,
-		Preferred: row.Preferred != 0,
+		OID:        row.Oid,
+		Name:       row.Name,
+		Category:   row.Category.String,
+		Typtype:    row.Typtype,
+		Preferred:  row.Preferred != 0,
+		ElementOID: row.ElementOid.Int64,
 	}, nil
 }
 
@@ -141,10 +141,12 @@ func (m *ManagedClient) Close(ctx context.Context) {
 	m.cache.Close()
 }
 
-func NewClient(servers []config.Server) *ManagedClient {
+// NewClient returns a client for servers, whose URIs are expanded with the
+// variables in env.
+func NewClient(servers []config.Server, env []string) *ManagedClient {
 	return &ManagedClient{
 		cache:    poolcache.New(),
 		servers:  servers,
-		replacer: shfmt.NewReplacer(nil),
+		replacer: shfmt.NewReplacer(env),
 	}
 }
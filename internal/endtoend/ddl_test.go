@@ -30,7 +30,7 @@ func TestValidSchema(t *testing.T) {
 			t.Fatal(err)
 		}
 
-		conf, err := config.ParseConfig(rd)
+		conf, err := config.ParseConfigFrom(rd, replay.Path)
 		if err != nil {
 			t.Fatal(err)
 		}
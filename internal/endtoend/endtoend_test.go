@@ -316,11 +316,11 @@ func TestReplay(t *testing.T) {
 					}
 				case "vet":
 					err = cmd.Vet(ctx, path, "", &opts)
-				case "parse", "analyze":
-					// These commands are config-less and flag-driven. Run them
-					// through the real CLI entry point from inside the test
-					// directory so file arguments resolve and the output stays
-					// independent of the absolute path.
+				case "parse", "analyze", "fmt", "translate", "config", "report", "docs":
+					// These commands are flag-driven and print their results.
+					// Run them through the real CLI entry point from inside the
+					// test directory so file arguments resolve and the output
+					// stays independent of the absolute path.
 					var stdout bytes.Buffer
 					wd, werr := os.Getwd()
 					if werr != nil {
@@ -368,7 +368,7 @@ func cmpDirectory(t *testing.T, dir string, actual map[string]string) {
 		if file.IsDir() {
 			return nil
 		}
-		if !strings.HasSuffix(path, ".go") && !strings.HasSuffix(path, ".kt") && !strings.HasSuffix(path, ".py") && !strings.HasSuffix(path, ".json") && !strings.HasSuffix(path, ".txt") {
+		if !strings.HasSuffix(path, ".go") && !strings.HasSuffix(path, ".kt") && !strings.HasSuffix(path, ".py") && !strings.HasSuffix(path, ".json") && !strings.HasSuffix(path, ".txt") && !strings.HasSuffix(path, ".html") && !strings.HasSuffix(path, ".dot") && !strings.HasSuffix(path, ".proto") {
 			return nil
 		}
 		// TODO: Figure out a better way to ignore certain files
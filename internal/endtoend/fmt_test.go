@@ -42,7 +42,7 @@ func TestFormat(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
-			conf, err := config.ParseConfig(configFile)
+			conf, err := config.ParseConfigFrom(configFile, tc.Path)
 			configFile.Close()
 			if err != nil {
 				t.Fatal(err)
@@ -0,0 +1,8 @@
+-- name: NearbyStores :many
+SELECT id, name, st_distance(location, $1) AS distance
+FROM stores
+WHERE st_dwithin(location, $1, $2)
+ORDER BY distance;
+
+-- name: StoreHash :one
+SELECT acme_hash(name) FROM stores WHERE id = $1;
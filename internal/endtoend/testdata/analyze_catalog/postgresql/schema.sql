@@ -0,0 +1,5 @@
+CREATE TABLE stores (
+    id bigint PRIMARY KEY,
+    name text NOT NULL,
+    location geography NOT NULL
+);
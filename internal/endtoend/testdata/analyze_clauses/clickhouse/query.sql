@@ -0,0 +1,13 @@
+-- name: Sampled :many
+SELECT id, name FROM events FINAL SAMPLE 0.1
+PREWHERE created > ?
+WHERE user_id = ?
+LIMIT 2 BY user_id
+LIMIT ?
+SETTINGS max_threads = 8;
+
+-- name: Unfolded :many
+SELECT id, tags FROM events ARRAY JOIN tags WHERE tags = ?;
+
+-- name: Aliased :many
+SELECT id, tag2, i FROM events LEFT ARRAY JOIN tags AS tag2, arrayEnumerate(tags) AS i WHERE id > ?;
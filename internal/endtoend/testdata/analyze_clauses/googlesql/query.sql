@@ -0,0 +1,37 @@
+-- name: LatestPerCustomer :many
+SELECT id, customer, created
+FROM orders
+WHERE amount > 0
+QUALIFY ROW_NUMBER() OVER (PARTITION BY customer ORDER BY created DESC) = 1;
+
+-- name: StructFields :many
+SELECT address.city, o.address.zip, address FROM orders AS o;
+
+-- name: UnnestWithOffset :many
+SELECT o.id, tag, pos FROM orders AS o, UNNEST(o.tags) AS tag WITH OFFSET AS pos;
+
+-- name: UnnestStructs :many
+SELECT o.id, sku, qty FROM orders AS o, UNNEST(o.items);
+
+-- name: UnnestStructAlias :many
+SELECT i.sku, i.qty FROM orders AS o, UNNEST(o.items) AS i;
+
+-- name: Pivot :many
+SELECT * FROM (SELECT customer, quarter, amount FROM orders)
+PIVOT (SUM(amount) FOR quarter IN ('Q1', 'Q2' AS second));
+
+-- name: Unpivot :many
+SELECT * FROM sales UNPIVOT (sales FOR quarter IN (q1, q2, q3, q4));
+
+-- name: SelectAsStruct :many
+SELECT AS STRUCT id, customer AS name FROM orders;
+
+-- name: StructConstructors :one
+SELECT STRUCT(id, customer AS name) AS s, (1, 'a') AS pair,
+       STRUCT<x INT64, y STRING>(1, 'b') AS typed,
+       ARRAY(SELECT AS STRUCT id, customer FROM orders) AS rows_array
+FROM orders WHERE id = @id;
+
+-- name: InsertWithAction :one
+INSERT INTO sales (product, q1, q2) VALUES (@product, 1, 2)
+THEN RETURN WITH ACTION AS act product, q1;
@@ -0,0 +1,14 @@
+-- name: ListBigOrders :many
+SELECT order_id, email, total FROM sales.big_orders WHERE email = @email;
+
+-- name: CustomerByEmail :one
+SELECT id, name FROM sales.customers WHERE email = @email;
+
+-- name: NextOrderNumber :one
+SELECT NEXT VALUE FOR sales.order_numbers AS number;
+
+-- name: PlaceOrder :exec
+EXEC sales.place_order @customer_id = @customer, @lines = @lines, @order_id = @order_id OUTPUT;
+
+-- name: PlaceOrderPositional :exec
+EXEC sales.place_order @p1, @p2, @p3 OUTPUT;
@@ -0,0 +1,43 @@
+CREATE SCHEMA sales;
+GO
+
+CREATE TYPE dbo.email FROM nvarchar(256) NOT NULL;
+CREATE TYPE sales.order_lines AS TABLE (
+    product_id int NOT NULL,
+    qty        int NOT NULL,
+    note       nvarchar(100)
+);
+CREATE SEQUENCE sales.order_numbers AS int START WITH 1000 INCREMENT BY 1;
+
+CREATE TABLE sales.customers (
+    id    bigint IDENTITY(1, 1) PRIMARY KEY,
+    email dbo.email NOT NULL,
+    name  nvarchar(100) NULL
+);
+
+CREATE TABLE sales.orders (
+    id          bigint IDENTITY(1, 1) PRIMARY KEY,
+    number      int NOT NULL,
+    customer_id bigint NOT NULL,
+    total       money NULL
+);
+GO
+
+CREATE VIEW sales.big_orders (order_id, email, total) AS
+SELECT o.id, c.email, o.total
+FROM sales.orders o
+JOIN sales.customers c ON c.id = o.customer_id
+WHERE o.total > 100;
+GO
+
+CREATE PROCEDURE sales.place_order
+    @customer_id bigint,
+    @lines sales.order_lines READONLY,
+    @order_id bigint OUTPUT
+AS
+BEGIN
+    INSERT INTO sales.orders (number, customer_id)
+    VALUES (NEXT VALUE FOR sales.order_numbers, @customer_id);
+    SET @order_id = SCOPE_IDENTITY();
+END;
+GO
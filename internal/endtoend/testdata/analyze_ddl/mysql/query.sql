@@ -0,0 +1,23 @@
+-- name: CreateOrder :exec
+INSERT INTO orders VALUES (?, ?, ?, ?, ?);
+
+-- name: OrderTotal :one
+SELECT order_total(?) AS total;
+
+-- name: PlaceOrder :exec
+CALL place_order(?, ?, @id);
+
+-- name: RankOrders :many
+SELECT id, ROW_NUMBER() OVER (PARTITION BY customer ORDER BY total DESC) AS rn,
+       SUM(total) OVER w AS running
+FROM orders
+WINDOW w AS (ORDER BY id ROWS BETWEEN UNBOUNDED PRECEDING AND CURRENT ROW);
+
+-- name: OrderItems :many
+SELECT o.id, j.sku, j.qty
+FROM orders o, JSON_TABLE(o.items, '$[*]' COLUMNS (sku VARCHAR(64) PATH '$.sku', qty INT PATH '$.qty')) AS j
+WHERE o.id = ?;
+
+-- name: Recent :many
+WITH RECURSIVE seq (n) AS (SELECT 1 UNION ALL SELECT n + 1 FROM seq WHERE n < ?)
+SELECT n FROM seq;
@@ -0,0 +1,20 @@
+CREATE TABLE orders (
+  id BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  customer VARCHAR(255) NOT NULL,
+  price DECIMAL(10,2) NOT NULL,
+  quantity INT NOT NULL,
+  total DECIMAL(10,2) AS (price * quantity) STORED,
+  label VARCHAR(300) GENERATED ALWAYS AS (CONCAT(customer, '-', id)) VIRTUAL,
+  items JSON NOT NULL
+);
+
+CREATE PROCEDURE place_order(IN p_customer VARCHAR(255), IN p_price DECIMAL(10,2), OUT p_id BIGINT)
+BEGIN
+  INSERT INTO orders (customer, price, quantity, items) VALUES (p_customer, p_price, 1, '[]');
+  SET p_id = LAST_INSERT_ID();
+END;
+
+CREATE FUNCTION order_total(p_id BIGINT) RETURNS DECIMAL(10,2) DETERMINISTIC
+RETURN (SELECT total FROM orders WHERE id = p_id);
+
+CREATE TRIGGER orders_bi BEFORE INSERT ON orders FOR EACH ROW SET NEW.customer = TRIM(NEW.customer);
@@ -0,0 +1,11 @@
+-- name: CreateAccount :exec
+INSERT INTO accounts VALUES (?, ?, ?, ?, ?);
+
+-- name: ListSettings :many
+SELECT * FROM settings WHERE account_id = ?;
+
+-- name: CreateEvent :exec
+INSERT INTO events VALUES (?, ?);
+
+-- name: ListEvents :many
+SELECT id, kind, size FROM events;
@@ -0,0 +1,31 @@
+CREATE TABLE accounts (
+  id INTEGER PRIMARY KEY,
+  email TEXT NOT NULL,
+  balance INT NOT NULL CHECK (balance >= 0),
+  nickname ANY,
+  avatar BLOB
+) STRICT;
+
+CREATE TABLE settings (
+  account_id INTEGER NOT NULL,
+  name TEXT,
+  enabled BOOLEAN,
+  weight NUMERIC CHECK (typeof(weight) = 'real'),
+  PRIMARY KEY (account_id, name)
+) WITHOUT ROWID;
+
+CREATE TABLE events (
+  id INTEGER PRIMARY KEY,
+  payload TEXT NOT NULL,
+  kind TEXT GENERATED ALWAYS AS (json_extract(payload, '$.kind')) VIRTUAL,
+  size INT AS (length(payload)) STORED,
+  CHECK (length(payload) > 2)
+);
+
+CREATE UNIQUE INDEX accounts_email ON accounts (email);
+CREATE INDEX events_kind ON events (kind) WHERE kind IS NOT NULL;
+
+CREATE TRIGGER accounts_audit AFTER UPDATE OF balance ON accounts
+BEGIN
+  INSERT INTO events (payload) VALUES (json_object('kind', 'balance', 'id', NEW.id));
+END;
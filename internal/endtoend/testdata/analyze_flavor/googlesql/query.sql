@@ -0,0 +1,8 @@
+-- name: SearchDocuments :many
+SELECT id, title, SCORE(body_tokens, @query) AS relevance
+FROM documents
+WHERE SEARCH(body_tokens, @query);
+
+-- name: CreateDocument :one
+INSERT INTO documents (id, title, created) VALUES (GET_NEXT_SEQUENCE_VALUE(SEQUENCE doc_ids), @title, PENDING_COMMIT_TIMESTAMP())
+THEN RETURN id, created;
@@ -0,0 +1,8 @@
+CREATE TABLE documents (
+  id      INT64 NOT NULL,
+  title   STRING(MAX) NOT NULL,
+  body    STRING(MAX),
+  score   FLOAT32,
+  body_tokens TOKENLIST AS (TOKENIZE_FULLTEXT(body)),
+  created TIMESTAMP NOT NULL,
+) PRIMARY KEY (id);
@@ -0,0 +1,26 @@
+-- name: UserStats :many
+SELECT user_id,
+    count() AS events,
+    countIf(amount > ?) AS big,
+    sumIf(amount, name = ?) AS total,
+    uniqExact(name) AS names,
+    groupArray(name) AS all_names,
+    quantile(0.9)(amount) AS p90,
+    max(amount) AS top,
+    avgOrNull(amount) AS mean,
+    argMax(name, created) AS last_name
+FROM events
+GROUP BY user_id;
+
+-- name: Monthly :many
+SELECT toStartOfMonth(created) AS month, toYYYYMM(created) AS yyyymm, dateDiff('day', min(created), max(created)) AS days
+FROM events
+GROUP BY month;
+
+-- name: Arrays :many
+SELECT id, length(tags) AS n, has(tags, ?) AS tagged, arraySort(tags) AS sorted, arrayEnumerate(tags) AS positions
+FROM events;
+
+-- name: Strings :many
+SELECT lower(tag) AS lowered, ifNull(tag, name) AS label, if(amount > ?, name, 'small') AS size, toString(id) AS key
+FROM events;
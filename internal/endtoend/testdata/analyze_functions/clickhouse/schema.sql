@@ -0,0 +1,9 @@
+CREATE TABLE events (
+    id UInt64,
+    user_id UInt32,
+    name String,
+    tag Nullable(String),
+    tags Array(String),
+    amount Float64,
+    created DateTime
+) ENGINE = MergeTree ORDER BY id;
@@ -0,0 +1,44 @@
+-- name: Aggregates :one
+SELECT COUNT(*) AS n, COUNTIF(price > 10) AS expensive, SUM(amount) AS total,
+       AVG(price) AS avg_price, MAX(created) AS latest,
+       ARRAY_AGG(customer) AS customers, STRING_AGG(customer, ', ') AS names,
+       LOGICAL_AND(price > 0) AS all_positive
+FROM orders;
+
+-- name: Strings :many
+SELECT UPPER(customer) AS upper_name, LENGTH(customer) AS len,
+       SUBSTR(customer, 1, 3) AS prefix, SPLIT(customer, ' ') AS words,
+       REGEXP_CONTAINS(customer, r'^A') AS starts_a, CONCAT(customer, '-', region) AS label,
+       IFNULL(region, 'none') AS region_name, IF(price > 10, 'high', 'low') AS band
+FROM orders
+WHERE STARTS_WITH(customer, @prefix);
+
+-- name: Dates :many
+SELECT DATE(created) AS day, DATE_DIFF(shipped, DATE(created), DAY) AS days,
+       TIMESTAMP_TRUNC(created, HOUR) AS hour, FORMAT_TIMESTAMP('%F', created) AS formatted,
+       UNIX_SECONDS(created) AS epoch, EXTRACT(YEAR FROM created) AS year,
+       TIMESTAMP_ADD(created, INTERVAL 1 DAY) AS tomorrow
+FROM orders
+WHERE created > @since;
+
+-- name: Arrays :many
+SELECT ARRAY_LENGTH(tags) AS tag_count, tags[OFFSET(0)] AS first_tag,
+       ARRAY_TO_STRING(tags, ',') AS tag_list, GENERATE_ARRAY(1, 3) AS nums,
+       [1, 2, 3] AS literal, ARRAY(SELECT t FROM UNNEST(tags) AS t) AS copied
+FROM orders;
+
+-- name: Json :many
+SELECT JSON_VALUE(payload, '$.name') AS name, JSON_QUERY(payload, '$.items') AS items,
+       TO_JSON_STRING(address) AS address_json
+FROM orders;
+
+-- name: Window :many
+SELECT id, ROW_NUMBER() OVER (PARTITION BY customer ORDER BY created) AS rn,
+       LAG(amount) OVER (ORDER BY created) AS previous
+FROM orders;
+
+-- name: Math :one
+SELECT ROUND(price, 2) AS rounded, SAFE_DIVIDE(price, 3.0) AS third,
+       MOD(id, 10) AS bucket, FARM_FINGERPRINT(customer) AS fp,
+       GREATEST(id, 5) AS at_least_five
+FROM orders WHERE id = @id;
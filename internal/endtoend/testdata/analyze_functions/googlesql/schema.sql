@@ -0,0 +1,22 @@
+CREATE TABLE orders (
+  id         INT64 NOT NULL,
+  customer   STRING NOT NULL,
+  region     STRING,
+  quarter    STRING NOT NULL,
+  amount     NUMERIC NOT NULL,
+  price      FLOAT64,
+  tags       ARRAY<STRING>,
+  address    STRUCT<street STRING, city STRING, zip INT64>,
+  items      ARRAY<STRUCT<sku STRING, qty INT64>>,
+  payload    JSON,
+  created    TIMESTAMP NOT NULL,
+  shipped    DATE,
+) PRIMARY KEY (id);
+
+CREATE TABLE sales (
+  product STRING NOT NULL,
+  q1      INT64 NOT NULL,
+  q2      INT64 NOT NULL,
+  q3      INT64,
+  q4      INT64,
+) PRIMARY KEY (product);
@@ -0,0 +1,102 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const insertAuthors = `-- name: InsertAuthors :bulkexec
+INSERT INTO authors (name, bio) VALUES /*BULK*/(?, ?)/*BULK*/
+`
+
+type InsertAuthorsParams struct {
+	Name string
+	Bio  string
+}
+
+func (q *Queries) InsertAuthors(ctx context.Context, arg []InsertAuthorsParams) (int64, error) {
+	var rowsAffected int64
+	for start := 0; start < len(arg); start += 32767 {
+		chunk := arg[start:]
+		if len(chunk) > 32767 {
+			chunk = chunk[:32767]
+		}
+		queryParams := make([]any, 0, len(chunk)*2)
+		for _, a := range chunk {
+			queryParams = append(queryParams, a.Name, a.Bio)
+		}
+		query := bulkQuery(insertAuthors, len(chunk), 2)
+		result, err := q.db.ExecContext(ctx, query, queryParams...)
+		if err != nil {
+			return rowsAffected, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return rowsAffected, err
+		}
+		rowsAffected += n
+	}
+	return rowsAffected, nil
+}
+
+const insertAuthorsWithDefault = `-- name: InsertAuthorsWithDefault :bulkexec
+INSERT INTO authors (name, bio) VALUES /*BULK*/(?, 'n/a')/*BULK*/
+`
+
+func (q *Queries) InsertAuthorsWithDefault(ctx context.Context, name []string) (int64, error) {
+	var rowsAffected int64
+	for start := 0; start < len(name); start += 65535 {
+		chunk := name[start:]
+		if len(chunk) > 65535 {
+			chunk = chunk[:65535]
+		}
+		queryParams := make([]any, 0, len(chunk)*1)
+		for _, a := range chunk {
+			queryParams = append(queryParams, a)
+		}
+		query := bulkQuery(insertAuthorsWithDefault, len(chunk), 1)
+		result, err := q.db.ExecContext(ctx, query, queryParams...)
+		if err != nil {
+			return rowsAffected, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return rowsAffected, err
+		}
+		rowsAffected += n
+	}
+	return rowsAffected, nil
+}
+
+const insertTags = `-- name: InsertTags :bulkexec
+INSERT IGNORE INTO tags (name) VALUES /*BULK*/(?)/*BULK*/
+`
+
+func (q *Queries) InsertTags(ctx context.Context, name []string) (int64, error) {
+	var rowsAffected int64
+	for start := 0; start < len(name); start += 65535 {
+		chunk := name[start:]
+		if len(chunk) > 65535 {
+			chunk = chunk[:65535]
+		}
+		queryParams := make([]any, 0, len(chunk)*1)
+		for _, a := range chunk {
+			queryParams = append(queryParams, a)
+		}
+		query := bulkQuery(insertTags, len(chunk), 1)
+		result, err := q.db.ExecContext(ctx, query, queryParams...)
+		if err != nil {
+			return rowsAffected, err
+		}
+		n, err := result.RowsAffected()
+		if err != nil {
+			return rowsAffected, err
+		}
+		rowsAffected += n
+	}
+	return rowsAffected, nil
+}
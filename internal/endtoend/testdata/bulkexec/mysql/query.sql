@@ -0,0 +1,8 @@
+-- name: InsertAuthors :bulkexec
+INSERT INTO authors (name, bio) VALUES (?, ?);
+
+-- name: InsertAuthorsWithDefault :bulkexec
+INSERT INTO authors (name, bio) VALUES (sqlc.arg(name), 'n/a');
+
+-- name: InsertTags :bulkexec
+INSERT IGNORE INTO tags (name) VALUES (?);
@@ -0,0 +1,9 @@
+CREATE TABLE authors (
+  id   BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name VARCHAR(255) NOT NULL,
+  bio  TEXT NOT NULL
+);
+
+CREATE TABLE tags (
+  name VARCHAR(255) NOT NULL PRIMARY KEY
+);
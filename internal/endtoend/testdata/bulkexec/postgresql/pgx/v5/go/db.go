@@ -0,0 +1,62 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+
+package querytest
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+type DBTX interface {
+	Exec(context.Context, string, ...any) (pgconn.CommandTag, error)
+	Query(context.Context, string, ...any) (pgx.Rows, error)
+	QueryRow(context.Context, string, ...any) pgx.Row
+}
+
+func New() *Queries {
+	return &Queries{}
+}
+
+type Queries struct {
+}
+
+// bulkQuery repeats the VALUES row of a :bulkexec query, which sqlc brackets
+// with /*BULK*/ comments, once per record. The numbered placeholders of each
+// copy are shifted past those of the copies before it.
+func bulkQuery(query string, records, width int) string {
+	before, rest, _ := strings.Cut(query, "/*BULK*/")
+	row, after, _ := strings.Cut(rest, "/*BULK*/")
+	var b strings.Builder
+	b.WriteString(before)
+	for r := 0; r < records; r++ {
+		if r > 0 {
+			b.WriteString(", ")
+		}
+		for i := 0; i < len(row); i++ {
+			c := row[i]
+			b.WriteByte(c)
+			switch {
+			case c == '\'':
+				end := strings.IndexByte(row[i+1:], '\'') + i + 1
+				b.WriteString(row[i+1 : end+1])
+				i = end
+			case (c == '$' || c == '?') && i+1 < len(row) && row[i+1] >= '0' && row[i+1] <= '9':
+				end := i + 1
+				for end < len(row) && row[end] >= '0' && row[end] <= '9' {
+					end++
+				}
+				n, _ := strconv.Atoi(row[i+1 : end])
+				b.WriteString(strconv.Itoa(n + r*width))
+				i = end - 1
+			}
+		}
+	}
+	b.WriteString(after)
+	return b.String()
+}
@@ -0,0 +1,17 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+
+package querytest
+
+import (
+	"context"
+)
+
+type Querier interface {
+	InsertAuthors(ctx context.Context, db DBTX, arg []InsertAuthorsParams) (int64, error)
+	InsertAuthorsReturning(ctx context.Context, db DBTX, arg []InsertAuthorsReturningParams) ([]InsertAuthorsReturningRow, error)
+	InsertTags(ctx context.Context, db DBTX, name []string) (int64, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,113 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+)
+
+const insertAuthors = `-- name: InsertAuthors :bulkexec
+INSERT INTO authors (name, bio) VALUES /*BULK*/($1, $2)/*BULK*/
+`
+
+type InsertAuthorsParams struct {
+	Name string
+	Bio  string
+}
+
+func (q *Queries) InsertAuthors(ctx context.Context, db DBTX, arg []InsertAuthorsParams) (int64, error) {
+	var rowsAffected int64
+	for start := 0; start < len(arg); start += 32767 {
+		chunk := arg[start:]
+		if len(chunk) > 32767 {
+			chunk = chunk[:32767]
+		}
+		queryParams := make([]any, 0, len(chunk)*2)
+		for _, a := range chunk {
+			queryParams = append(queryParams, a.Name, a.Bio)
+		}
+		query := bulkQuery(insertAuthors, len(chunk), 2)
+		result, err := db.Exec(ctx, query, queryParams...)
+		if err != nil {
+			return rowsAffected, err
+		}
+		rowsAffected += result.RowsAffected()
+	}
+	return rowsAffected, nil
+}
+
+const insertAuthorsReturning = `-- name: InsertAuthorsReturning :bulkexec
+INSERT INTO authors (name, bio) VALUES /*BULK*/($1, coalesce($2, 'n/a'))/*BULK*/
+RETURNING id, name
+`
+
+type InsertAuthorsReturningParams struct {
+	Name string
+	Bio  any
+}
+
+type InsertAuthorsReturningRow struct {
+	ID   int64
+	Name string
+}
+
+func (q *Queries) InsertAuthorsReturning(ctx context.Context, db DBTX, arg []InsertAuthorsReturningParams) ([]InsertAuthorsReturningRow, error) {
+	var items []InsertAuthorsReturningRow
+	for start := 0; start < len(arg); start += 32767 {
+		chunk := arg[start:]
+		if len(chunk) > 32767 {
+			chunk = chunk[:32767]
+		}
+		queryParams := make([]any, 0, len(chunk)*2)
+		for _, a := range chunk {
+			queryParams = append(queryParams, a.Name, a.Bio)
+		}
+		query := bulkQuery(insertAuthorsReturning, len(chunk), 2)
+		rows, err := db.Query(ctx, query, queryParams...)
+		if err != nil {
+			return nil, err
+		}
+		for rows.Next() {
+			var i InsertAuthorsReturningRow
+			if err := rows.Scan(&i.ID, &i.Name); err != nil {
+				rows.Close()
+				return nil, err
+			}
+			items = append(items, i)
+		}
+		rows.Close()
+		if err := rows.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return items, nil
+}
+
+const insertTags = `-- name: InsertTags :bulkexec
+INSERT INTO tags (name) VALUES /*BULK*/($1)/*BULK*/
+ON CONFLICT DO NOTHING
+`
+
+func (q *Queries) InsertTags(ctx context.Context, db DBTX, name []string) (int64, error) {
+	var rowsAffected int64
+	for start := 0; start < len(name); start += 65535 {
+		chunk := name[start:]
+		if len(chunk) > 65535 {
+			chunk = chunk[:65535]
+		}
+		queryParams := make([]any, 0, len(chunk)*1)
+		for _, a := range chunk {
+			queryParams = append(queryParams, a)
+		}
+		query := bulkQuery(insertTags, len(chunk), 1)
+		result, err := db.Exec(ctx, query, queryParams...)
+		if err != nil {
+			return rowsAffected, err
+		}
+		rowsAffected += result.RowsAffected()
+	}
+	return rowsAffected, nil
+}
@@ -0,0 +1,10 @@
+-- name: InsertAuthors :bulkexec
+INSERT INTO authors (name, bio) VALUES ($1, $2);
+
+-- name: InsertAuthorsReturning :bulkexec
+INSERT INTO authors (name, bio) VALUES (sqlc.arg(name), coalesce(sqlc.narg(bio), 'n/a'))
+RETURNING id, name;
+
+-- name: InsertTags :bulkexec
+INSERT INTO tags (name) VALUES ($1)
+ON CONFLICT DO NOTHING;
@@ -0,0 +1,9 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL,
+  bio  TEXT NOT NULL
+);
+
+CREATE TABLE tags (
+  name TEXT PRIMARY KEY
+);
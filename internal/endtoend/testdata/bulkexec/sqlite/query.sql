@@ -0,0 +1,10 @@
+-- name: InsertAuthors :bulkexec
+INSERT INTO authors (name, bio) VALUES (?, ?);
+
+-- name: InsertAuthorsReturning :bulkexec
+INSERT INTO authors (name, bio) VALUES (@name, coalesce(sqlc.narg(bio), 'n/a'))
+RETURNING id, name;
+
+-- name: InsertTags :bulkexec
+INSERT INTO tags (name) VALUES (?)
+ON CONFLICT DO NOTHING;
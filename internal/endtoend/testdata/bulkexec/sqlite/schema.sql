@@ -0,0 +1,9 @@
+CREATE TABLE authors (
+  id   INTEGER NOT NULL PRIMARY KEY,
+  name TEXT NOT NULL,
+  bio  TEXT NOT NULL
+);
+
+CREATE TABLE tags (
+  name TEXT NOT NULL PRIMARY KEY
+);
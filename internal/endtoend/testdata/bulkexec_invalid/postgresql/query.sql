@@ -0,0 +1,18 @@
+-- name: SelectAuthors :bulkexec
+SELECT * FROM authors WHERE name = $1;
+
+-- name: InsertTwoRows :bulkexec
+INSERT INTO authors (name, bio) VALUES ($1, $2), ($3, $4);
+
+-- name: InsertSelect :bulkexec
+INSERT INTO authors (name, bio) SELECT name, bio FROM authors WHERE id = $1;
+
+-- name: InsertNoParams :bulkexec
+INSERT INTO authors (name, bio) VALUES ('a', 'b');
+
+-- name: UpsertOutsideRow :bulkexec
+INSERT INTO authors (id, name, bio) VALUES ($1, $2, $3)
+ON CONFLICT (id) DO UPDATE SET bio = $4;
+
+-- name: InsertQuotedIdent :bulkexec
+INSERT INTO authors (name, bio) VALUES ($1, $2 || "name");
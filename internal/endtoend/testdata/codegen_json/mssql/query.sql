@@ -0,0 +1,2 @@
+-- name: PlaceOrder :exec
+EXEC sales.place_order @customer_id = @customer_id, @lines = @lines;
@@ -0,0 +1,18 @@
+CREATE SCHEMA sales;
+GO
+
+CREATE TYPE sales.order_lines AS TABLE (
+    product_id int NOT NULL,
+    qty        int NOT NULL,
+    note       nvarchar(100)
+);
+GO
+
+CREATE PROCEDURE sales.place_order
+    @customer_id bigint,
+    @lines sales.order_lines READONLY
+AS
+BEGIN
+    SELECT @customer_id;
+END;
+GO
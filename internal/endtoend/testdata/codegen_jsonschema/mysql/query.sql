@@ -0,0 +1,2 @@
+-- name: ListAuthors :many
+SELECT id, name FROM authors WHERE status = ? AND rating > ?;
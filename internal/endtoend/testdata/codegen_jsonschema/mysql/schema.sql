@@ -0,0 +1,7 @@
+CREATE TABLE authors (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name VARCHAR(255) NOT NULL COMMENT 'Full name',
+  rating DOUBLE,
+  born DATETIME,
+  status ENUM('open', 'closed') NOT NULL
+);
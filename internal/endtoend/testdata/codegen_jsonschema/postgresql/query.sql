@@ -0,0 +1,9 @@
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+
+-- name: ListBooks :many
+-- Books by the given authors.
+SELECT sqlc.embed(books), authors.status FROM books JOIN authors ON authors.id = books.author_id WHERE authors.id = ANY(sqlc.slice(ids)::bigint[]);
+
+-- name: UpdateStatus :exec
+UPDATE authors SET status = $1, bio = $2 WHERE id = $3;
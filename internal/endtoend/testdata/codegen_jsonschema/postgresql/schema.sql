@@ -0,0 +1,22 @@
+CREATE TYPE status AS ENUM ('open', 'closed');
+
+CREATE TABLE authors (
+  id BIGSERIAL PRIMARY KEY,
+  name varchar(100) NOT NULL,
+  bio text,
+  born date,
+  tags text[] NOT NULL,
+  grid integer[][],
+  data jsonb,
+  avatar bytea,
+  status status NOT NULL
+);
+COMMENT ON TABLE authors IS 'Authors of books.';
+COMMENT ON COLUMN authors.name IS 'Full name';
+
+CREATE TABLE books (
+  id uuid PRIMARY KEY,
+  author_id bigint NOT NULL REFERENCES authors(id),
+  price numeric(10,2),
+  published timestamptz NOT NULL
+);
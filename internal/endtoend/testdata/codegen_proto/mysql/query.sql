@@ -0,0 +1,11 @@
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = ?;
+
+-- name: ListAuthorNames :many
+SELECT id, name FROM authors WHERE status = sqlc.arg(status);
+
+-- name: CreateAuthor :execlastid
+INSERT INTO authors (name, bio, status) VALUES (?, ?, ?);
+
+-- name: DeleteAuthors :execresult
+DELETE FROM authors WHERE id IN (sqlc.slice(ids));
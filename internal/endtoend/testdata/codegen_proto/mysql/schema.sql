@@ -0,0 +1,9 @@
+CREATE TABLE authors (
+  id BIGINT UNSIGNED NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name VARCHAR(255) NOT NULL COMMENT 'Full name',
+  bio TEXT,
+  rating DOUBLE,
+  born DATETIME,
+  status ENUM('open', 'closed') NOT NULL,
+  avatar BLOB
+) COMMENT 'Authors of books.';
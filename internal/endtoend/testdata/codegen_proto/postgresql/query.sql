@@ -0,0 +1,24 @@
+-- name: GetAuthor :one
+-- Returns one author.
+SELECT * FROM authors WHERE id = $1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors ORDER BY name;
+
+-- name: ListBookTitles :many
+SELECT b.title, a.name, sqlc.embed(a) FROM books b JOIN authors a ON a.id = b.author_id WHERE a.id = ANY(sqlc.slice(ids)::bigint[]) ;
+
+-- name: CreateAuthor :one
+INSERT INTO authors (name, bio, tags) VALUES ($1, $2, $3) RETURNING id;
+
+-- name: DeleteAuthor :execrows
+DELETE FROM authors WHERE id = $1;
+
+-- name: UpdateStatus :exec
+UPDATE authors SET status = $1 WHERE id = $2;
+
+-- name: CopyBooks :copyfrom
+INSERT INTO books (author_id, title) VALUES ($1, $2);
+
+-- name: CountBooks :one
+SELECT count(*) FROM books WHERE price > @min_price;
@@ -0,0 +1,22 @@
+CREATE TYPE status AS ENUM ('open', 'closed', 'in-progress');
+
+-- Authors of books.
+CREATE TABLE authors (
+  id BIGSERIAL PRIMARY KEY,
+  name text NOT NULL,
+  bio text,
+  born date,
+  tags text[] NOT NULL,
+  data jsonb,
+  status status
+);
+COMMENT ON TABLE authors IS 'Authors of books.';
+COMMENT ON COLUMN authors.name IS 'Full name';
+
+CREATE TABLE books (
+  id serial PRIMARY KEY,
+  author_id bigint NOT NULL REFERENCES authors(id),
+  title text NOT NULL,
+  price numeric(10,2),
+  published timestamptz NOT NULL DEFAULT now()
+);
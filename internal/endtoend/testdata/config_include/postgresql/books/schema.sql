@@ -0,0 +1,4 @@
+CREATE TABLE books (
+  id    BIGSERIAL PRIMARY KEY,
+  title text NOT NULL
+);
@@ -0,0 +1,3 @@
+-- name: ListBooks :many
+SELECT * FROM books
+ORDER BY title;
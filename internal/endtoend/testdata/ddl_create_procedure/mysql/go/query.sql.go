@@ -9,6 +9,15 @@ import (
 	"context"
 )
 
+const callCountData = `-- name: CallCountData :exec
+CALL count_data(?, @total)
+`
+
+func (q *Queries) CallCountData(ctx context.Context, minValue int32) error {
+	_, err := q.db.ExecContext(ctx, callCountData, minValue)
+	return err
+}
+
 const callInsertData = `-- name: CallInsertData :exec
 CALL insert_data(?, ?)
 `
@@ -45,3 +54,14 @@ func (q *Queries) CallInsertDataSqlcArgs(ctx context.Context, arg CallInsertData
 	_, err := q.db.ExecContext(ctx, callInsertDataSqlcArgs, arg.Foo, arg.Bar)
 	return err
 }
+
+const doubleValue = `-- name: DoubleValue :one
+SELECT double_value(?) AS doubled
+`
+
+func (q *Queries) DoubleValue(ctx context.Context, v int32) (int32, error) {
+	row := q.db.QueryRowContext(ctx, doubleValue, v)
+	var doubled int32
+	err := row.Scan(&doubled)
+	return doubled, err
+}
@@ -6,3 +6,9 @@ CALL insert_data(1, 2);
 
 -- name: CallInsertDataSqlcArgs :exec
 CALL insert_data(sqlc.arg('foo'), sqlc.arg('bar'));
+
+-- name: CallCountData :exec
+CALL count_data(?, @total);
+
+-- name: DoubleValue :one
+SELECT double_value(?) AS doubled;
@@ -6,4 +6,12 @@ CREATE PROCEDURE insert_data(a int, b int)
 BEGIN
     INSERT INTO tbl VALUES (a);
     INSERT INTO tbl VALUES (b);
-END;
\ No newline at end of file
+END;
+
+CREATE PROCEDURE count_data(IN min_value int, OUT total int)
+BEGIN
+    SELECT COUNT(*) INTO total FROM tbl WHERE value >= min_value;
+END;
+
+CREATE FUNCTION double_value(v int) RETURNS int DETERMINISTIC
+RETURN v * 2;
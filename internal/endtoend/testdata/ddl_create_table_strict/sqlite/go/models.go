@@ -8,6 +8,14 @@ import (
 	"database/sql"
 )
 
+type Ticket struct {
+	ID        int64
+	VenueName string
+	Price     float64
+	Seat      any
+	Barcode   []byte
+}
+
 type Venue struct {
 	Name sql.NullString
 }
@@ -9,6 +9,23 @@ import (
 	"context"
 )
 
+const getTicket = `-- name: GetTicket :one
+SELECT id, venue_name, price, seat, barcode FROM tickets WHERE id = ?
+`
+
+func (q *Queries) GetTicket(ctx context.Context, id int64) (Ticket, error) {
+	row := q.db.QueryRowContext(ctx, getTicket, id)
+	var i Ticket
+	err := row.Scan(
+		&i.ID,
+		&i.VenueName,
+		&i.Price,
+		&i.Seat,
+		&i.Barcode,
+	)
+	return i, err
+}
+
 const placeholder = `-- name: Placeholder :exec
 SELECT 1
 `
@@ -1,2 +1,5 @@
 -- name: Placeholder :exec
 SELECT 1;
+
+-- name: GetTicket :one
+SELECT id, venue_name, price, seat, barcode FROM tickets WHERE id = ?;
@@ -1 +1,9 @@
 CREATE TABLE venues (name text) STRICT;
+
+CREATE TABLE tickets (
+    id INT PRIMARY KEY,
+    venue_name TEXT NOT NULL,
+    price REAL NOT NULL,
+    seat ANY,
+    barcode BLOB
+) STRICT;
@@ -13,3 +13,9 @@ type Arena struct {
 	Location sql.NullString
 	Size     int64
 }
+
+type Booking struct {
+	ArenaName string
+	Day       string
+	Team      string
+}
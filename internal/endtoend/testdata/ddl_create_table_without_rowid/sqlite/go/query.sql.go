@@ -9,6 +9,33 @@ import (
 	"context"
 )
 
+const listBookings = `-- name: ListBookings :many
+SELECT arena_name, day, team FROM bookings WHERE arena_name = ?
+`
+
+func (q *Queries) ListBookings(ctx context.Context, arenaName string) ([]Booking, error) {
+	rows, err := q.db.QueryContext(ctx, listBookings, arenaName)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Booking
+	for rows.Next() {
+		var i Booking
+		if err := rows.Scan(&i.ArenaName, &i.Day, &i.Team); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const placeholder = `-- name: Placeholder :exec
 SELECT 1
 `
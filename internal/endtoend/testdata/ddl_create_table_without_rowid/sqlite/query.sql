@@ -1,2 +1,5 @@
 -- name: Placeholder :exec
 SELECT 1;
+
+-- name: ListBookings :many
+SELECT arena_name, day, team FROM bookings WHERE arena_name = ?;
@@ -1 +1,8 @@
-CREATE TABLE IF NOT EXISTS arenas (name text PRIMARY KEY, location text, size int NOT NULL) WITHOUT ROWID;
\ No newline at end of file
+CREATE TABLE IF NOT EXISTS arenas (name text PRIMARY KEY, location text, size int NOT NULL) WITHOUT ROWID;
+
+CREATE TABLE bookings (
+    arena_name text,
+    day text,
+    team text NOT NULL,
+    PRIMARY KEY (arena_name, day)
+) WITHOUT ROWID;
@@ -0,0 +1,8 @@
+-- name: CreateProduct :exec
+INSERT INTO products (price, quantity) VALUES (?, ?);
+
+-- name: ListProducts :many
+SELECT id, total, label FROM products;
+
+-- name: CreateProductRow :exec
+INSERT INTO products VALUES (?, ?, ?);
@@ -0,0 +1,5 @@
+-- name: CreateProduct :exec
+INSERT INTO products (price, quantity, total) VALUES (?, ?, ?);
+
+-- name: CreateProductRow :exec
+INSERT INTO products VALUES (?, ?, ?, ?, ?);
@@ -0,0 +1,8 @@
+CREATE TABLE products (
+    id INTEGER PRIMARY KEY,
+    price REAL NOT NULL,
+    quantity INTEGER NOT NULL,
+    total REAL GENERATED ALWAYS AS (price * quantity) STORED,
+    label TEXT AS ('#' || id) VIRTUAL,
+    CHECK (quantity >= 0)
+);
@@ -0,0 +1,12 @@
+-- name: GetAuthor :one
+SELECT * FROM authors WHERE id = $1;
+
+-- name: CountBooks :one
+SELECT count(*) FROM books;
+
+-- name: ListBooksByAuthor :many
+WITH b AS (SELECT * FROM books)
+SELECT b.title, a.name FROM b JOIN authors a ON a.id = b.author_id;
+
+-- name: DeleteDetails :exec
+DELETE FROM book_details WHERE book_id = $1;
@@ -0,0 +1,2 @@
+-- name: GetB :one
+SELECT * FROM b WHERE id = ?;
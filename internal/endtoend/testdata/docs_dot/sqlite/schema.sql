@@ -0,0 +1,2 @@
+CREATE TABLE a (id INTEGER PRIMARY KEY, name TEXT NOT NULL DEFAULT 'x');
+CREATE TABLE b (id INTEGER PRIMARY KEY, a_id INTEGER REFERENCES a(id), created TEXT DEFAULT (datetime('now')), FOREIGN KEY (id) REFERENCES a);
@@ -0,0 +1,12 @@
+CREATE TABLE authors (
+  id   BIGINT PRIMARY KEY AUTO_INCREMENT,
+  name varchar(255) NOT NULL DEFAULT 'anon' COMMENT 'Pen name',
+  status ENUM('active', 'retired') NOT NULL DEFAULT 'active',
+  created_at datetime NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE TABLE books (
+  id BIGINT PRIMARY KEY,
+  author_id BIGINT NOT NULL,
+  price decimal(10,2) DEFAULT 9.99,
+  CONSTRAINT fk_author FOREIGN KEY (author_id) REFERENCES authors (id)
+);
@@ -0,0 +1,76 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: batch.go
+
+package querytest
+
+import (
+	"context"
+	"errors"
+
+	"github.com/jackc/pgx/v5"
+)
+
+var (
+	ErrBatchAlreadyClosed = errors.New("batch already closed")
+)
+
+const getAuthorsByName = `-- name: GetAuthorsByName :batchmany
+SELECT id, name, bio FROM authors
+WHERE name = $1
+`
+
+type GetAuthorsByNameBatchResults struct {
+	br     pgx.BatchResults
+	tot    int
+	closed bool
+}
+
+func (q *Queries) GetAuthorsByName(ctx context.Context, name []string) *GetAuthorsByNameBatchResults {
+	batch := &pgx.Batch{}
+	for _, a := range name {
+		vals := []any{
+			a,
+		}
+		batch.Queue(getAuthorsByName, vals...)
+	}
+	br := q.db.SendBatch(ctx, batch)
+	return &GetAuthorsByNameBatchResults{br, len(name), false}
+}
+
+func (b *GetAuthorsByNameBatchResults) Query(f func(int, []Author, error)) {
+	defer b.br.Close()
+	for t := 0; t < b.tot; t++ {
+		var items []Author
+		if b.closed {
+			if f != nil {
+				f(t, items, ErrBatchAlreadyClosed)
+			}
+			continue
+		}
+		err := func() error {
+			rows, err := b.br.Query()
+			if err != nil {
+				return err
+			}
+			defer rows.Close()
+			for rows.Next() {
+				var i Author
+				if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+					return err
+				}
+				items = append(items, i)
+			}
+			return rows.Err()
+		}()
+		if f != nil {
+			f(t, items, err)
+		}
+	}
+}
+
+func (b *GetAuthorsByNameBatchResults) Close() error {
+	b.closed = true
+	return b.br.Close()
+}
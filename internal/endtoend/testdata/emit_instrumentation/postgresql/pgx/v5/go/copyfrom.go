@@ -0,0 +1,43 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: copyfrom.go
+
+package querytest
+
+import (
+	"context"
+)
+
+// iteratorForCopyAuthors implements pgx.CopyFromSource.
+type iteratorForCopyAuthors struct {
+	rows                 []CopyAuthorsParams
+	skippedFirstNextCall bool
+}
+
+func (r *iteratorForCopyAuthors) Next() bool {
+	if len(r.rows) == 0 {
+		return false
+	}
+	if !r.skippedFirstNextCall {
+		r.skippedFirstNextCall = true
+		return true
+	}
+	r.rows = r.rows[1:]
+	return len(r.rows) > 0
+}
+
+func (r iteratorForCopyAuthors) Values() ([]any, error) {
+	return []any{
+		r.rows[0].Name,
+		r.rows[0].Bio,
+	}, nil
+}
+
+func (r iteratorForCopyAuthors) Err() error {
+	return nil
+}
+
+func (q *Queries) CopyAuthors(ctx context.Context, arg []CopyAuthorsParams) (int64, error) {
+	return q.db.CopyFrom(ctx, []string{"authors"}, []string{"name", "bio"}, &iteratorForCopyAuthors{rows: arg})
+}
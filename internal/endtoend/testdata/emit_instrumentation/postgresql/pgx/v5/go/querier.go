@@ -0,0 +1,25 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+
+package querytest
+
+import (
+	"context"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgtype"
+)
+
+type Querier interface {
+	CopyAuthors(ctx context.Context, arg []CopyAuthorsParams) (int64, error)
+	CreateAuthor(ctx context.Context, arg CreateAuthorParams) (Author, error)
+	DeleteAllAuthors(ctx context.Context) (pgconn.CommandTag, error)
+	DeleteAuthor(ctx context.Context, id int64) error
+	GetAuthor(ctx context.Context, id int64) (Author, error)
+	GetAuthorsByName(ctx context.Context, name []string) *GetAuthorsByNameBatchResults
+	ListAuthors(ctx context.Context) ([]Author, error)
+	UpdateBios(ctx context.Context, bio pgtype.Text) (int64, error)
+}
+
+var _ Querier = (*Queries)(nil)
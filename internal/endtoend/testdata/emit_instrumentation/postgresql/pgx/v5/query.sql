@@ -0,0 +1,29 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: CreateAuthor :one
+INSERT INTO authors (name, bio)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = $1;
+
+-- name: UpdateBios :execrows
+UPDATE authors SET bio = $1;
+
+-- name: DeleteAllAuthors :execresult
+DELETE FROM authors;
+
+-- name: CopyAuthors :copyfrom
+INSERT INTO authors (name, bio) VALUES ($1, $2);
+
+-- name: GetAuthorsByName :batchmany
+SELECT * FROM authors
+WHERE name = $1;
@@ -0,0 +1,21 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+type Querier interface {
+	CreateAuthor(ctx context.Context, db DBTX, arg CreateAuthorParams) (Author, error)
+	DeleteAllAuthors(ctx context.Context, db DBTX) (sql.Result, error)
+	DeleteAuthor(ctx context.Context, db DBTX, id int64) error
+	GetAuthor(ctx context.Context, db DBTX, id int64) (Author, error)
+	ListAuthors(ctx context.Context, db DBTX) ([]Author, error)
+	UpdateBios(ctx context.Context, db DBTX, bio sql.NullString) (int64, error)
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,16 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+
+package querytest
+
+import (
+	"context"
+)
+
+type Querier interface {
+	CreateAuthor(ctx context.Context, arg CreateAuthorParams) (int64, error)
+	GetAuthor(ctx context.Context, id int64) (Author, error)
+}
+
+var _ Querier = (*Queries)(nil)
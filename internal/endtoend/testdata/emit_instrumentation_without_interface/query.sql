@@ -0,0 +1,7 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = ? LIMIT 1;
+
+-- name: CreateAuthor :execlastid
+INSERT INTO authors (name, bio)
+VALUES (?, ?);
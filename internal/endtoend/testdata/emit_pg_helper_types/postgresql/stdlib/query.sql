@@ -0,0 +1,20 @@
+-- name: GetBooking :one
+SELECT * FROM bookings WHERE id = $1;
+
+-- name: ListBookingsOverlapping :many
+SELECT id, stay FROM bookings WHERE stay && $1;
+
+-- name: CreateBooking :exec
+INSERT INTO bookings (seats, stay, closed_days) VALUES ($1, $2, $3);
+
+-- name: GetPlace :one
+SELECT * FROM places WHERE id = $1;
+
+-- name: MovePlace :exec
+UPDATE places SET location = $1, reach = $2 WHERE id = $3;
+
+-- name: SearchDocuments :many
+SELECT id, to_tsvector(body) AS vector, search FROM documents WHERE search @@ to_tsquery($1);
+
+-- name: SetDocumentSearch :exec
+UPDATE documents SET search = $1, pattern = $2 WHERE id = $3;
@@ -0,0 +1,29 @@
+CREATE TABLE bookings (
+    id          BIGSERIAL PRIMARY KEY,
+    seats       int4range NOT NULL,
+    guests      int8range,
+    price       numrange,
+    stay        daterange NOT NULL,
+    slot        tsrange,
+    slot_tz     tstzrange,
+    closed_days datemultirange,
+    busy        tstzmultirange
+);
+
+CREATE TABLE places (
+    id       BIGSERIAL PRIMARY KEY,
+    location point NOT NULL,
+    road     line,
+    segment  lseg,
+    bounds   box,
+    route    path,
+    area     polygon,
+    reach    circle
+);
+
+CREATE TABLE documents (
+    id      BIGSERIAL PRIMARY KEY,
+    body    text NOT NULL,
+    search  tsvector,
+    pattern tsquery
+);
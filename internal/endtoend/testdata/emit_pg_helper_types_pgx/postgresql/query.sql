@@ -0,0 +1,2 @@
+-- name: GetPlace :one
+SELECT * FROM places WHERE id = $1;
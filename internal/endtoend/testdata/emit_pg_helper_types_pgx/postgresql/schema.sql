@@ -0,0 +1,4 @@
+CREATE TABLE places (
+    id       BIGSERIAL PRIMARY KEY,
+    location point NOT NULL
+);
@@ -0,0 +1,25 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = ? LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: GetAuthorForUpdate :one
+SELECT * FROM authors
+WHERE id = ?
+FOR UPDATE;
+
+-- name: LockAuthorShared :one
+SELECT * FROM authors
+WHERE id = ?
+FOR SHARE SKIP LOCKED;
+
+-- name: CreateAuthor :execlastid
+INSERT INTO authors (name, bio)
+VALUES (?, ?);
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = ?;
@@ -0,0 +1,24 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+
+package querytest
+
+import (
+	"context"
+)
+
+type Querier interface {
+	ArchiveAuthor(ctx context.Context, id int64) (ArchiveAuthorRow, error)
+	CreateAuthor(ctx context.Context, arg CreateAuthorParams) (Author, error)
+	DeleteAuthor(ctx context.Context, id int64) error
+	GetAuthor(ctx context.Context, id int64) (Author, error)
+	GetAuthorForUpdate(ctx context.Context, id int64) (Author, error)
+	ListAuthors(ctx context.Context) ([]Author, error)
+	// @readwrite
+	NextAuthorID(ctx context.Context) (int64, error)
+	// @readwrite
+	RecordView(ctx context.Context, dollar_1 string) error
+}
+
+var _ Querier = (*Queries)(nil)
@@ -0,0 +1,35 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors
+ORDER BY name;
+
+-- name: GetAuthorForUpdate :one
+SELECT * FROM authors
+WHERE id = $1
+FOR UPDATE;
+
+-- name: CreateAuthor :one
+INSERT INTO authors (name, bio)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = $1;
+
+-- name: ArchiveAuthor :one
+WITH deleted AS (
+  DELETE FROM authors WHERE id = $1 RETURNING *
+)
+SELECT * FROM deleted;
+
+-- name: NextAuthorID :one
+-- @readwrite
+SELECT nextval('authors_id_seq')::bigint;
+
+-- name: RecordView :exec
+-- @readwrite
+SELECT pg_notify('author_views', $1::text);
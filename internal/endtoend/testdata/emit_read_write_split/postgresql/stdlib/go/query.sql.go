@@ -0,0 +1,134 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+// source: query.sql
+
+package querytest
+
+import (
+	"context"
+	"database/sql"
+)
+
+const archiveAuthor = `-- name: ArchiveAuthor :one
+WITH deleted AS (
+  DELETE FROM authors WHERE id = $1 RETURNING id, name, bio
+)
+SELECT id, name, bio FROM deleted
+`
+
+type ArchiveAuthorRow struct {
+	ID   int64
+	Name string
+	Bio  sql.NullString
+}
+
+func (q *Queries) ArchiveAuthor(ctx context.Context, id int64) (ArchiveAuthorRow, error) {
+	row := q.writer.QueryRowContext(ctx, archiveAuthor, id)
+	var i ArchiveAuthorRow
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const createAuthor = `-- name: CreateAuthor :one
+INSERT INTO authors (name, bio)
+VALUES ($1, $2)
+RETURNING id, name, bio
+`
+
+type CreateAuthorParams struct {
+	Name string
+	Bio  sql.NullString
+}
+
+func (q *Queries) CreateAuthor(ctx context.Context, arg CreateAuthorParams) (Author, error) {
+	row := q.writer.QueryRowContext(ctx, createAuthor, arg.Name, arg.Bio)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const deleteAuthor = `-- name: DeleteAuthor :exec
+DELETE FROM authors
+WHERE id = $1
+`
+
+func (q *Queries) DeleteAuthor(ctx context.Context, id int64) error {
+	_, err := q.writer.ExecContext(ctx, deleteAuthor, id)
+	return err
+}
+
+const getAuthor = `-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = $1 LIMIT 1
+`
+
+func (q *Queries) GetAuthor(ctx context.Context, id int64) (Author, error) {
+	row := q.reader.QueryRowContext(ctx, getAuthor, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const getAuthorForUpdate = `-- name: GetAuthorForUpdate :one
+SELECT id, name, bio FROM authors
+WHERE id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetAuthorForUpdate(ctx context.Context, id int64) (Author, error) {
+	row := q.writer.QueryRowContext(ctx, getAuthorForUpdate, id)
+	var i Author
+	err := row.Scan(&i.ID, &i.Name, &i.Bio)
+	return i, err
+}
+
+const listAuthors = `-- name: ListAuthors :many
+SELECT id, name, bio FROM authors
+ORDER BY name
+`
+
+func (q *Queries) ListAuthors(ctx context.Context) ([]Author, error) {
+	rows, err := q.reader.QueryContext(ctx, listAuthors)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []Author
+	for rows.Next() {
+		var i Author
+		if err := rows.Scan(&i.ID, &i.Name, &i.Bio); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const nextAuthorID = `-- name: NextAuthorID :one
+SELECT nextval('authors_id_seq')::bigint
+`
+
+// @readwrite
+func (q *Queries) NextAuthorID(ctx context.Context) (int64, error) {
+	row := q.writer.QueryRowContext(ctx, nextAuthorID)
+	var column_1 int64
+	err := row.Scan(&column_1)
+	return column_1, err
+}
+
+const recordView = `-- name: RecordView :exec
+SELECT pg_notify('author_views', $1::text)
+`
+
+// @readwrite
+func (q *Queries) RecordView(ctx context.Context, dollar_1 string) error {
+	_, err := q.writer.ExecContext(ctx, recordView, dollar_1)
+	return err
+}
@@ -0,0 +1,10 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL,
+  bio  text
+);
+
+CREATE TABLE author_views (
+  author_id BIGINT NOT NULL REFERENCES authors (id),
+  viewed_at TIMESTAMP NOT NULL DEFAULT now()
+);
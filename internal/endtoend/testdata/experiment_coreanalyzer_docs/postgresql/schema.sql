@@ -0,0 +1,25 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL,
+  bio  text,
+  created_at timestamptz NOT NULL DEFAULT now()
+);
+
+COMMENT ON TABLE authors IS 'People who write books';
+COMMENT ON COLUMN authors.bio IS 'A short | biography';
+
+CREATE TABLE books (
+  id        BIGSERIAL PRIMARY KEY,
+  author_id bigint NOT NULL REFERENCES authors (id),
+  editor_id bigint REFERENCES authors,
+  isbn      text UNIQUE,
+  title     text NOT NULL DEFAULT 'Untitled',
+  status    text NOT NULL DEFAULT 'draft',
+  tags      text[] NOT NULL DEFAULT '{}'
+);
+
+CREATE TABLE book_details (
+  book_id bigint PRIMARY KEY,
+  pages   integer NOT NULL DEFAULT 0,
+  CONSTRAINT book_details_book_fk FOREIGN KEY (book_id) REFERENCES books (id)
+);
@@ -0,0 +1,57 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+
+package querytest
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/sqlc-dev/pqtype"
+)
+
+type Audited struct {
+	CreatedBy  string
+	ModifiedAt sql.NullTime
+}
+
+type Document struct {
+	CreatedBy  string
+	ModifiedAt sql.NullTime
+	ID         int64
+	Title      string
+}
+
+type Event struct {
+	ID        int64
+	Kind      string
+	Payload   pqtype.NullRawMessage
+	CreatedAt time.Time
+	Source    sql.NullString
+}
+
+type Events2024 struct {
+	ID        int64
+	Kind      string
+	Payload   pqtype.NullRawMessage
+	CreatedAt time.Time
+	Source    sql.NullString
+}
+
+type Events2025 struct {
+	ID        int64
+	Kind      string
+	Payload   json.RawMessage
+	CreatedAt time.Time
+	Source    sql.NullString
+}
+
+type EventsArchive struct {
+	ID        int64
+	Kind      string
+	Payload   pqtype.NullRawMessage
+	CreatedAt time.Time
+	Source    sql.NullString
+}
@@ -0,0 +1,20 @@
+-- name: ListEvents2025 :many
+SELECT * FROM events_2025 WHERE kind = $1;
+
+-- name: ListArchivedEvents :many
+SELECT id, kind, source FROM events_archive;
+
+-- name: InsertEvent :exec
+INSERT INTO events (id, kind, payload, created_at, source) VALUES ($1, $2, $3, $4, $5);
+
+-- name: ListDocuments :many
+SELECT id, title, created_by, modified_at FROM documents;
+
+-- name: EventCounts :many
+SELECT kind, total FROM event_counts($1) ORDER BY total DESC;
+
+-- name: EventWindow :one
+SELECT first_id, last_id FROM event_window($1);
+
+-- name: RecentDocuments :many
+SELECT d.id, d.title FROM recent_documents($1) AS d;
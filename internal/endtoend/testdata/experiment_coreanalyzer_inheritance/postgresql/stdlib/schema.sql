@@ -0,0 +1,50 @@
+CREATE TABLE events (
+    id         bigint NOT NULL,
+    kind       text NOT NULL,
+    payload    jsonb,
+    created_at timestamptz NOT NULL
+) PARTITION BY RANGE (created_at);
+
+CREATE TABLE events_2024 PARTITION OF events
+    FOR VALUES FROM ('2024-01-01') TO ('2025-01-01');
+
+CREATE TABLE events_2025 PARTITION OF events (payload NOT NULL)
+    FOR VALUES FROM ('2025-01-01') TO ('2026-01-01');
+
+CREATE TABLE events_archive (
+    id         bigint NOT NULL,
+    kind       text NOT NULL,
+    payload    jsonb,
+    created_at timestamptz NOT NULL
+);
+
+ALTER TABLE events ATTACH PARTITION events_archive
+    FOR VALUES FROM (MINVALUE) TO ('2024-01-01');
+
+ALTER TABLE events ADD COLUMN source text;
+
+CREATE TABLE audited (
+    created_by text NOT NULL,
+    updated_at timestamptz
+);
+
+CREATE TABLE documents (
+    id    bigserial PRIMARY KEY,
+    title text NOT NULL
+) INHERITS (audited);
+
+ALTER TABLE audited RENAME COLUMN updated_at TO modified_at;
+
+CREATE FUNCTION event_counts(since timestamptz)
+    RETURNS TABLE (kind text, total bigint)
+    AS $$ SELECT kind, count(*) FROM events WHERE created_at >= since GROUP BY kind $$
+    LANGUAGE sql;
+
+CREATE FUNCTION event_window(since timestamptz, OUT first_id bigint, OUT last_id bigint)
+    AS $$ SELECT min(id), max(id) FROM events WHERE created_at >= since $$
+    LANGUAGE sql;
+
+CREATE FUNCTION recent_documents(n int)
+    RETURNS SETOF documents
+    AS $$ SELECT * FROM documents ORDER BY id DESC LIMIT n $$
+    LANGUAGE sql;
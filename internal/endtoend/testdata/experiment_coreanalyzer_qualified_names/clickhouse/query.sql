@@ -0,0 +1,7 @@
+-- name: GetEvent :one
+SELECT * FROM analytics.events
+WHERE id = ?;
+
+-- name: ListEvents :many
+SELECT analytics.events.name, score FROM analytics.events
+ORDER BY name;
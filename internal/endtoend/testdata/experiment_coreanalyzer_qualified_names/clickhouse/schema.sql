@@ -0,0 +1,5 @@
+CREATE TABLE events (
+    id    UInt64,
+    name  String,
+    score Int32
+) ENGINE = MergeTree ORDER BY id;
@@ -0,0 +1,10 @@
+-- name: GetOrder :one
+SELECT * FROM shop.sales.orders
+WHERE id = @id;
+
+-- name: ListOrders :many
+SELECT shop.sales.orders.id, total FROM shop.sales.orders
+ORDER BY id;
+
+-- name: ListOrderTotals :many
+SELECT o.total FROM sales.orders AS o;
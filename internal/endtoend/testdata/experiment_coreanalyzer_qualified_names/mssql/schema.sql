@@ -0,0 +1,6 @@
+CREATE SCHEMA sales;
+GO
+CREATE TABLE sales.orders (
+    id    bigint NOT NULL PRIMARY KEY,
+    total int NOT NULL
+);
@@ -0,0 +1,7 @@
+-- name: GetProduct :one
+SELECT * FROM shop.products
+WHERE id = ?;
+
+-- name: ListProducts :many
+SELECT shop.products.name, price FROM shop.products
+ORDER BY name;
@@ -0,0 +1,5 @@
+CREATE TABLE products (
+    id    bigint PRIMARY KEY,
+    name  varchar(255) NOT NULL,
+    price int NOT NULL
+);
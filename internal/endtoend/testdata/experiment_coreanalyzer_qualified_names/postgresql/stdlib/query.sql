@@ -0,0 +1,19 @@
+-- name: ListAuthors :many
+SELECT id, name FROM authors;
+
+-- name: ListRetiredAuthors :many
+SELECT id, name, retired FROM public.authors
+WHERE retired;
+
+-- name: GetAuthor :one
+SELECT main.app.authors.name FROM main.app.authors
+WHERE main.app.authors.id = $1;
+
+-- name: ListAuditLog :many
+SELECT app.authors.name, audit_log.action
+FROM audit_log
+JOIN app.authors ON app.authors.id = audit_log.author_id;
+
+-- name: GetAuthorByName :one
+SELECT * FROM authors
+WHERE name = $1;
@@ -0,0 +1,19 @@
+CREATE SCHEMA app;
+
+-- Created in app, the first schema on the search path.
+CREATE TABLE authors (
+    id   bigserial PRIMARY KEY,
+    name text NOT NULL
+);
+
+CREATE TABLE public.authors (
+    id      bigserial PRIMARY KEY,
+    name    text NOT NULL,
+    retired boolean NOT NULL
+);
+
+CREATE TABLE public.audit_log (
+    id        bigserial PRIMARY KEY,
+    author_id bigint NOT NULL,
+    action    text NOT NULL
+);
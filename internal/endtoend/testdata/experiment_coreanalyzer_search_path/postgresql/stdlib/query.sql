@@ -0,0 +1,25 @@
+-- name: GetUser :one
+SELECT * FROM users
+WHERE id = $1;
+
+-- name: ListUserOrders :many
+SELECT orders.id, orders.total, users.email
+FROM orders
+JOIN users ON users.id = orders.user_id
+WHERE orders.user_id = $1;
+
+-- name: CreateOrder :one
+INSERT INTO orders (user_id, total)
+VALUES ($1, $2)
+RETURNING *;
+
+-- name: GetSetting :one
+SELECT value FROM settings
+WHERE key = $1;
+
+-- name: ListAudit :many
+SELECT note FROM public.audit_log;
+
+-- name: ListDeletedUsers :many
+SELECT id FROM public.users
+WHERE deleted;
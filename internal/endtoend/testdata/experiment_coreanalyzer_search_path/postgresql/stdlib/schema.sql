@@ -0,0 +1,34 @@
+CREATE SCHEMA app;
+
+CREATE TABLE settings (
+    key   text PRIMARY KEY,
+    value text NOT NULL
+);
+
+SET search_path TO app, public;
+
+CREATE TABLE users (
+    id    bigserial PRIMARY KEY,
+    email text NOT NULL
+);
+
+CREATE TABLE orders (
+    id      bigserial PRIMARY KEY,
+    user_id bigint NOT NULL REFERENCES users (id),
+    total   numeric NOT NULL
+);
+
+SET search_path = DEFAULT;
+
+CREATE TABLE audit_log (
+    id   bigserial PRIMARY KEY,
+    note text NOT NULL
+);
+
+SET search_path TO app, public;
+
+-- An unqualified name finds the first schema on the path that has it.
+CREATE TABLE public.users (
+    id      bigserial PRIMARY KEY,
+    deleted boolean NOT NULL
+);
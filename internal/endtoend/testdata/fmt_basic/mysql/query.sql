@@ -0,0 +1,12 @@
+-- name: GetAuthor :one
+select * from authors where id = ? limit 1;
+
+-- name: ListAuthors :many
+select id, name from authors order by name;
+
+-- name: CreateAuthor :execresult
+insert into authors (name, bio) values (sqlc.arg(name), sqlc.narg(bio));
+
+-- name: DeleteAuthor :exec
+delete from authors where id = ? # the author to remove
+;
@@ -0,0 +1,26 @@
+-- name: GetAuthor :one
+select * from authors where id = $1 limit 1;
+
+-- name: ListAuthors :many
+-- Authors with a biography, by name.
+select a.id, a.name from authors a where a.bio is not null and a.name like @pattern order by a.name;
+
+-- name: CreateAuthor :one
+insert into authors (name, bio) values (sqlc.arg(name), sqlc.narg(bio)) returning *;
+
+-- name: UpdateAuthorBio :exec
+update authors set bio = $2 where id = $1;
+
+-- name: DeleteAuthor :exec
+delete from authors
+where id = $1 -- the author to remove
+;
+
+-- name: AuthorsWithBooks :many
+with counted as (select author_id, count(*) as books from books group by author_id) select authors.name, counted.books from authors join counted on counted.author_id = authors.id where counted.books > 1;
+
+-- name: ListTaggedAuthors :many
+select id, /* the display name */ name
+from authors
+where bio like '%--%' -- bios that mention a dash
+order by name;
@@ -0,0 +1,7 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text      NOT NULL CHECK (name <> ''),
+  bio  text
+);
+
+create function author_count() returns bigint as $$ select count(*) from authors $$ language sql;
@@ -0,0 +1,8 @@
+-- name: GetAuthor :one
+select * from authors where id = ? limit 1;
+
+-- name: ListAuthors :many
+select id, name from authors where name like @pattern order by name;
+
+-- name: UpdateAuthorBio :exec
+update authors set bio = ? where id = ?;
@@ -0,0 +1,12 @@
+-- name: GetAuthor :one
+SELECT *
+FROM authors
+WHERE id = $1
+LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT * FROM authors ORDER BY name;
+
+-- name: CountAuthors :one
+select count(*) -- every author, retired or not
+from authors;
@@ -0,0 +1,13 @@
+CREATE TABLE authors (
+  id   BIGINT NOT NULL AUTO_INCREMENT PRIMARY KEY,
+  name VARCHAR(255) NOT NULL UNIQUE,
+  bio  TEXT
+);
+
+CREATE TABLE posts (
+  id         BIGINT NOT NULL AUTO_INCREMENT,
+  author_id  BIGINT NOT NULL,
+  title      VARCHAR(255) NOT NULL,
+  created_at DATETIME NOT NULL,
+  PRIMARY KEY (id)
+);
@@ -0,0 +1,17 @@
+// Code generated by sqlc. DO NOT EDIT.
+// versions:
+//   sqlc v1.31.1
+
+package querytest
+
+import (
+	"context"
+)
+
+type Querier interface {
+	ListAuthorNames(ctx context.Context, db DBTX, cursor string, limit int32) ([]string, string, error)
+	ListAuthors(ctx context.Context, db DBTX, cursor string, limit int32) ([]Author, string, error)
+	ListPostsByAuthor(ctx context.Context, db DBTX, authorID int64, cursor string, limit int32) ([]ListPostsByAuthorRow, string, error)
+}
+
+var _ Querier = (*Queries)(nil)
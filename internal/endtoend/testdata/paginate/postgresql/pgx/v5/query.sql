@@ -0,0 +1,13 @@
+-- name: ListAuthors :paginate
+SELECT * FROM authors
+ORDER BY id;
+
+-- name: ListAuthorNames :paginate
+SELECT name FROM authors
+ORDER BY name;
+
+-- name: ListPostsByAuthor :paginate
+SELECT p.id, p.title, p.created_at
+FROM posts p
+WHERE p.author_id = $1
+ORDER BY p.created_at DESC, p.id DESC;
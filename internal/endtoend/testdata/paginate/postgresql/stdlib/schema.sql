@@ -0,0 +1,12 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name TEXT NOT NULL UNIQUE,
+  bio  TEXT
+);
+
+CREATE TABLE posts (
+  id         BIGSERIAL PRIMARY KEY,
+  author_id  BIGINT NOT NULL REFERENCES authors (id),
+  title      TEXT NOT NULL,
+  created_at TIMESTAMPTZ NOT NULL
+);
@@ -0,0 +1,12 @@
+CREATE TABLE authors (
+  id   INTEGER NOT NULL PRIMARY KEY,
+  name TEXT NOT NULL UNIQUE,
+  bio  TEXT
+);
+
+CREATE TABLE posts (
+  id         INTEGER NOT NULL PRIMARY KEY,
+  author_id  INTEGER NOT NULL REFERENCES authors (id),
+  title      TEXT NOT NULL,
+  created_at DATETIME NOT NULL
+);
@@ -0,0 +1,26 @@
+-- name: ListNoOrder :paginate
+SELECT * FROM authors;
+
+-- name: ListWithLimit :paginate
+SELECT * FROM authors ORDER BY id LIMIT 10;
+
+-- name: ListNotUnique :paginate
+SELECT * FROM posts ORDER BY created_at;
+
+-- name: ListNullable :paginate
+SELECT * FROM authors ORDER BY bio, id;
+
+-- name: ListMixed :paginate
+SELECT * FROM posts ORDER BY created_at DESC, id;
+
+-- name: ListMissing :paginate
+SELECT title FROM posts ORDER BY id;
+
+-- name: ListExpression :paginate
+SELECT * FROM authors ORDER BY lower(name);
+
+-- name: ListJoinOneSide :paginate
+SELECT a.id, a.name, p.title FROM authors a JOIN posts p ON p.author_id = a.id ORDER BY a.id;
+
+-- name: ListDuplicateNames :paginate
+SELECT p.id, a.id, a.name FROM posts p JOIN authors a ON a.id = p.author_id ORDER BY p.id, a.id;
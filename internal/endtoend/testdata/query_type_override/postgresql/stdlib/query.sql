@@ -0,0 +1,20 @@
+-- name: GetUser :one
+-- @param id github.com/acme/ids.UserID
+-- @column id github.com/acme/ids.UserID
+-- @column deleted_at not null
+SELECT id, name, deleted_at FROM users
+WHERE id = $1;
+
+-- name: ListUsers :many
+-- @param org_id github.com/acme/ids.OrgID
+-- @column created_at nullable
+SELECT id, created_at FROM users
+WHERE org_id = $1 AND name = $2;
+
+-- name: CountUsers :one
+-- @column count int
+SELECT count(*) FROM users;
+
+-- name: RenameUser :exec
+-- @param name text nullable
+UPDATE users SET name = $1 WHERE id = $2;
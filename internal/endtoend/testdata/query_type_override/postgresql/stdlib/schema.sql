@@ -0,0 +1,7 @@
+CREATE TABLE users (
+    id         BIGSERIAL PRIMARY KEY,
+    org_id     BIGINT NOT NULL,
+    name       TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMP
+);
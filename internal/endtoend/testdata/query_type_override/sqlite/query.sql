@@ -0,0 +1,10 @@
+-- name: GetUser :one
+-- @param id github.com/acme/ids.UserID
+-- @column id github.com/acme/ids.UserID
+SELECT id, name FROM users
+WHERE id = ?;
+
+-- name: ListDeleted :many
+-- @column deleted_at not null
+SELECT id, deleted_at FROM users
+WHERE deleted_at IS NOT NULL;
@@ -0,0 +1,7 @@
+-- name: GetUser :one
+-- @param user_id github.com/acme/ids.UserID
+SELECT id FROM users WHERE id = $1;
+
+-- name: ListUsers :many
+-- @column created_at text bigint
+SELECT created_at FROM users;
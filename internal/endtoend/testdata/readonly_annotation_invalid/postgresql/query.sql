@@ -0,0 +1,10 @@
+-- name: DeleteAuthor :exec
+-- @readonly
+DELETE FROM authors
+WHERE id = $1;
+
+-- name: GetAuthor :one
+-- @readonly
+-- @readwrite
+SELECT * FROM authors
+WHERE id = $1;
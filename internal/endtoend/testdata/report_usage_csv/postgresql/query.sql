@@ -0,0 +1,16 @@
+-- name: GetAuthor :one
+SELECT id, name, bio FROM authors
+WHERE id = $1 LIMIT 1;
+
+-- name: ListBooksByAuthor :many
+SELECT b.title FROM books b
+JOIN authors a ON a.id = b.author_id
+WHERE a.name = $1
+ORDER BY b.title;
+
+-- name: CreateBook :one
+INSERT INTO books (author_id, title) VALUES ($1, $2)
+RETURNING *;
+
+-- name: LogAction :exec
+INSERT INTO audit_log (action) VALUES ($1);
@@ -0,0 +1,20 @@
+CREATE TABLE authors (
+  id   BIGSERIAL PRIMARY KEY,
+  name text NOT NULL,
+  bio  text,
+  legacy_code text
+);
+CREATE TABLE books (
+  id        BIGSERIAL PRIMARY KEY,
+  author_id bigint NOT NULL REFERENCES authors (id),
+  title     text NOT NULL,
+  isbn      text
+);
+CREATE TABLE audit_log (
+  id      BIGSERIAL PRIMARY KEY,
+  action  text NOT NULL,
+  at      timestamptz NOT NULL DEFAULT now()
+);
+CREATE TABLE old_stuff (
+  id int
+);
@@ -0,0 +1,23 @@
+-- name: GetAccount :one
+SELECT id, email FROM accounts WHERE id = $1;
+
+-- name: GetPasswordHash :one
+SELECT password_hash FROM accounts WHERE email = $1;
+
+-- name: UpdateEmail :exec
+UPDATE accounts SET email = $2 WHERE id = $1;
+
+-- name: MoveAccount :exec
+UPDATE accounts SET tenant_id = $2 WHERE id = $1;
+
+-- name: DeleteAccount :exec
+DELETE FROM accounts WHERE id = $1;
+
+-- name: LogMessage :exec
+INSERT INTO audit_log (account_id, message) VALUES ($1, $2);
+
+-- name: ListAuditLog :many
+SELECT id, message FROM audit_log;
+
+-- name: ListPlans :many
+SELECT id, name FROM plans;
@@ -0,0 +1,20 @@
+-- name: GetUser :one
+SELECT * FROM users
+WHERE id = $1;
+
+-- name: GetUserEmail :one
+SELECT u.email FROM users u
+WHERE u.id = $1;
+
+-- name: CreateUser :one
+INSERT INTO users (email)
+VALUES ($1)
+RETURNING *;
+
+-- name: UpdateUserEmail :exec
+UPDATE users SET email = $1
+WHERE id = $2;
+
+-- name: GetSetting :one
+SELECT value FROM settings
+WHERE key = $1;
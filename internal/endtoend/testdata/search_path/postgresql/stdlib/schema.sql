@@ -0,0 +1,11 @@
+CREATE SCHEMA app;
+
+CREATE TABLE users (
+    id    bigserial PRIMARY KEY,
+    email text NOT NULL
+);
+
+CREATE TABLE public.settings (
+    key   text PRIMARY KEY,
+    value text NOT NULL
+);
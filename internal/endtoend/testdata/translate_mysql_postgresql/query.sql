@@ -0,0 +1,18 @@
+# Queries for the authors table.
+
+-- name: GetAuthor :one
+SELECT * FROM `authors`
+WHERE `id` = ? LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT id, IFNULL(bio, "no bio") AS bio, CHAR_LENGTH(name) AS name_length
+FROM authors
+WHERE name LIKE 'O\'%'
+ORDER BY name
+LIMIT ?, ?;
+
+-- name: CreateAuthor :execlastid
+INSERT INTO authors (name, bio) VALUES (sqlc.arg(name), sqlc.narg(bio));
+
+-- name: CountAuthors :one
+SELECT CAST(COUNT(*) AS char) FROM authors WHERE created_at > NOW();
@@ -0,0 +1,11 @@
+CREATE TABLE `authors` (
+  `id` bigint unsigned NOT NULL AUTO_INCREMENT,
+  `name` varchar(255) NOT NULL,
+  `bio` text,
+  `active` tinyint(1) NOT NULL DEFAULT 1,
+  `rating` double,
+  `avatar` blob,
+  `created_at` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  PRIMARY KEY (`id`),
+  UNIQUE KEY `authors_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4;
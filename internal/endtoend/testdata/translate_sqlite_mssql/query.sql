@@ -0,0 +1,16 @@
+-- name: GetAuthor :one
+SELECT * FROM authors
+WHERE id = ?1 LIMIT 1;
+
+-- name: ListAuthors :many
+SELECT id, ifnull(bio, '') AS bio, length(name) AS name_length
+FROM authors
+WHERE name = @name OR bio = @name
+ORDER BY name
+LIMIT ? OFFSET ?;
+
+-- name: TopAuthors :many
+SELECT "name" FROM authors WHERE score > 0 LIMIT 10;
+
+-- name: DeleteAuthor :exec
+DELETE FROM authors WHERE id = ?;
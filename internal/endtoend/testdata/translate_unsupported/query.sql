@@ -0,0 +1,7 @@
+-- name: ListAuthors :many
+SELECT id, date_trunc('day', created_at) FROM authors
+WHERE name ILIKE $1;
+
+-- name: CreateAuthor :one
+INSERT INTO authors (name) VALUES ($1)
+RETURNING id;
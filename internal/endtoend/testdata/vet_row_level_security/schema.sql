@@ -0,0 +1,33 @@
+CREATE TABLE accounts (
+    id bigserial PRIMARY KEY,
+    tenant_id bigint NOT NULL,
+    email text NOT NULL,
+    password_hash text NOT NULL
+);
+
+CREATE TABLE audit_log (
+    id bigserial PRIMARY KEY,
+    account_id bigint NOT NULL,
+    message text NOT NULL
+);
+
+CREATE TABLE plans (
+    id bigserial PRIMARY KEY,
+    name text NOT NULL
+);
+
+ALTER TABLE accounts OWNER TO migrator;
+ALTER TABLE accounts ENABLE ROW LEVEL SECURITY;
+
+CREATE POLICY tenant_isolation ON accounts FOR SELECT
+    USING (tenant_id = current_setting('app.tenant_id')::bigint);
+ALTER POLICY tenant_isolation ON accounts TO app_user;
+
+CREATE ROLE app_user;
+CREATE ROLE app;
+GRANT app_user TO app;
+
+GRANT SELECT (id, tenant_id, email), UPDATE (email, tenant_id) ON accounts TO app_user;
+REVOKE UPDATE (tenant_id) ON accounts FROM app_user;
+GRANT INSERT ON audit_log TO app;
+GRANT SELECT ON plans TO PUBLIC;
@@ -12,6 +12,9 @@ import (
 
 type cc struct {
 	paramCount int
+	// arrayJoined are the names an ARRAY JOIN without an alias unfolds in
+	// the query being converted.
+	arrayJoined map[string]bool
 }
 
 func (c *cc) convert(node chast.Node) ast.Node {
@@ -82,6 +85,47 @@ func (c *cc) convertSelectWithUnionQuery(n *chast.SelectWithUnionQuery) ast.Node
 func (c *cc) convertSelectQuery(n *chast.SelectQuery) *ast.SelectStmt {
 	stmt := &ast.SelectStmt{}
 
+	// Clauses are converted in the order they appear in the query, so that
+	// placeholders are numbered by position.
+	saved := c.arrayJoined
+	c.arrayJoined = arrayJoinedNames(n)
+	defer func() { c.arrayJoined = saved }()
+
+	// Convert WITH clause (CTEs)
+	if len(n.With) > 0 {
+		stmt.WithClause = &ast.WithClause{
+			Ctes: &ast.List{},
+		}
+		for _, cte := range n.With {
+			if aliased, ok := cte.(*chast.AliasedExpr); ok {
+				cteNode := &ast.CommonTableExpr{
+					Ctename: &aliased.Alias,
+				}
+				// CTE expression may be a Subquery containing the actual SELECT
+				if subq, ok := aliased.Expr.(*chast.Subquery); ok {
+					cteNode.Ctequery = c.convert(subq.Query)
+				} else {
+					// Fallback: treat the expression itself as the query
+					cteNode.Ctequery = c.convertExpr(aliased.Expr)
+				}
+				stmt.WithClause.Ctes.Items = append(stmt.WithClause.Ctes.Items, cteNode)
+			}
+		}
+	}
+
+	// Convert DISTINCT clause
+	if n.Distinct {
+		stmt.DistinctClause = &ast.List{}
+	}
+
+	// Convert DISTINCT ON clause
+	if len(n.DistinctOn) > 0 {
+		stmt.DistinctClause = &ast.List{}
+		for _, expr := range n.DistinctOn {
+			stmt.DistinctClause.Items = append(stmt.DistinctClause.Items, c.convertExpr(expr))
+		}
+	}
+
 	// Convert target list (SELECT columns)
 	if len(n.Columns) > 0 {
 		stmt.TargetList = &ast.List{}
@@ -98,6 +142,19 @@ func (c *cc) convertSelectQuery(n *chast.SelectQuery) *ast.SelectStmt {
 		stmt.FromClause = c.convertTablesInSelectQuery(n.From)
 	}
 
+	// Convert ARRAY JOIN clause
+	if n.ArrayJoin != nil {
+		if stmt.FromClause == nil {
+			stmt.FromClause = &ast.List{}
+		}
+		stmt.FromClause.Items = append(stmt.FromClause.Items, c.convertArrayJoin(n.ArrayJoin)...)
+	}
+
+	// Convert PREWHERE clause
+	if n.PreWhere != nil {
+		stmt.PrewhereClause = c.convertExpr(n.PreWhere)
+	}
+
 	// Convert WHERE clause
 	if n.Where != nil {
 		stmt.WhereClause = c.convertExpr(n.Where)
@@ -124,6 +181,19 @@ func (c *cc) convertSelectQuery(n *chast.SelectQuery) *ast.SelectStmt {
 		}
 	}
 
+	// Convert LIMIT BY clause
+	if len(n.LimitBy) > 0 {
+		stmt.LimitBy = &ast.LimitBy{
+			Count:    c.convertExpr(n.LimitByLimit),
+			Offset:   c.convertExpr(n.LimitByOffset),
+			Exprs:    &ast.List{},
+			Location: n.Pos().Offset,
+		}
+		for _, expr := range n.LimitBy {
+			stmt.LimitBy.Exprs.Items = append(stmt.LimitBy.Exprs.Items, c.convertExpr(expr))
+		}
+	}
+
 	// Convert LIMIT clause
 	if n.Limit != nil {
 		stmt.LimitCount = c.convertExpr(n.Limit)
@@ -134,42 +204,104 @@ func (c *cc) convertSelectQuery(n *chast.SelectQuery) *ast.SelectStmt {
 		stmt.LimitOffset = c.convertExpr(n.Offset)
 	}
 
-	// Convert DISTINCT clause
-	if n.Distinct {
-		stmt.DistinctClause = &ast.List{}
+	// Convert SETTINGS clause
+	if len(n.Settings) > 0 {
+		stmt.Settings = c.convertSettings(n.Settings)
 	}
 
-	// Convert DISTINCT ON clause
-	if len(n.DistinctOn) > 0 {
-		stmt.DistinctClause = &ast.List{}
-		for _, expr := range n.DistinctOn {
-			stmt.DistinctClause.Items = append(stmt.DistinctClause.Items, c.convertExpr(expr))
-		}
-	}
+	return stmt
+}
 
-	// Convert WITH clause (CTEs)
-	if len(n.With) > 0 {
-		stmt.WithClause = &ast.WithClause{
-			Ctes: &ast.List{},
+// arrayJoinedNames returns the columns a query's ARRAY JOIN unfolds without
+// an alias. Within the query these names refer to the elements of the array,
+// not to the array itself.
+func arrayJoinedNames(n *chast.SelectQuery) map[string]bool {
+	var clauses []*chast.ArrayJoinClause
+	if n.From != nil {
+		for _, elem := range n.From.Tables {
+			if elem.ArrayJoin != nil {
+				clauses = append(clauses, elem.ArrayJoin)
+			}
 		}
-		for _, cte := range n.With {
-			if aliased, ok := cte.(*chast.AliasedExpr); ok {
-				cteNode := &ast.CommonTableExpr{
-					Ctename: &aliased.Alias,
-				}
-				// CTE expression may be a Subquery containing the actual SELECT
-				if subq, ok := aliased.Expr.(*chast.Subquery); ok {
-					cteNode.Ctequery = c.convert(subq.Query)
-				} else {
-					// Fallback: treat the expression itself as the query
-					cteNode.Ctequery = c.convertExpr(aliased.Expr)
+	}
+	if n.ArrayJoin != nil {
+		clauses = append(clauses, n.ArrayJoin)
+	}
+	var names map[string]bool
+	for _, clause := range clauses {
+		for _, col := range clause.Columns {
+			if id, ok := col.(*chast.Identifier); ok && id.Alias == "" {
+				if names == nil {
+					names = map[string]bool{}
 				}
-				stmt.WithClause.Ctes.Items = append(stmt.WithClause.Ctes.Items, cteNode)
+				names[id.Parts[len(id.Parts)-1]] = true
+			}
+		}
+	}
+	return names
+}
+
+// convertArrayJoin converts an ARRAY JOIN into a call to arrayJoin() in FROM
+// for every array it unfolds, named by the alias of the array or, without
+// one, by the array's column. A LEFT ARRAY JOIN keeps the rows with empty
+// arrays and fills in the default value of the element type, so it types the
+// same.
+func (c *cc) convertArrayJoin(n *chast.ArrayJoinClause) []ast.Node {
+	var items []ast.Node
+	for _, col := range n.Columns {
+		var alias string
+		expr := col
+		switch e := col.(type) {
+		case *chast.AliasedExpr:
+			alias = e.Alias
+			expr = e.Expr
+		case *chast.Identifier:
+			alias = e.Alias
+			if alias == "" {
+				alias = e.Parts[len(e.Parts)-1]
 			}
+		case *chast.FunctionCall:
+			alias = e.Alias
+		}
+
+		// The array itself refers to the column, not to its elements.
+		saved := c.arrayJoined
+		c.arrayJoined = nil
+		arg := c.convertExpr(expr)
+		c.arrayJoined = saved
+
+		rf := &ast.RangeFunction{
+			Functions: &ast.List{
+				Items: []ast.Node{
+					&ast.FuncCall{
+						Funcname: &ast.List{
+							Items: []ast.Node{&ast.String{Str: "arrayJoin"}},
+						},
+						Args:     &ast.List{Items: []ast.Node{arg}},
+						Location: col.Pos().Offset,
+					},
+				},
+			},
 		}
+		if alias != "" {
+			rf.Alias = &ast.Alias{Aliasname: &alias}
+		}
+		items = append(items, rf)
 	}
+	return items
+}
 
-	return stmt
+func (c *cc) convertSettings(settings []*chast.SettingExpr) *ast.List {
+	list := &ast.List{}
+	for _, s := range settings {
+		name := s.Name
+		list.Items = append(list.Items, &ast.DefElem{
+			Defname:  &name,
+			Arg:      c.convertExpr(s.Value),
+			Location: s.Pos().Offset,
+		})
+	}
+	return list
 }
 
 func (c *cc) convertToResTarget(expr chast.Expression) *ast.ResTarget {
@@ -236,6 +368,8 @@ func (c *cc) convertTablesInSelectQuery(n *chast.TablesInSelectQuery) *ast.List
 			} else {
 				result.Items = append(result.Items, tableExpr)
 			}
+		} else if elem.ArrayJoin != nil {
+			result.Items = append(result.Items, c.convertArrayJoin(elem.ArrayJoin)...)
 		} else if elem.Join != nil && len(result.Items) > 0 {
 			// Join without table (should not happen normally)
 			continue
@@ -284,6 +418,24 @@ func (c *cc) convertTableExpression(n *chast.TableExpression) ast.Node {
 		result = &ast.TODO{}
 	}
 
+	// FINAL only changes how a MergeTree table merges its rows when it is
+	// read, not the rows' columns, so it is not converted.
+	if n.Sample != nil {
+		sample := &ast.RangeTableSample{
+			Relation: result,
+			Method: &ast.List{
+				Items: []ast.Node{&ast.String{Str: "sample"}},
+			},
+			Args:     &ast.List{},
+			Location: n.Sample.Pos().Offset,
+		}
+		sample.Args.Items = append(sample.Args.Items, c.convertExpr(n.Sample.Ratio))
+		if n.Sample.Offset != nil {
+			sample.Args.Items = append(sample.Args.Items, c.convertExpr(n.Sample.Offset))
+		}
+		result = sample
+	}
+
 	return result
 }
 
@@ -376,6 +528,11 @@ func (c *cc) convertExpr(expr chast.Expression) ast.Node {
 
 func (c *cc) convertIdentifier(n *chast.Identifier) *ast.ColumnRef {
 	fields := &ast.List{}
+	if len(n.Parts) == 1 && c.arrayJoined[n.Parts[0]] {
+		// An element of an array unfolded by ARRAY JOIN, which is bound
+		// under the array's name.
+		fields.Items = append(fields.Items, NewIdentifier(n.Parts[0]))
+	}
 	for _, part := range n.Parts {
 		fields.Items = append(fields.Items, NewIdentifier(part))
 	}
@@ -499,6 +656,10 @@ func (c *cc) convertFunctionCall(n *chast.FunctionCall) *ast.FuncCall {
 		}
 	}
 
+	if n.Filter != nil {
+		fc.AggFilter = c.convertExpr(n.Filter)
+	}
+
 	// Convert window function
 	if n.Over != nil {
 		fc.Over = &ast.WindowDef{}
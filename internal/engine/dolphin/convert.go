@@ -46,7 +46,7 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_AddColumn,
-					Def:     convertColumnDef(def),
+					Def:     c.convertColumnDef(def),
 				})
 			}
 
@@ -70,7 +70,7 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_AddColumn,
-					Def:     convertColumnDef(def),
+					Def:     c.convertColumnDef(def),
 				})
 			}
 
@@ -84,7 +84,7 @@ func (c *cc) convertAlterTableStmt(n *pcast.AlterTableStmt) ast.Node {
 				alt.Cmds.Items = append(alt.Cmds.Items, &ast.AlterTableCmd{
 					Name:    &name,
 					Subtype: ast.AT_AddColumn,
-					Def:     convertColumnDef(def),
+					Def:     c.convertColumnDef(def),
 				})
 			}
 
@@ -225,7 +225,38 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 		create.ReferTable = parseTableName(n.ReferTable)
 	}
 	for _, def := range n.Cols {
-		create.Cols = append(create.Cols, convertColumnDef(def))
+		create.Cols = append(create.Cols, c.convertColumnDef(def))
+		for _, opt := range def.Options {
+			switch opt.Tp {
+			case pcast.ColumnOptionPrimaryKey:
+				create.PrimaryKey = []string{def.Name.String()}
+			case pcast.ColumnOptionUniqKey:
+				create.UniqueKeys = append(create.UniqueKeys, []string{def.Name.String()})
+			case pcast.ColumnOptionReference:
+				create.ForeignKeys = append(create.ForeignKeys, foreignKey("", []string{def.Name.String()}, opt.Refer))
+			}
+		}
+	}
+	for _, con := range n.Constraints {
+		var keys []string
+		for _, key := range con.Keys {
+			if key.Column == nil {
+				keys = nil
+				break
+			}
+			keys = append(keys, key.Column.Name.String())
+		}
+		if len(keys) == 0 {
+			continue
+		}
+		switch con.Tp {
+		case pcast.ConstraintPrimaryKey:
+			create.PrimaryKey = keys
+		case pcast.ConstraintUniq, pcast.ConstraintUniqKey, pcast.ConstraintUniqIndex:
+			create.UniqueKeys = append(create.UniqueKeys, keys)
+		case pcast.ConstraintForeignKey:
+			create.ForeignKeys = append(create.ForeignKeys, foreignKey(con.Name, keys, con.Refer))
+		}
 	}
 	for _, opt := range n.Options {
 		switch opt.Tp {
@@ -236,7 +267,7 @@ func (c *cc) convertCreateTableStmt(n *pcast.CreateTableStmt) ast.Node {
 	return create
 }
 
-func convertColumnDef(def *pcast.ColumnDef) *ast.ColumnDef {
+func (c *cc) convertColumnDef(def *pcast.ColumnDef) *ast.ColumnDef {
 	var vals *ast.List
 	if len(def.Tp.GetElems()) > 0 {
 		vals = &ast.List{}
@@ -247,12 +278,18 @@ func convertColumnDef(def *pcast.ColumnDef) *ast.ColumnDef {
 		}
 	}
 	comment := ""
+	defaultExpr := ""
+	var generated ast.Node
 	for _, opt := range def.Options {
 		switch opt.Tp {
 		case pcast.ColumnOptionComment:
 			if value, ok := opt.Expr.(*pcast.ValueExprBase); ok {
 				comment = value.GetString()
 			}
+		case pcast.ColumnOptionDefaultValue:
+			defaultExpr = restore(opt.Expr)
+		case pcast.ColumnOptionGenerated:
+			generated = c.convert(opt.Expr)
 		}
 	}
 
@@ -289,6 +326,8 @@ func convertColumnDef(def *pcast.ColumnDef) *ast.ColumnDef {
 		IsUnsigned: isUnsigned(def),
 		Comment:    comment,
 		Vals:       vals,
+		Default:    defaultExpr,
+		Generated:  generated,
 	}
 	if def.Tp.GetFlen() >= 0 {
 		length := def.Tp.GetFlen()
@@ -578,13 +617,53 @@ func (c *cc) convertSelectStmt(n *pcast.SelectStmt) *ast.SelectStmt {
 		Op:           op,
 		All:          all,
 	}
+	for i := range n.WindowSpecs {
+		stmt.WindowClause.Items = append(stmt.WindowClause.Items, c.convertWindowSpec(&n.WindowSpecs[i]))
+	}
 	if n.Limit != nil {
 		stmt.LimitCount = c.convert(n.Limit.Count)
 		stmt.LimitOffset = c.convert(n.Limit.Offset)
 	}
+	if lock := c.convertSelectLockInfo(n.LockInfo); lock != nil {
+		stmt.LockingClause = &ast.List{Items: []ast.Node{lock}}
+	}
 	return stmt
 }
 
+func (c *cc) convertSelectLockInfo(n *pcast.SelectLockInfo) *ast.LockingClause {
+	if n == nil {
+		return nil
+	}
+	lock := &ast.LockingClause{
+		LockedRels: &ast.List{},
+		WaitPolicy: ast.LockWaitPolicyBlock,
+	}
+	switch n.LockType {
+	case pcast.SelectLockForUpdate, pcast.SelectLockForUpdateWaitN:
+		lock.Strength = ast.LockClauseStrengthForUpdate
+	case pcast.SelectLockForUpdateNoWait:
+		lock.Strength = ast.LockClauseStrengthForUpdate
+		lock.WaitPolicy = ast.LockWaitPolicyError
+	case pcast.SelectLockForUpdateSkipLocked:
+		lock.Strength = ast.LockClauseStrengthForUpdate
+		lock.WaitPolicy = ast.LockWaitPolicySkip
+	case pcast.SelectLockForShare:
+		lock.Strength = ast.LockClauseStrengthForShare
+	case pcast.SelectLockForShareNoWait:
+		lock.Strength = ast.LockClauseStrengthForShare
+		lock.WaitPolicy = ast.LockWaitPolicyError
+	case pcast.SelectLockForShareSkipLocked:
+		lock.Strength = ast.LockClauseStrengthForShare
+		lock.WaitPolicy = ast.LockWaitPolicySkip
+	default:
+		return nil
+	}
+	for _, t := range n.Tables {
+		lock.LockedRels.Items = append(lock.LockedRels.Items, c.convertTableName(t))
+	}
+	return lock
+}
+
 func (c *cc) convertSubqueryExpr(n *pcast.SubqueryExpr) ast.Node {
 	// Wrap subquery in SubLink to ensure parentheses are added
 	return &ast.SubLink{
@@ -600,7 +679,7 @@ func (c *cc) convertTableRefsClause(n *pcast.TableRefsClause) *ast.List {
 	return c.convertJoin(n.TableRefs)
 }
 
-func (c *cc) convertCommonTableExpression(n *pcast.CommonTableExpression) *ast.CommonTableExpr {
+func (c *cc) convertCommonTableExpression(n *pcast.CommonTableExpression, recursive bool) *ast.CommonTableExpr {
 	if n == nil {
 		return nil
 	}
@@ -621,9 +700,11 @@ func (c *cc) convertCommonTableExpression(n *pcast.CommonTableExpression) *ast.C
 	}
 
 	return &ast.CommonTableExpr{
-		Ctename:     &name,
-		Ctequery:    cteQuery,
-		Ctecolnames: columns,
+		Ctename:       &name,
+		Aliascolnames: columns,
+		Ctequery:      cteQuery,
+		Cterecursive:  recursive,
+		Location:      n.OriginTextPosition(),
 	}
 }
 
@@ -632,8 +713,8 @@ func (c *cc) convertWithClause(n *pcast.WithClause) *ast.WithClause {
 		return nil
 	}
 	list := &ast.List{}
-	for _, n := range n.CTEs {
-		list.Items = append(list.Items, c.convertCommonTableExpression(n))
+	for _, cte := range n.CTEs {
+		list.Items = append(list.Items, c.convertCommonTableExpression(cte, n.IsRecursive))
 	}
 
 	return &ast.WithClause{
@@ -857,10 +938,6 @@ func (c *cc) convertCleanupTableLockStmt(n *pcast.CleanupTableLockStmt) ast.Node
 	return todo(n)
 }
 
-func (c *cc) convertColumnDef(n *pcast.ColumnDef) ast.Node {
-	return todo(n)
-}
-
 func (c *cc) convertColumnName(n *pcast.ColumnName) ast.Node {
 	return todo(n)
 }
@@ -1190,7 +1267,16 @@ func (c *cc) convertOrderByClause(n *pcast.OrderByClause) ast.Node {
 	}
 	list := &ast.List{Items: []ast.Node{}}
 	for _, item := range n.Items {
-		list.Items = append(list.Items, c.convert(item.Expr))
+		dir := ast.SortByDirDefault
+		if item.Desc {
+			dir = ast.SortByDirDesc
+		}
+		list.Items = append(list.Items, &ast.SortBy{
+			Node:      c.convert(item.Expr),
+			SortbyDir: dir,
+			UseOp:     &ast.List{},
+			Location:  item.Expr.OriginTextPosition(),
+		})
 	}
 	return list
 }
@@ -1211,8 +1297,15 @@ func (c *cc) convertParenthesesExpr(n *pcast.ParenthesesExpr) ast.Node {
 	return inner
 }
 
-func (c *cc) convertPartitionByClause(n *pcast.PartitionByClause) ast.Node {
-	return todo(n)
+func (c *cc) convertPartitionByClause(n *pcast.PartitionByClause) *ast.List {
+	list := &ast.List{}
+	if n == nil {
+		return list
+	}
+	for _, item := range n.Items {
+		list.Items = append(list.Items, c.convert(item.Expr))
+	}
+	return list
 }
 
 func (c *cc) convertPatternInExpr(n *pcast.PatternInExpr) ast.Node {
@@ -1528,11 +1621,54 @@ func (c *cc) convertTableSource(node *pcast.TableSource) ast.Node {
 		}
 		return rv
 
+	case *pcast.JSONTableExpr:
+		tf := c.convertJSONTableExpr(n)
+		if alias != "" {
+			tf.Alias = &ast.Alias{Aliasname: &alias}
+		}
+		return tf
+
 	default:
 		return todo(n)
 	}
 }
 
+// convertJSONTableExpr converts JSON_TABLE, which reads the rows of a JSON
+// document as a relation. It may refer to the tables before it in FROM, so it
+// is always lateral. The columns of a NESTED PATH are columns of the same
+// relation.
+func (c *cc) convertJSONTableExpr(n *pcast.JSONTableExpr) *ast.RangeTableFunc {
+	tf := &ast.RangeTableFunc{
+		Lateral:  true,
+		Docexpr:  c.convert(n.Doc),
+		Rowexpr:  c.convert(n.Path),
+		Columns:  &ast.List{},
+		Location: n.OriginTextPosition(),
+	}
+	c.convertJSONTableColumns(tf.Columns, n.Columns)
+	return tf
+}
+
+func (c *cc) convertJSONTableColumns(list *ast.List, cols []*pcast.JSONTableColumn) {
+	for _, col := range cols {
+		if col.Nested {
+			c.convertJSONTableColumns(list, col.NestedColumns)
+			continue
+		}
+		name := col.Name.String()
+		item := &ast.RangeTableFuncCol{
+			Colname:       &name,
+			ForOrdinality: col.ForOrdinality,
+			Location:      col.OriginTextPosition(),
+		}
+		if !col.ForOrdinality {
+			item.TypeName = fieldTypeName(col.Tp)
+			item.Colexpr = c.convert(col.Path)
+		}
+		list.Items = append(list.Items, item)
+	}
+}
+
 func (c *cc) convertTableToTable(n *pcast.TableToTable) ast.Node {
 	return todo(n)
 }
@@ -1596,11 +1732,93 @@ func (c *cc) convertWhenClause(n *pcast.WhenClause) ast.Node {
 }
 
 func (c *cc) convertWindowFuncExpr(n *pcast.WindowFuncExpr) ast.Node {
-	return todo(n)
+	name := strings.ToLower(n.Name)
+	fn := &ast.FuncCall{
+		Func: &ast.FuncName{
+			Name: name,
+		},
+		Funcname: &ast.List{
+			Items: []ast.Node{
+				NewIdentifier(name),
+			},
+		},
+		Args:        &ast.List{},
+		AggOrder:    &ast.List{},
+		AggDistinct: n.Distinct,
+		Over:        c.convertWindowSpec(&n.Spec),
+		Location:    n.OriginTextPosition(),
+	}
+	for _, a := range n.Args {
+		// COUNT(*) reaches us as COUNT(1), the same as it does as an aggregate.
+		if value, ok := a.(*pcast.ValueExprBase); ok && name == "count" && value.GetInt64() == int64(1) {
+			fn.AggStar = true
+			continue
+		}
+		fn.Args.Items = append(fn.Args.Items, c.convert(a))
+	}
+	return fn
 }
 
-func (c *cc) convertWindowSpec(n *pcast.WindowSpec) ast.Node {
-	return todo(n)
+func (c *cc) convertWindowSpec(n *pcast.WindowSpec) *ast.WindowDef {
+	def := &ast.WindowDef{
+		PartitionClause: c.convertPartitionByClause(n.PartitionBy),
+		OrderClause:     &ast.List{},
+		Location:        n.OriginTextPosition(),
+	}
+	if order, ok := c.convertOrderByClause(n.OrderBy).(*ast.List); ok {
+		def.OrderClause = order
+	}
+	name := n.Name.String()
+	if n.OnlyAlias {
+		// OVER w names a window the WINDOW clause defines.
+		def.Refname = &name
+		return def
+	}
+	if name != "" {
+		def.Name = &name
+	}
+	if ref := n.Ref.String(); ref != "" {
+		def.Refname = &ref
+	}
+	if n.Frame != nil {
+		c.convertFrame(def, n.Frame)
+	}
+	return def
+}
+
+// convertFrame records a ROWS or RANGE frame on the window it belongs to.
+func (c *cc) convertFrame(def *ast.WindowDef, n *pcast.FrameClause) {
+	def.FrameOptions = ast.FrameOptionNonDefault | ast.FrameOptionBetween
+	switch n.Type {
+	case pcast.Rows:
+		def.FrameOptions |= ast.FrameOptionRows
+	case pcast.Ranges:
+		def.FrameOptions |= ast.FrameOptionRange
+	}
+	start := n.Extent.Start
+	switch {
+	case start.Type == pcast.CurrentRow:
+		def.FrameOptions |= ast.FrameOptionStartCurrentRow
+	case start.UnBounded && start.Type == pcast.Preceding:
+		def.FrameOptions |= ast.FrameOptionStartUnboundedPreceding
+	case start.UnBounded:
+		def.FrameOptions |= ast.FrameOptionStartUnboundedFollowing
+	default:
+		def.FrameOptions |= ast.FrameOptionStartOffset
+		def.StartOffset = c.convert(start.Expr)
+	}
+	end := n.Extent.End
+	switch {
+	case end.Type == pcast.CurrentRow:
+		def.FrameOptions |= ast.FrameOptionEndCurrentRow
+	case end.UnBounded && end.Type == pcast.Following:
+		def.FrameOptions |= ast.FrameOptionEndUnboundedFollowing
+	case end.UnBounded:
+		def.FrameOptions |= ast.FrameOptionEndUnboundedPreceding
+	default:
+		def.FrameOptions |= ast.FrameOptionEndOffset
+		def.EndOffset = c.convert(end.Expr)
+	}
 }
 
 func (c *cc) convertCallStmt(n *pcast.CallStmt) ast.Node {
@@ -1631,9 +1849,17 @@ func (c *cc) convertProcedureInfo(n *pcast.ProcedureInfo) ast.Node {
 	var params ast.List
 	for _, sp := range n.ProcedureParam {
 		paramName := sp.ParamName
+		mode := ast.FuncParamIn
+		switch sp.Paramstatus {
+		case pcast.MODE_OUT:
+			mode = ast.FuncParamOut
+		case pcast.MODE_INOUT:
+			mode = ast.FuncParamInOut
+		}
 		params.Items = append(params.Items, &ast.FuncParam{
 			Name: &paramName,
-			Type: &ast.TypeName{Name: types.TypeToStr(sp.ParamType.GetType(), sp.ParamType.GetCharset())},
+			Type: fieldTypeName(sp.ParamType),
+			Mode: mode,
 		})
 	}
 	return &ast.CreateFunctionStmt{
@@ -1642,9 +1868,61 @@ func (c *cc) convertProcedureInfo(n *pcast.ProcedureInfo) ast.Node {
 			Schema: n.ProcedureName.Schema.L,
 			Name:   n.ProcedureName.Name.L,
 		},
+		IsProcedure: true,
 	}
 }
 
+// convertCreateFunctionStmt converts a stored function. Its arguments are
+// always IN arguments, and unlike a procedure it has a return type.
+func (c *cc) convertCreateFunctionStmt(n *pcast.CreateFunctionStmt) ast.Node {
+	var params ast.List
+	for _, fp := range n.Params {
+		paramName := fp.ParamName
+		params.Items = append(params.Items, &ast.FuncParam{
+			Name: &paramName,
+			Type: fieldTypeName(fp.ParamType),
+		})
+	}
+	return &ast.CreateFunctionStmt{
+		Params: &params,
+		Func: &ast.FuncName{
+			Schema: n.FunctionName.Schema.L,
+			Name:   n.FunctionName.Name.L,
+		},
+		ReturnType: fieldTypeName(n.ReturnType),
+	}
+}
+
+// fieldTypeName is the type a routine argument or result, or a JSON_TABLE
+// column, is declared with.
+func fieldTypeName(tp *types.FieldType) *ast.TypeName {
+	return &ast.TypeName{Name: types.TypeToStr(tp.GetType(), tp.GetCharset())}
+}
+
+// convertCreateTriggerStmt converts a trigger. A MySQL trigger always fires
+// for each row, and its body is the single statement it runs.
+func (c *cc) convertCreateTriggerStmt(n *pcast.CreateTriggerStmt) ast.Node {
+	name := n.TriggerName.Name.String()
+	stmt := &ast.CreateTrigStmt{
+		Trigname: &name,
+		Relation: c.convertTableName(n.Table),
+		Row:      true,
+	}
+	switch n.TriggerTime {
+	case pcast.TriggerTimeBefore:
+		stmt.Timing = ast.TriggerTypeBefore
+	}
+	switch n.TriggerEvent {
+	case pcast.TriggerEventInsert:
+		stmt.Events = ast.TriggerTypeInsert
+	case pcast.TriggerEventUpdate:
+		stmt.Events = ast.TriggerTypeUpdate
+	case pcast.TriggerEventDelete:
+		stmt.Events = ast.TriggerTypeDelete
+	}
+	return stmt
+}
+
 func (c *cc) convert(node pcast.Node) ast.Node {
 	switch n := node.(type) {
 
@@ -1735,6 +2013,9 @@ func (c *cc) convert(node pcast.Node) ast.Node {
 	case *pcast.CreateDatabaseStmt:
 		return c.convertCreateDatabaseStmt(n)
 
+	case *pcast.CreateFunctionStmt:
+		return c.convertCreateFunctionStmt(n)
+
 	case *pcast.CreateIndexStmt:
 		return c.convertCreateIndexStmt(n)
 
@@ -1747,6 +2028,9 @@ func (c *cc) convert(node pcast.Node) ast.Node {
 	case *pcast.CreateTableStmt:
 		return c.convertCreateTableStmt(n)
 
+	case *pcast.CreateTriggerStmt:
+		return c.convertCreateTriggerStmt(n)
+
 	case *pcast.CreateUserStmt:
 		return c.convertCreateUserStmt(n)
 
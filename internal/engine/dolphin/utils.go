@@ -1,7 +1,10 @@
 package dolphin
 
 import (
+	"strings"
+
 	pcast "github.com/sqlc-dev/marino/ast"
+	"github.com/sqlc-dev/marino/format"
 	"github.com/sqlc-dev/marino/mysql"
 
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
@@ -14,6 +17,32 @@ func parseTableName(n *pcast.TableName) *ast.TableName {
 	}
 }
 
+// foreignKey returns the foreign key of columns that a REFERENCES clause
+// describes.
+func foreignKey(name string, columns []string, ref *pcast.ReferenceDef) *ast.ForeignKey {
+	fk := &ast.ForeignKey{
+		Name:     name,
+		Columns:  columns,
+		RefTable: parseTableName(ref.Table),
+	}
+	for _, spec := range ref.IndexPartSpecifications {
+		if spec.Column != nil {
+			fk.RefColumns = append(fk.RefColumns, spec.Column.Name.String())
+		}
+	}
+	return fk
+}
+
+// restore returns an expression as MySQL writes it.
+func restore(n pcast.Node) string {
+	flags := format.RestoreStringSingleQuotes | format.RestoreKeyWordUppercase | format.RestoreStringWithoutCharset | format.RestoreNameBackQuotes
+	var b strings.Builder
+	if err := n.Restore(format.NewRestoreCtx(flags, &b)); err != nil {
+		return ""
+	}
+	return b.String()
+}
+
 func toList(node pcast.Node) *ast.List {
 	var items []ast.Node
 	switch n := node.(type) {
@@ -177,6 +177,14 @@ func (c *cc) convertSelect(n *zjast.Select) *ast.SelectStmt {
 		stmt.HavingClause = c.convertExpr(n.Having.Expr)
 	}
 
+	if n.Qualify != nil {
+		stmt.QualifyClause = c.convertExpr(n.Qualify.Expr)
+	}
+
+	if n.SelectAs != nil && strings.EqualFold(n.SelectAs.AsMode, "STRUCT") && stmt.TargetList != nil {
+		stmt.TargetList = &ast.List{Items: []ast.Node{structTarget(stmt.TargetList)}}
+	}
+
 	if n.Distinct {
 		stmt.DistinctClause = &ast.List{}
 	}
@@ -184,6 +192,25 @@ func (c *cc) convertSelect(n *zjast.Select) *ast.SelectStmt {
 	return stmt
 }
 
+// structTarget turns the targets of SELECT AS STRUCT into the single struct
+// column it returns, with a field for each of them.
+func structTarget(targets *ast.List) *ast.ResTarget {
+	row := &ast.RowExpr{Args: &ast.List{}, Colnames: &ast.List{}}
+	for _, item := range targets.Items {
+		rt, ok := item.(*ast.ResTarget)
+		if !ok {
+			continue
+		}
+		name := ""
+		if rt.Name != nil {
+			name = *rt.Name
+		}
+		row.Args.Items = append(row.Args.Items, rt.Val)
+		row.Colnames.Items = append(row.Colnames.Items, &ast.String{Str: name})
+	}
+	return &ast.ResTarget{Val: row}
+}
+
 func (c *cc) convertSelectColumn(n *zjast.SelectColumn) *ast.ResTarget {
 	res := &ast.ResTarget{
 		Location: n.Pos(),
@@ -201,14 +228,11 @@ func (c *cc) convertTableExpr(node zjast.Node) ast.Node {
 	case *zjast.TablePathExpression:
 		return c.convertTablePathExpression(n)
 	case *zjast.TableSubquery:
-		if len(n.PostfixOperators) > 0 {
-			return todo(n)
-		}
-		return &ast.RangeSubselect{
+		return c.convertPostfixOperators(&ast.RangeSubselect{
 			Lateral:  n.IsLateral,
 			Subquery: c.convertQuery(n.Query),
 			Alias:    convertAlias(n.Alias),
-		}
+		}, n, n.PostfixOperators)
 	case *zjast.Join:
 		return c.convertJoin(n)
 	case *zjast.ParenthesizedJoin:
@@ -224,20 +248,121 @@ func (c *cc) convertTableExpr(node zjast.Node) ast.Node {
 }
 
 func (c *cc) convertTablePathExpression(n *zjast.TablePathExpression) ast.Node {
-	if len(n.PostfixOperators) > 0 {
-		return todo(n)
-	}
 	if n.UnnestExpr != nil {
-		return &ast.RangeFunction{
+		rf := &ast.RangeFunction{
 			Functions: &ast.List{
 				Items: []ast.Node{c.convertUnnestExpression(n.UnnestExpr)},
 			},
 			Alias: convertAlias(n.Alias),
 		}
+		if n.Offset != nil {
+			// WITH OFFSET numbers the elements from zero in a column named
+			// "offset" unless it is given an alias.
+			rf.Ordinality = true
+			if rf.Alias == nil {
+				name := "unnest"
+				rf.Alias = &ast.Alias{Aliasname: &name}
+			}
+			offset := "offset"
+			if a := convertAlias(n.Offset.Alias); a != nil {
+				offset = *a.Aliasname
+			}
+			rf.Alias.Colnames = &ast.List{Items: []ast.Node{
+				&ast.String{Str: *rf.Alias.Aliasname},
+				&ast.String{Str: offset},
+			}}
+		}
+		return c.convertPostfixOperators(rf, n, n.PostfixOperators)
 	}
 	rv := parseRangeVar(n.Path)
 	rv.Alias = convertAlias(n.Alias)
-	return rv
+	return c.convertPostfixOperators(rv, n, n.PostfixOperators)
+}
+
+// convertPostfixOperators wraps a FROM item in the PIVOT and UNPIVOT operators
+// that follow it. Other operators, such as MATCH_RECOGNIZE, are not supported.
+func (c *cc) convertPostfixOperators(rel ast.Node, n zjast.Node, ops []zjast.Node) ast.Node {
+	for _, op := range ops {
+		switch o := op.(type) {
+		case *zjast.PivotClause:
+			rel = c.convertPivotClause(rel, o)
+		case *zjast.UnpivotClause:
+			rel = c.convertUnpivotClause(rel, o)
+		default:
+			return todo(n)
+		}
+	}
+	return rel
+}
+
+func (c *cc) convertPivotClause(rel ast.Node, n *zjast.PivotClause) *ast.RangePivot {
+	pivot := &ast.RangePivot{
+		Relation:   rel,
+		Aggregates: &ast.List{},
+		ForExpr:    c.convertExpr(n.ForExpr),
+		Values:     &ast.List{},
+		Alias:      convertAlias(n.Alias),
+		Location:   n.Pos(),
+	}
+	if n.Expressions != nil {
+		for _, e := range n.Expressions.Expressions {
+			if e == nil {
+				continue
+			}
+			rt := &ast.ResTarget{Val: c.convertExpr(e.Expr), Location: e.Pos()}
+			if a := convertAlias(e.Alias); a != nil {
+				rt.Name = a.Aliasname
+			}
+			pivot.Aggregates.Items = append(pivot.Aggregates.Items, rt)
+		}
+	}
+	if n.Values != nil {
+		for _, v := range n.Values.Values {
+			if v == nil {
+				continue
+			}
+			rt := &ast.ResTarget{Val: c.convertExpr(v.Value), Location: v.Pos()}
+			if a := convertAlias(v.Alias); a != nil {
+				rt.Name = a.Aliasname
+			}
+			pivot.Values.Items = append(pivot.Values.Items, rt)
+		}
+	}
+	return pivot
+}
+
+func (c *cc) convertUnpivotClause(rel ast.Node, n *zjast.UnpivotClause) *ast.RangeUnpivot {
+	unpivot := &ast.RangeUnpivot{
+		Relation:     rel,
+		IncludeNulls: strings.EqualFold(n.NullFilter, "INCLUDE NULLS"),
+		ValueColumns: &ast.List{},
+		Items:        &ast.List{},
+		Alias:        convertAlias(n.Alias),
+		Location:     n.Pos(),
+	}
+	if n.Columns != nil {
+		for _, p := range n.Columns.Paths {
+			if parts := pathParts(p); len(parts) > 0 {
+				unpivot.ValueColumns.Items = append(unpivot.ValueColumns.Items, NewIdentifier(parts[len(parts)-1]))
+			}
+		}
+	}
+	if parts := pathParts(n.ForExpr); len(parts) > 0 {
+		unpivot.NameColumn = identifier(parts[len(parts)-1])
+	}
+	if n.InItems != nil {
+		for _, item := range n.InItems.Items {
+			if item == nil || item.Columns == nil {
+				continue
+			}
+			refs := &ast.List{}
+			for _, p := range item.Columns.Paths {
+				refs.Items = append(refs.Items, c.convertPathExpression(p))
+			}
+			unpivot.Items.Items = append(unpivot.Items.Items, refs)
+		}
+	}
+	return unpivot
 }
 
 func (c *cc) convertUnnestExpression(n *zjast.UnnestExpression) *ast.FuncCall {
@@ -387,7 +512,14 @@ func (c *cc) convertExpr(node zjast.Node) ast.Node {
 				Location: n.Pos(),
 			}
 		}
-		return todo(n)
+		if n.Name == nil {
+			return todo(n)
+		}
+		// A field of a struct value that is not a column, (expr).field.
+		return &ast.A_Indirection{
+			Arg:         c.convertExpr(n.Expr),
+			Indirection: &ast.List{Items: []ast.Node{NewIdentifier(n.Name.Name)}},
+		}
 	case *zjast.IntLiteral:
 		return c.convertIntLiteral(n)
 	case *zjast.FloatLiteral:
@@ -473,10 +605,54 @@ func (c *cc) convertExpr(node zjast.Node) ast.Node {
 			Arg: c.convertExpr(n.Array),
 			Indirection: &ast.List{
 				Items: []ast.Node{
-					&ast.A_Indices{Uidx: c.convertExpr(n.Position)},
+					&ast.A_Indices{Uidx: c.convertExpr(arrayPosition(n.Position))},
 				},
 			},
 		}
+	case *zjast.ArrayConstructor:
+		arr := &ast.A_ArrayExpr{Elements: &ast.List{}, Location: n.Pos()}
+		for _, e := range n.Elements {
+			arr.Elements.Items = append(arr.Elements.Items, c.convertExpr(e))
+		}
+		if n.Type != nil && len(n.Elements) == 0 {
+			// An empty ARRAY<T>[] has no element to take its type from.
+			return &ast.TypeCast{
+				Arg:      arr,
+				TypeName: &ast.TypeName{Name: typeName(n.Type)},
+				Location: n.Pos(),
+			}
+		}
+		return arr
+	case *zjast.StructConstructorWithKeyword:
+		return c.convertStructConstructor(n)
+	case *zjast.StructConstructorWithParens:
+		row := &ast.RowExpr{Args: &ast.List{}, Location: n.Pos()}
+		for _, e := range n.FieldExpressions {
+			row.Args.Items = append(row.Args.Items, c.convertExpr(e))
+		}
+		return row
+	case *zjast.DateOrTimeLiteral:
+		return &ast.TypeCast{
+			Arg:      c.convertExpr(n.Value),
+			TypeName: &ast.TypeName{Name: strings.ToLower(n.TypeKind)},
+			Location: n.Pos(),
+		}
+	case *zjast.IntervalExpr:
+		unit := ""
+		if n.DatePart != nil {
+			unit = strings.ToUpper(n.DatePart.Name)
+		}
+		return &ast.IntervalExpr{
+			Value:    c.convertExpr(n.Value),
+			Unit:     unit,
+			Location: n.Pos(),
+		}
+	case *zjast.ExtractExpression:
+		// EXTRACT(part FROM expr) is typed as a call taking the part as a
+		// string, the way the date functions take theirs.
+		fc := c.newFuncCall([]string{"extract"}, n.Pos())
+		fc.Args.Items = append(fc.Args.Items, datePart(n.LhsExpr), c.convertExpr(n.RhsExpr))
+		return fc
 	case *zjast.NamedArgument:
 		return c.convertExpr(n.Value)
 	case *zjast.ExpressionWithAlias:
@@ -668,6 +844,91 @@ func (c *cc) convertExpressionSubquery(n *zjast.ExpressionSubquery) *ast.SubLink
 	return subLink
 }
 
+// convertStructConstructor converts STRUCT(...), whose fields are named by
+// their aliases, and STRUCT<...>(...), whose fields are named by its type.
+func (c *cc) convertStructConstructor(n *zjast.StructConstructorWithKeyword) ast.Node {
+	row := &ast.RowExpr{Args: &ast.List{}, Colnames: &ast.List{}, Location: n.Pos()}
+	for _, f := range n.Fields {
+		if f == nil {
+			continue
+		}
+		name := ""
+		if a := convertAlias(f.Alias); a != nil {
+			name = *a.Aliasname
+		}
+		row.Args.Items = append(row.Args.Items, c.convertExpr(f.Expression))
+		row.Colnames.Items = append(row.Colnames.Items, &ast.String{Str: name})
+	}
+	if n.StructType != nil {
+		return &ast.TypeCast{
+			Arg:      row,
+			TypeName: &ast.TypeName{Name: typeName(n.StructType)},
+			Location: n.Pos(),
+		}
+	}
+	return row
+}
+
+// datePartFunctions are the functions that take a date part, as in
+// DATE_DIFF(a, b, DAY), after their first argument.
+var datePartFunctions = map[string]bool{
+	"date_diff":       true,
+	"date_trunc":      true,
+	"datetime_diff":   true,
+	"datetime_trunc":  true,
+	"time_diff":       true,
+	"time_trunc":      true,
+	"timestamp_diff":  true,
+	"timestamp_trunc": true,
+	"last_day":        true,
+}
+
+// datePart converts a date part, DAY or WEEK(MONDAY), to the string constant
+// the date functions are seeded to take, or returns nil for anything else.
+func datePart(node zjast.Node) ast.Node {
+	var name string
+	switch n := node.(type) {
+	case *zjast.PathExpression:
+		parts := pathParts(n)
+		if len(parts) != 1 {
+			return nil
+		}
+		name = parts[0]
+	case *zjast.Identifier:
+		name = n.Name
+	case *zjast.FunctionCall:
+		// WEEK(<weekday>)
+		parts := pathParts(n.Function)
+		if len(parts) != 1 || !strings.EqualFold(parts[0], "week") {
+			return nil
+		}
+		name = parts[0]
+	default:
+		return nil
+	}
+	switch name = strings.ToUpper(name); name {
+	case "MICROSECOND", "MILLISECOND", "SECOND", "MINUTE", "HOUR", "DAY",
+		"DAYOFWEEK", "DAYOFYEAR", "WEEK", "ISOWEEK", "MONTH", "QUARTER",
+		"YEAR", "ISOYEAR", "DATE", "TIME", "DATETIME":
+		return &ast.A_Const{Val: &ast.String{Str: name}, Location: node.Pos()}
+	}
+	return nil
+}
+
+// arrayPosition unwraps the OFFSET, ORDINAL, SAFE_OFFSET and SAFE_ORDINAL
+// of arr[OFFSET(i)] to the position they take.
+func arrayPosition(node zjast.Node) zjast.Node {
+	call, ok := node.(*zjast.FunctionCall)
+	if !ok || len(call.Args) != 1 {
+		return node
+	}
+	switch parts := pathParts(call.Function); strings.ToLower(strings.Join(parts, ".")) {
+	case "offset", "ordinal", "safe_offset", "safe_ordinal":
+		return call.Args[0]
+	}
+	return node
+}
+
 // newFuncCall builds a FuncCall from a dotted function path. GoogleSQL
 // function names are case-insensitive, so they are lowercased.
 func (c *cc) newFuncCall(parts []string, loc int) *ast.FuncCall {
@@ -700,11 +961,17 @@ func (c *cc) convertFunctionCall(n *zjast.FunctionCall) ast.Node {
 	}
 	fc := c.newFuncCall(parts, n.Pos())
 	fc.AggDistinct = n.Distinct
-	for _, arg := range n.Args {
+	for i, arg := range n.Args {
 		if _, ok := arg.(*zjast.Star); ok {
 			fc.AggStar = true
 			continue
 		}
+		if i > 0 && datePartFunctions[fc.Func.Name] {
+			if part := datePart(arg); part != nil {
+				fc.Args.Items = append(fc.Args.Items, part)
+				continue
+			}
+		}
 		fc.Args.Items = append(fc.Args.Items, c.convertExpr(arg))
 	}
 	if n.OrderBy != nil {
@@ -801,14 +1068,16 @@ func (c *cc) convertInsertStatement(n *zjast.InsertStatement) ast.Node {
 	}
 
 	if n.Returning != nil {
-		stmt.ReturningList = c.convertReturningClause(n.Returning)
+		stmt.ReturningList = c.convertReturningClause(n.Returning, "INSERT")
 	}
 
 	return stmt
 }
 
 // convertReturningClause converts a "THEN RETURN" clause to a returning list.
-func (c *cc) convertReturningClause(n *zjast.ReturningClause) *ast.List {
+// WITH ACTION adds a string column holding the statement's action: INSERT,
+// UPDATE or DELETE.
+func (c *cc) convertReturningClause(n *zjast.ReturningClause, action string) *ast.List {
 	list := &ast.List{}
 	if n.SelectList != nil {
 		for _, col := range n.SelectList.Columns {
@@ -818,6 +1087,13 @@ func (c *cc) convertReturningClause(n *zjast.ReturningClause) *ast.List {
 			list.Items = append(list.Items, c.convertSelectColumn(col))
 		}
 	}
+	if a := convertAlias(n.ActionAlias); a != nil {
+		list.Items = append(list.Items, &ast.ResTarget{
+			Name:     a.Aliasname,
+			Val:      &ast.A_Const{Val: &ast.String{Str: action}, Location: n.ActionAlias.Pos()},
+			Location: n.ActionAlias.Pos(),
+		})
+	}
 	return list
 }
 
@@ -854,7 +1130,7 @@ func (c *cc) convertUpdateStatement(n *zjast.UpdateStatement) ast.Node {
 	}
 
 	if n.Returning != nil {
-		stmt.ReturningList = c.convertReturningClause(n.Returning)
+		stmt.ReturningList = c.convertReturningClause(n.Returning, "UPDATE")
 	}
 
 	return stmt
@@ -890,7 +1166,7 @@ func (c *cc) convertDeleteStatement(n *zjast.DeleteStatement) ast.Node {
 	}
 
 	if n.Returning != nil {
-		stmt.ReturningList = c.convertReturningClause(n.Returning)
+		stmt.ReturningList = c.convertReturningClause(n.Returning, "DELETE")
 	}
 
 	return stmt
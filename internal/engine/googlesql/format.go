@@ -0,0 +1,36 @@
+package googlesql
+
+// QuoteIdent returns a quoted identifier if it needs quoting.
+// GoogleSQL uses backticks for quoting identifiers.
+func (p *Parser) QuoteIdent(s string) string {
+	if p.IsReservedKeyword(s) {
+		return "`" + s + "`"
+	}
+	return s
+}
+
+// TypeName returns the SQL type name for the given namespace and name.
+func (p *Parser) TypeName(ns, name string) string {
+	if ns != "" {
+		return ns + "." + name
+	}
+	return name
+}
+
+// Param returns the parameter placeholder for the given number.
+// GoogleSQL uses ? for positional parameters.
+func (p *Parser) Param(n int) string {
+	return "?"
+}
+
+// NamedParam returns the named parameter placeholder for the given name.
+// GoogleSQL uses @name syntax.
+func (p *Parser) NamedParam(name string) string {
+	return "@" + name
+}
+
+// Cast returns a type cast expression.
+// GoogleSQL uses CAST(expr AS type) syntax.
+func (p *Parser) Cast(arg, typeName string) string {
+	return "CAST(" + arg + " AS " + typeName + ")"
+}
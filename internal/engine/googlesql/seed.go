@@ -10,7 +10,14 @@ import (
 //go:embed dialect
 var dialectFS embed.FS
 
-// Dialect returns the catalog option that seeds GoogleSQL's type system.
+// Dialect returns the catalog option that seeds GoogleSQL's type system, with
+// the types and functions of every flavor.
 func Dialect() core.Option {
 	return seed.Dialect(dialectFS, "dialect")
 }
+
+// DialectFlavor is Dialect for one flavor of GoogleSQL, spanner or bigquery,
+// which seeds only the types and functions that database has.
+func DialectFlavor(flavor string) core.Option {
+	return seed.DialectFlavor(dialectFS, "dialect", flavor)
+}
@@ -6,6 +6,7 @@ import (
 
 	zjast "github.com/sqlc-dev/zetajones/ast"
 
+	"github.com/sqlc-dev/sqlc/internal/core"
 	"github.com/sqlc-dev/sqlc/internal/debug"
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 )
@@ -181,7 +182,8 @@ func decodeEscapes(s string) string {
 }
 
 // typeName renders a zetajones type node (used by CAST) as a lowercased type
-// name. Nested types degrade to a readable representation.
+// name. Arrays and structs are spelled ARRAY<T> and STRUCT<name T, ...>, which
+// the catalog reads as parameterized types.
 func typeName(node zjast.Node) string {
 	switch t := node.(type) {
 	case *zjast.SimpleType:
@@ -189,7 +191,18 @@ func typeName(node zjast.Node) string {
 	case *zjast.ArrayType:
 		return "array<" + typeName(t.ElementType) + ">"
 	case *zjast.StructType:
-		return "struct"
+		var fields []core.StructField
+		for _, f := range t.Fields {
+			if f == nil {
+				continue
+			}
+			field := core.StructField{Type: typeName(f.Type)}
+			if f.Name != nil {
+				field.Name = identifier(f.Name.Name)
+			}
+			fields = append(fields, field)
+		}
+		return core.StructTypeName(fields)
 	case *zjast.RangeType:
 		return "range<" + typeName(t.ElementType) + ">"
 	case *zjast.MapType:
@@ -200,7 +213,7 @@ func typeName(node zjast.Node) string {
 }
 
 // columnSchemaTypeName renders a CREATE TABLE column schema as a lowercased
-// type name. Nested types degrade to a readable representation.
+// type name, spelling arrays and structs the way typeName does.
 func columnSchemaTypeName(node zjast.Node) string {
 	switch t := node.(type) {
 	case *zjast.SimpleColumnSchema:
@@ -208,7 +221,18 @@ func columnSchemaTypeName(node zjast.Node) string {
 	case *zjast.ArrayColumnSchema:
 		return "array<" + columnSchemaTypeName(t.ElementSchema) + ">"
 	case *zjast.StructColumnSchema:
-		return "struct"
+		var fields []core.StructField
+		for _, f := range t.Fields {
+			if f == nil {
+				continue
+			}
+			field := core.StructField{Type: columnSchemaTypeName(f.Schema)}
+			if f.Name != nil {
+				field.Name = identifier(f.Name.Name)
+			}
+			fields = append(fields, field)
+		}
+		return core.StructTypeName(fields)
 	default:
 		return ""
 	}
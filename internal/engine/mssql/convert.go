@@ -39,6 +39,9 @@ func (c *cc) parseRangeVar(n *tsql.SchemaObjectName) *ast.RangeVar {
 		Relname:  &name.Name,
 		Location: c.loc(n),
 	}
+	if name.Catalog != "" {
+		rv.Catalogname = &name.Catalog
+	}
 	if name.Schema != "" {
 		rv.Schemaname = &name.Schema
 	}
@@ -63,6 +66,26 @@ func (c *cc) convert(node tsql.Node) ast.Node {
 		return c.convertAlterTableAddTableElementStatement(n)
 	case *tsql.AlterTableDropTableElementStatement:
 		return c.convertAlterTableDropTableElementStatement(n)
+	case *tsql.CreateViewStatement:
+		return c.convertViewStatement(n, n.SchemaObjectName, n.Columns, n.SelectStatement, false)
+	case *tsql.CreateOrAlterViewStatement:
+		return c.convertViewStatement(n, n.SchemaObjectName, n.Columns, n.SelectStatement, true)
+	case *tsql.AlterViewStatement:
+		return c.convertViewStatement(n, n.SchemaObjectName, n.Columns, n.SelectStatement, true)
+	case *tsql.CreateSchemaStatement:
+		return c.convertCreateSchemaStatement(n)
+	case *tsql.CreateTypeUddtStatement:
+		return c.convertCreateTypeUddtStatement(n)
+	case *tsql.CreateTypeTableStatement:
+		return c.convertCreateTypeTableStatement(n)
+	case *tsql.CreateProcedureStatement:
+		return c.convertProcedureStatement(n, n.ProcedureReference, n.Parameters, false)
+	case *tsql.CreateOrAlterProcedureStatement:
+		return c.convertProcedureStatement(n, n.ProcedureReference, n.Parameters, true)
+	case *tsql.CreateSequenceStatement:
+		return c.convertCreateSequenceStatement(n)
+	case *tsql.ExecuteStatement:
+		return c.convertExecuteStatement(n)
 	default:
 		return todo(n)
 	}
@@ -621,6 +644,8 @@ func (c *cc) convertScalarExpression(expr tsql.ScalarExpression) ast.Node {
 			Subselect:   c.convertQueryExpression(e.QueryExpression),
 			Location:    c.loc(e),
 		}
+	case *tsql.NextValueForExpression:
+		return &ast.NextValueExpr{Sequence: c.parseRangeVar(e.SequenceName)}
 	case *tsql.ParameterlessCall:
 		return &ast.FuncCall{
 			Funcname: &ast.List{
@@ -1007,9 +1032,19 @@ func (c *cc) convertCreateTableStatement(n *tsql.CreateTableStatement) ast.Node
 		Name: parseTableName(n.SchemaObjectName),
 	}
 
-	// Column names in table-level PRIMARY KEY constraints are NOT NULL.
+	primaryKey := primaryKeyColumns(n.Definition)
+	for _, col := range n.Definition.ColumnDefinitions {
+		stmt.Cols = append(stmt.Cols, c.convertColumnDefinition(col, primaryKey))
+	}
+
+	return stmt
+}
+
+// primaryKeyColumns returns the columns named by a table-level PRIMARY KEY
+// constraint, which are NOT NULL.
+func primaryKeyColumns(n *tsql.TableDefinition) map[string]bool {
 	primaryKey := map[string]bool{}
-	for _, constraint := range n.Definition.TableConstraints {
+	for _, constraint := range n.TableConstraints {
 		unique, ok := constraint.(*tsql.UniqueConstraintDefinition)
 		if !ok || !unique.IsPrimaryKey {
 			continue
@@ -1025,12 +1060,7 @@ func (c *cc) convertCreateTableStatement(n *tsql.CreateTableStatement) ast.Node
 			primaryKey[identifierValue(ids[len(ids)-1])] = true
 		}
 	}
-
-	for _, col := range n.Definition.ColumnDefinitions {
-		stmt.Cols = append(stmt.Cols, c.convertColumnDefinition(col, primaryKey))
-	}
-
-	return stmt
+	return primaryKey
 }
 
 func (c *cc) convertColumnDefinition(n *tsql.ColumnDefinition, tablePrimaryKey map[string]bool) *ast.ColumnDef {
@@ -1141,3 +1171,159 @@ func (c *cc) convertAlterTableDropTableElementStatement(n *tsql.AlterTableDropTa
 	}
 	return stmt
 }
+
+// convertViewStatement converts CREATE VIEW, and CREATE OR ALTER VIEW and
+// ALTER VIEW, which replace the view they name.
+func (c *cc) convertViewStatement(n tsql.Node, name *tsql.SchemaObjectName, columns []*tsql.Identifier, sel *tsql.SelectStatement, replace bool) ast.Node {
+	if name == nil || sel == nil {
+		return todo(n)
+	}
+	stmt := &ast.ViewStmt{
+		View:    c.parseRangeVar(name),
+		Query:   c.convertSelectStatement(sel),
+		Replace: replace,
+	}
+	if len(columns) > 0 {
+		stmt.Aliases = &ast.List{}
+		for _, col := range columns {
+			stmt.Aliases.Items = append(stmt.Aliases.Items, NewIdentifier(col.Value))
+		}
+	}
+	return stmt
+}
+
+func (c *cc) convertCreateSchemaStatement(n *tsql.CreateSchemaStatement) ast.Node {
+	name := identifierValue(n.Name)
+	// dbo is the catalog's default namespace, which always exists.
+	if name == "" || name == "dbo" {
+		return todo(n)
+	}
+	return &ast.CreateSchemaStmt{Name: &name}
+}
+
+// convertCreateTypeUddtStatement converts an alias type, CREATE TYPE ...
+// FROM, which is its base type under another name.
+func (c *cc) convertCreateTypeUddtStatement(n *tsql.CreateTypeUddtStatement) ast.Node {
+	if n.Name == nil || n.DataType == nil {
+		return todo(n)
+	}
+	name := &ast.List{}
+	if schema := schemaName(n.Name); schema != "" {
+		name.Items = append(name.Items, &ast.String{Str: schema})
+	}
+	name.Items = append(name.Items, &ast.String{Str: identifierValue(n.Name.BaseIdentifier)})
+	return &ast.CreateDomainStmt{
+		Domainname: name,
+		TypeName:   &ast.TypeName{Name: dataTypeName(n.DataType)},
+	}
+}
+
+// convertCreateTypeTableStatement converts a table type, CREATE TYPE ... AS
+// TABLE, the type of a table-valued parameter.
+func (c *cc) convertCreateTypeTableStatement(n *tsql.CreateTypeTableStatement) ast.Node {
+	if n.Name == nil || n.Definition == nil {
+		return todo(n)
+	}
+	stmt := &ast.CompositeTypeStmt{
+		TypeName: &ast.TypeName{
+			Schema: schemaName(n.Name),
+			Name:   identifierValue(n.Name.BaseIdentifier),
+		},
+	}
+	primaryKey := primaryKeyColumns(n.Definition)
+	for _, col := range n.Definition.ColumnDefinitions {
+		stmt.Cols = append(stmt.Cols, c.convertColumnDefinition(col, primaryKey))
+	}
+	return stmt
+}
+
+// convertProcedureStatement converts CREATE PROCEDURE, and CREATE OR ALTER
+// PROCEDURE, to a function with no return type. Its body is not analyzed.
+// An OUTPUT parameter is passed in as well as out.
+func (c *cc) convertProcedureStatement(n tsql.Node, ref *tsql.ProcedureReference, params []*tsql.ProcedureParameter, replace bool) ast.Node {
+	if ref == nil || ref.Name == nil {
+		return todo(n)
+	}
+	stmt := &ast.CreateFunctionStmt{
+		Func: &ast.FuncName{
+			Schema: schemaName(ref.Name),
+			Name:   identifierValue(ref.Name.BaseIdentifier),
+		},
+		Params:      &ast.List{},
+		Replace:     replace,
+		IsProcedure: true,
+	}
+	for _, p := range params {
+		fp := &ast.FuncParam{
+			Type: &ast.TypeName{Name: dataTypeName(p.DataType)},
+		}
+		if p.VariableName != nil {
+			name := identifier(strings.TrimPrefix(p.VariableName.Value, "@"))
+			fp.Name = &name
+		}
+		if p.Value != nil {
+			fp.DefExpr = &ast.TODO{}
+		}
+		if p.Modifier == "Output" {
+			fp.Mode = ast.FuncParamInOut
+		}
+		stmt.Params.Items = append(stmt.Params.Items, fp)
+	}
+	return stmt
+}
+
+func (c *cc) convertCreateSequenceStatement(n *tsql.CreateSequenceStatement) ast.Node {
+	if n.Name == nil {
+		return todo(n)
+	}
+	stmt := &ast.CreateSeqStmt{
+		Sequence: c.parseRangeVar(n.Name),
+	}
+	for _, opt := range n.SequenceOptions {
+		if as, ok := opt.(*tsql.DataTypeSequenceOption); ok && as.OptionKind == "As" {
+			name := "as"
+			stmt.Options = &ast.List{Items: []ast.Node{&ast.DefElem{
+				Defname: &name,
+				Arg:     &ast.TypeName{Name: dataTypeName(as.DataType)},
+			}}}
+		}
+	}
+	return stmt
+}
+
+// convertExecuteStatement converts EXEC of a named procedure to a CALL. An
+// argument passed as @name = value becomes a named argument.
+func (c *cc) convertExecuteStatement(n *tsql.ExecuteStatement) ast.Node {
+	if n.ExecuteSpecification == nil {
+		return todo(n)
+	}
+	proc, ok := n.ExecuteSpecification.ExecutableEntity.(*tsql.ExecutableProcedureReference)
+	if !ok || proc.ProcedureReference == nil || proc.ProcedureReference.ProcedureReference == nil {
+		return todo(n)
+	}
+	name := proc.ProcedureReference.ProcedureReference.Name
+	if name == nil {
+		return todo(n)
+	}
+	fc := &ast.FuncCall{
+		Func: &ast.FuncName{
+			Schema: schemaName(name),
+			Name:   identifierValue(name.BaseIdentifier),
+		},
+		Args:     &ast.List{},
+		Location: c.loc(n),
+	}
+	for _, p := range proc.Parameters {
+		arg := c.convertScalarExpression(p.ParameterValue)
+		if p.Variable != nil {
+			argName := identifier(strings.TrimPrefix(p.Variable.Name, "@"))
+			arg = &ast.NamedArgExpr{
+				Name:     &argName,
+				Arg:      arg,
+				Location: c.loc(p),
+			}
+		}
+		fc.Args.Items = append(fc.Args.Items, arg)
+	}
+	return &ast.CallStmt{FuncCall: fc}
+}
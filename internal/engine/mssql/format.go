@@ -0,0 +1,38 @@
+package mssql
+
+import "fmt"
+
+// QuoteIdent returns a quoted identifier if it needs quoting.
+// SQL Server uses square brackets for quoting identifiers.
+func (p *Parser) QuoteIdent(s string) string {
+	if p.IsReservedKeyword(s) {
+		return "[" + s + "]"
+	}
+	return s
+}
+
+// TypeName returns the SQL type name for the given namespace and name.
+func (p *Parser) TypeName(ns, name string) string {
+	if ns != "" {
+		return ns + "." + name
+	}
+	return name
+}
+
+// Param returns the parameter placeholder for the given number.
+// SQL Server drivers accept @p1, @p2, etc. for ordinal parameters.
+func (p *Parser) Param(n int) string {
+	return fmt.Sprintf("@p%d", n)
+}
+
+// NamedParam returns the named parameter placeholder for the given name.
+// SQL Server uses @name syntax.
+func (p *Parser) NamedParam(name string) string {
+	return "@" + name
+}
+
+// Cast returns a type cast expression.
+// SQL Server uses CAST(expr AS type) syntax.
+func (p *Parser) Cast(arg, typeName string) string {
+	return "CAST(" + arg + " AS " + typeName + ")"
+}
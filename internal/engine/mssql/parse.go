@@ -36,13 +36,18 @@ func (p *Parser) Parse(r io.Reader) ([]ast.Statement, error) {
 	loc := 0
 	for _, batch := range script.Batches {
 		for _, stmt := range batch.Statements {
-			start := loc
+			start, last := loc, loc
 			if frag, ok := stmt.(fragmented); ok && frag.Frag().HasSpan() {
-				if s := toByte(frag.Frag().StartOffset); s > start {
+				f := frag.Frag()
+				if s := toByte(f.StartOffset); s > start {
 					start = s
 				}
+				// A statement with a body, such as CREATE PROCEDURE, holds
+				// semicolons of its own, so the one ending it is not before
+				// the last character of its span.
+				last = toByte(f.StartOffset+f.FragmentLength) - 1
 			}
-			end := statementEnd(blob, start)
+			end := statementEnd(blob, max(start, last))
 
 			converter := &cc{toByte: toByte}
 			out := converter.convert(stmt)
@@ -58,8 +58,9 @@ func parseTableName(n *tsql.SchemaObjectName) *ast.TableName {
 		return &ast.TableName{}
 	}
 	return &ast.TableName{
-		Schema: schemaName(n),
-		Name:   identifierValue(n.BaseIdentifier),
+		Catalog: identifierValue(n.DatabaseIdentifier),
+		Schema:  schemaName(n),
+		Name:    identifierValue(n.BaseIdentifier),
 	}
 }
 
@@ -33,11 +33,13 @@ type Analyzer struct {
 	tables   sync.Map
 }
 
-func New(client dbmanager.Client, db config.Database) *Analyzer {
+// New returns an analyzer for db, whose URI is expanded with the variables in
+// env.
+func New(client dbmanager.Client, db config.Database, env []string) *Analyzer {
 	return &Analyzer{
 		db:       db,
 		client:   client,
-		replacer: shfmt.NewReplacer(nil),
+		replacer: shfmt.NewReplacer(env),
 	}
 }
 
@@ -212,7 +214,11 @@ func (a *Analyzer) Analyze(ctx context.Context, n ast.Node, query string, migrat
 		} else if debugDatabases.Value() == "managed" {
 			return nil, fmt.Errorf("database: connections disabled via SQLCDEBUG=databases=managed")
 		} else {
-			uri = a.replacer.Replace(a.db.URI)
+			var err error
+			uri, err = a.replacer.ReplaceStrict(a.db.URI)
+			if err != nil {
+				return nil, fmt.Errorf("database: %w", err)
+			}
 		}
 		conf, err := pgxpool.ParseConfig(uri)
 		if err != nil {
@@ -25,3 +25,10 @@ func loadExtension(name string) *catalog.Schema {
 	}
 	return &catalog.Schema{Name: "pg_catalog", Funcs: funcs}
 }
+
+// HasExtension reports whether sqlc ships the types and functions of the named
+// extension, so that CREATE EXTENSION is all a schema needs to use it.
+func HasExtension(name string) bool {
+	_, err := fs.Stat(dialectFS, path.Join("dialect", "extensions", name))
+	return err == nil
+}
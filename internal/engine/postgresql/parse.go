@@ -348,6 +348,33 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				case nodes.AlterTableType_AT_SetNotNull:
 					item.Subtype = ast.AT_SetNotNull
 
+				case nodes.AlterTableType_AT_ChangeOwner:
+					item.Subtype = ast.AT_ChangeOwner
+					item.Newowner = convertRoleSpec(altercmd.Newowner)
+
+				case nodes.AlterTableType_AT_EnableRowSecurity:
+					item.Subtype = ast.AT_EnableRowSecurity
+
+				case nodes.AlterTableType_AT_DisableRowSecurity:
+					item.Subtype = ast.AT_DisableRowSecurity
+
+				case nodes.AlterTableType_AT_ForceRowSecurity:
+					item.Subtype = ast.AT_ForceRowSecurity
+
+				case nodes.AlterTableType_AT_NoForceRowSecurity:
+					item.Subtype = ast.AT_NoForceRowSecurity
+
+				case nodes.AlterTableType_AT_AttachPartition, nodes.AlterTableType_AT_DetachPartition:
+					d, ok := altercmd.Def.Node.(*nodes.Node_PartitionCmd)
+					if !ok {
+						return nil, fmt.Errorf("expected alter table definition to be a PartitionCmd")
+					}
+					item.Subtype = ast.AT_AttachPartition
+					if altercmd.Subtype == nodes.AlterTableType_AT_DetachPartition {
+						item.Subtype = ast.AT_DetachPartition
+					}
+					item.Partition = convertPartitionCmd(d.PartitionCmd)
+
 				default:
 					continue
 				}
@@ -428,6 +455,8 @@ func translate(node *nodes.Node) (ast.Node, error) {
 		create := &ast.CreateTableStmt{
 			Name:        rel.TableName(),
 			IfNotExists: n.IfNotExists,
+			Partspec:    convertPartitionSpec(n.Partspec),
+			Partbound:   convertPartitionBoundSpec(n.Partbound),
 		}
 		for _, node := range n.InhRelations {
 			switch item := node.Node.(type) {
@@ -447,6 +476,13 @@ func translate(node *nodes.Node) (ast.Node, error) {
 						// FIXME: Possible nil pointer dereference
 						primaryKey[key.Node.(*nodes.Node_String_).String_.Sval] = true
 					}
+					create.PrimaryKey = constraintKeys(item.Constraint)
+				}
+				if item.Constraint.Contype == nodes.ConstrType_CONSTR_UNIQUE {
+					create.UniqueKeys = append(create.UniqueKeys, constraintKeys(item.Constraint))
+				}
+				if item.Constraint.Contype == nodes.ConstrType_CONSTR_FOREIGN {
+					create.ForeignKeys = append(create.ForeignKeys, foreignKey(item.Constraint, ""))
 				}
 
 			case *nodes.Node_TableLikeClause:
@@ -457,6 +493,15 @@ func translate(node *nodes.Node) (ast.Node, error) {
 		for _, elt := range n.TableElts {
 			switch item := elt.Node.(type) {
 			case *nodes.Node_ColumnDef:
+				// A partition's column list names columns of its parent,
+				// without a type, to add constraints to them.
+				if item.ColumnDef.TypeName == nil {
+					create.Cols = append(create.Cols, &ast.ColumnDef{
+						Colname:   item.ColumnDef.Colname,
+						IsNotNull: isNotNull(item.ColumnDef) || primaryKey[item.ColumnDef.Colname],
+					})
+					continue
+				}
 				rel, err := parseRelationFromNodes(item.ColumnDef.TypeName.Names)
 				if err != nil {
 					return nil, err
@@ -465,9 +510,19 @@ func translate(node *nodes.Node) (ast.Node, error) {
 				primary := false
 				for _, con := range item.ColumnDef.Constraints {
 					if constraint, ok := con.Node.(*nodes.Node_Constraint); ok {
-						primary = constraint.Constraint.Contype == nodes.ConstrType_CONSTR_PRIMARY
+						switch constraint.Constraint.Contype {
+						case nodes.ConstrType_CONSTR_PRIMARY:
+							primary = true
+						case nodes.ConstrType_CONSTR_UNIQUE:
+							create.UniqueKeys = append(create.UniqueKeys, []string{item.ColumnDef.Colname})
+						case nodes.ConstrType_CONSTR_FOREIGN:
+							create.ForeignKeys = append(create.ForeignKeys, foreignKey(constraint.Constraint, item.ColumnDef.Colname))
+						}
 					}
 				}
+				if primary {
+					create.PrimaryKey = []string{item.ColumnDef.Colname}
+				}
 
 				create.Cols = append(create.Cols, &ast.ColumnDef{
 					Colname:    item.ColumnDef.Colname,
@@ -476,6 +531,7 @@ func translate(node *nodes.Node) (ast.Node, error) {
 					IsArray:    isArray(item.ColumnDef.TypeName),
 					ArrayDims:  len(item.ColumnDef.TypeName.ArrayBounds),
 					PrimaryKey: primary,
+					Default:    columnDefault(item.ColumnDef),
 				})
 			}
 		}
@@ -516,11 +572,12 @@ func translate(node *nodes.Node) (ast.Node, error) {
 			rt = rel.TypeName()
 		}
 		stmt := &ast.CreateFunctionStmt{
-			Func:       fn.FuncName(),
-			ReturnType: rt,
-			Replace:    n.Replace,
-			Params:     &ast.List{},
-			Options:    convertSlice(n.Options),
+			Func:        fn.FuncName(),
+			ReturnType:  rt,
+			Replace:     n.Replace,
+			Params:      &ast.List{},
+			Options:     convertSlice(n.Options),
+			IsProcedure: n.IsProcedure,
 		}
 		for _, item := range n.Parameters {
 			arg := item.Node.(*nodes.Node_FunctionParameter).FunctionParameter
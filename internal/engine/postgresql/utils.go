@@ -2,6 +2,8 @@ package postgresql
 
 import (
 	nodes "github.com/sqlc-dev/oliphant"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 )
 
 func isArray(n *nodes.TypeName) bool {
@@ -29,6 +31,47 @@ func isNotNull(n *nodes.ColumnDef) bool {
 	return false
 }
 
+// constraintKeys returns the column names of a table-level PRIMARY KEY or
+// UNIQUE constraint.
+func constraintKeys(n *nodes.Constraint) []string {
+	return names(n.Keys)
+}
+
+func names(list []*nodes.Node) []string {
+	var names []string
+	for _, item := range list {
+		if s, ok := item.Node.(*nodes.Node_String_); ok {
+			names = append(names, s.String_.Sval)
+		}
+	}
+	return names
+}
+
+// foreignKey returns the foreign key of a FOREIGN KEY constraint, or of the
+// REFERENCES clause of column, which is "" for a table-level constraint.
+func foreignKey(n *nodes.Constraint, column string) *ast.ForeignKey {
+	fk := &ast.ForeignKey{
+		Name:       n.Conname,
+		Columns:    names(n.FkAttrs),
+		RefTable:   parseRelationFromRangeVar(n.Pktable).TableName(),
+		RefColumns: names(n.PkAttrs),
+	}
+	if column != "" {
+		fk.Columns = []string{column}
+	}
+	return fk
+}
+
+// columnDefault returns the DEFAULT expression of a column as SQL.
+func columnDefault(n *nodes.ColumnDef) string {
+	for _, c := range n.Constraints {
+		if con, ok := c.Node.(*nodes.Node_Constraint); ok && con.Constraint.Contype == nodes.ConstrType_CONSTR_DEFAULT {
+			return ast.Format(convertNode(con.Constraint.RawExpr), NewParser())
+		}
+	}
+	return ""
+}
+
 func makeByte(s string) byte {
 	var b byte
 	if s == "" {
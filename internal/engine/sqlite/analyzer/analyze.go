@@ -27,10 +27,12 @@ type Analyzer struct {
 	mu       sync.Mutex
 }
 
-func New(db config.Database) *Analyzer {
+// New returns an analyzer for db, whose URI is expanded with the variables in
+// env.
+func New(db config.Database, env []string) *Analyzer {
 	return &Analyzer{
 		db:       db,
-		replacer: shfmt.NewReplacer(nil),
+		replacer: shfmt.NewReplacer(env),
 	}
 }
 
@@ -47,7 +49,11 @@ func (a *Analyzer) Analyze(ctx context.Context, n ast.Node, query string, migrat
 		} else if debugDatabases.Value() == "managed" {
 			return nil, fmt.Errorf("database: connections disabled via SQLCDEBUG=databases=managed")
 		} else {
-			uri = a.replacer.Replace(a.db.URI)
+			var err error
+			uri, err = a.replacer.ReplaceStrict(a.db.URI)
+			if err != nil {
+				return nil, fmt.Errorf("database: %w", err)
+			}
 			// For in-memory databases, we need to apply migrations since the database starts empty
 			if isInMemoryDatabase(uri) {
 				applyMigrations = true
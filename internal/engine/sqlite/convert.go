@@ -3,6 +3,7 @@ package sqlite
 import (
 	"fmt"
 	"log"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -37,9 +38,15 @@ func (c *cc) convert(node meyer.Node) ast.Node {
 	case *meyer.AttachStmt:
 		return c.convertAttachStmt(n)
 
+	case *meyer.CreateIndexStmt:
+		return c.convertCreateIndexStmt(n)
+
 	case *meyer.CreateTableStmt:
 		return c.convertCreateTableStmt(n)
 
+	case *meyer.CreateTriggerStmt:
+		return c.convertCreateTriggerStmt(n)
+
 	case *meyer.CreateViewStmt:
 		return c.convertCreateViewStmt(n)
 
@@ -212,18 +219,14 @@ func (c *cc) convertAlterTableStmt(n *meyer.AlterTableStmt) ast.Node {
 		if def == nil {
 			break
 		}
-		name := identifier(def.Name)
+		col := c.convertColumnDef(def)
 		return &ast.AlterTableStmt{
 			Table: parseTableName(n.Table),
 			Cmds: &ast.List{Items: []ast.Node{
 				&ast.AlterTableCmd{
-					Name:    &name,
+					Name:    &col.Colname,
 					Subtype: ast.AT_AddColumn,
-					Def: &ast.ColumnDef{
-						Colname:   name,
-						TypeName:  &ast.TypeName{Name: columnTypeName(def.Type)},
-						IsNotNull: hasNotNullConstraint(def.Constraints),
-					},
+					Def:     col,
 				},
 			}},
 		}
@@ -268,10 +271,106 @@ func schemaName(e meyer.Expr) string {
 // columnTypeName returns the declared type of a column, defaulting to
 // SQLite's untyped "any" when the declaration omits one.
 func columnTypeName(t *meyer.TypeName) string {
+	if name := declaredType(t); name != "" {
+		return name
+	}
+	return "any"
+}
+
+// declaredType returns the type a column is declared with, or "" when it has
+// none. SQLite's grammar reads the GENERATED ALWAYS of a generated column as
+// the tail of its type name, and SQLite strips it back off, as this does.
+func declaredType(t *meyer.TypeName) string {
 	if t == nil {
-		return "any"
+		return ""
+	}
+	name := typeName(t)
+	if len(t.Args) == 0 && len(name) >= len("GENERATEDALWAYS") {
+		if rest, ok := cutSuffixFold(name, "ALWAYS"); ok {
+			name = rest
+			if rest, ok := cutSuffixFold(name, "GENERATED"); ok {
+				name = rest
+			}
+		}
+	}
+	return name
+}
+
+func cutSuffixFold(s, suffix string) (string, bool) {
+	if len(s) < len(suffix) || !strings.EqualFold(s[len(s)-len(suffix):], suffix) {
+		return s, false
+	}
+	return s[:len(s)-len(suffix)], true
+}
+
+// strictTypes maps each type a column of a STRICT table may be declared with
+// to the storage class SQLite holds its values in.
+//
+// https://www.sqlite.org/stricttables.html
+var strictTypes = map[string]string{
+	"int":     "integer",
+	"integer": "integer",
+	"real":    "real",
+	"text":    "text",
+	"blob":    "blob",
+	"any":     "any",
+}
+
+// convertColumnDef converts a column of a CREATE TABLE or an ALTER TABLE ADD
+// COLUMN.
+func (c *cc) convertColumnDef(def *meyer.ColumnDef) *ast.ColumnDef {
+	col := &ast.ColumnDef{
+		Colname:   identifier(def.Name),
+		IsNotNull: hasNotNullConstraint(def.Constraints),
+		TypeName:  &ast.TypeName{Name: columnTypeName(def.Type)},
+		Default:   columnDefault(def.Constraints),
+	}
+	for _, con := range def.Constraints {
+		if con.Kind == meyer.ColumnGenerated {
+			col.Generated = c.convertExpr(con.Expr)
+		}
 	}
-	return typeName(t)
+	return col
+}
+
+// strictColumnType gives a column of a STRICT table the storage class its
+// declared type names. SQLite refuses any other type in a STRICT table, but
+// sqlc has always accepted one, so it is left as declared.
+func strictColumnType(def *meyer.ColumnDef, col *ast.ColumnDef) {
+	if storage, ok := strictTypes[strings.ToLower(declaredType(def.Type))]; ok {
+		col.TypeName = &ast.TypeName{Name: storage}
+	}
+}
+
+// typeofCheck reads the storage class a CHECK constraint of the form
+// typeof(col) = 'class' holds a column to, the way a table that is not
+// STRICT enforces a column's type.
+func typeofCheck(e meyer.Expr) (column, storage string, ok bool) {
+	bin, ok := e.(*meyer.BinaryExpr)
+	if !ok || bin.Op != meyer.OpEq {
+		return "", "", false
+	}
+	call, lit := bin.X, bin.Y
+	if _, isLit := call.(*meyer.Literal); isLit {
+		call, lit = lit, call
+	}
+	fn, ok := call.(*meyer.FuncCall)
+	if !ok || identifier(fn.Name) != "typeof" || len(fn.Args) != 1 {
+		return "", "", false
+	}
+	id, ok := fn.Args[0].(*meyer.Ident)
+	if !ok {
+		return "", "", false
+	}
+	str, ok := lit.(*meyer.Literal)
+	if !ok || str.Kind != meyer.LitString {
+		return "", "", false
+	}
+	switch storage := strings.ToLower(str.Value); storage {
+	case "integer", "real", "text", "blob":
+		return identifier(id), storage, true
+	}
+	return "", "", false
 }
 
 func (c *cc) convertCreateTableStmt(n *meyer.CreateTableStmt) ast.Node {
@@ -279,12 +378,161 @@ func (c *cc) convertCreateTableStmt(n *meyer.CreateTableStmt) ast.Node {
 		Name:        parseTableName(n.Name),
 		IfNotExists: n.IfNotExists,
 	}
+	var strict, withoutRowid bool
+	for _, opt := range n.Options {
+		switch name := identifier(opt.Name); {
+		case opt.Without && name == "rowid":
+			withoutRowid = true
+		case !opt.Without && name == "strict":
+			strict = true
+		}
+	}
+	var checks []meyer.Expr
 	for _, def := range n.Columns {
-		stmt.Cols = append(stmt.Cols, &ast.ColumnDef{
-			Colname:   identifier(def.Name),
-			IsNotNull: hasNotNullConstraint(def.Constraints),
-			TypeName:  &ast.TypeName{Name: columnTypeName(def.Type)},
-		})
+		col := c.convertColumnDef(def)
+		if strict {
+			strictColumnType(def, col)
+		}
+		stmt.Cols = append(stmt.Cols, col)
+		name := col.Colname
+		for _, con := range def.Constraints {
+			switch con.Kind {
+			case meyer.ColumnPrimaryKey:
+				stmt.PrimaryKey = []string{name}
+			case meyer.ColumnUnique:
+				stmt.UniqueKeys = append(stmt.UniqueKeys, []string{name})
+			case meyer.ColumnReferences:
+				stmt.ForeignKeys = append(stmt.ForeignKeys, foreignKey(identifier(con.Name), []string{name}, con.References))
+			case meyer.ColumnCheck:
+				stmt.Checks = append(stmt.Checks, &ast.Check{
+					Name: identifier(con.Name),
+					Expr: c.convertExpr(con.Expr),
+				})
+				checks = append(checks, con.Expr)
+			}
+		}
+	}
+	for _, con := range n.Constraints {
+		switch con.Kind {
+		case meyer.TableForeignKey:
+			var cols []string
+			for _, col := range con.FKColumns {
+				cols = append(cols, identifier(col.Name))
+			}
+			stmt.ForeignKeys = append(stmt.ForeignKeys, foreignKey(identifier(con.Name), cols, con.References))
+			continue
+		case meyer.TableCheck:
+			stmt.Checks = append(stmt.Checks, &ast.Check{
+				Name: identifier(con.Name),
+				Expr: c.convertExpr(con.Expr),
+			})
+			checks = append(checks, con.Expr)
+			continue
+		}
+		var cols []string
+		for _, term := range con.Columns {
+			id, ok := term.Expr.(*meyer.Ident)
+			if !ok {
+				cols = nil
+				break
+			}
+			cols = append(cols, identifier(id))
+		}
+		if len(cols) == 0 {
+			continue
+		}
+		switch con.Kind {
+		case meyer.TablePrimaryKey:
+			stmt.PrimaryKey = cols
+		case meyer.TableUnique:
+			stmt.UniqueKeys = append(stmt.UniqueKeys, cols)
+		}
+	}
+	// A rowid table lets a primary key column that is not its rowid hold
+	// NULL, a long-standing SQLite quirk; a WITHOUT ROWID table does not.
+	if withoutRowid {
+		for _, col := range stmt.Cols {
+			if slices.Contains(stmt.PrimaryKey, col.Colname) {
+				col.IsNotNull = true
+			}
+		}
+	}
+	// A STRICT table already holds each column to its type. Any other table
+	// does so only through a CHECK on the column's typeof().
+	if !strict {
+		for _, check := range checks {
+			name, storage, ok := typeofCheck(check)
+			if !ok {
+				continue
+			}
+			for _, col := range stmt.Cols {
+				if col.Colname == name {
+					col.TypeName = &ast.TypeName{Name: storage}
+				}
+			}
+		}
+	}
+	return stmt
+}
+
+func (c *cc) convertCreateIndexStmt(n *meyer.CreateIndexStmt) ast.Node {
+	name := parseTableName(n.Name)
+	table := identifier(n.Table)
+	// An index is always created in the schema of the table it indexes.
+	relation := &ast.RangeVar{Relname: &table, Location: n.Table.Pos()}
+	if name.Schema != "" {
+		relation.Schemaname = &name.Schema
+	}
+	params := &ast.List{}
+	for _, term := range n.Columns {
+		elem := &ast.IndexElem{}
+		if id, ok := term.Expr.(*meyer.Ident); ok {
+			col := identifier(id)
+			elem.Name = &col
+		} else {
+			elem.Expr = c.convertExpr(term.Expr)
+		}
+		params.Items = append(params.Items, elem)
+	}
+	return &ast.IndexStmt{
+		Idxname:     &name.Name,
+		Relation:    relation,
+		IndexParams: params,
+		WhereClause: c.convertExpr(n.Where),
+		Unique:      n.Unique,
+		IfNotExists: n.IfNotExists,
+	}
+}
+
+func (c *cc) convertCreateTriggerStmt(n *meyer.CreateTriggerStmt) ast.Node {
+	name := parseTableName(n.Name)
+	stmt := &ast.CreateTrigStmt{
+		Trigname:    &name.Name,
+		Relation:    parseRangeVar(n.Table, nil),
+		Row:         true, // SQLite only has row-level triggers
+		WhenClause:  c.convertExpr(n.When),
+		IfNotExists: n.IfNotExists,
+	}
+	switch n.Time {
+	case meyer.TriggerBefore:
+		// A trigger that names no time is a BEFORE trigger.
+		stmt.Timing = ast.TriggerTypeBefore
+	case meyer.TriggerInsteadOf:
+		stmt.Timing = ast.TriggerTypeInstead
+	}
+	switch strings.ToUpper(n.Event) {
+	case "INSERT":
+		stmt.Events = ast.TriggerTypeInsert
+	case "UPDATE":
+		stmt.Events = ast.TriggerTypeUpdate
+	case "DELETE":
+		stmt.Events = ast.TriggerTypeDelete
+	}
+	if len(n.UpdateOf) > 0 {
+		stmt.Columns = &ast.List{}
+		for _, col := range n.UpdateOf {
+			stmt.Columns.Items = append(stmt.Columns.Items, NewIdentifier(col))
+		}
 	}
 	return stmt
 }
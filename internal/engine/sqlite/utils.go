@@ -88,3 +88,27 @@ func hasNotNullConstraint(constraints []*meyer.ColumnConstraint) bool {
 	}
 	return false
 }
+
+// columnDefault returns a column's DEFAULT expression as SQL.
+func columnDefault(constraints []*meyer.ColumnConstraint) string {
+	for _, constraint := range constraints {
+		if constraint.Kind == meyer.ColumnDefault && constraint.Expr != nil {
+			return meyer.String(constraint.Expr)
+		}
+	}
+	return ""
+}
+
+// foreignKey returns the foreign key of columns that a REFERENCES clause
+// describes. SQLite only lets a table reference tables in its own schema.
+func foreignKey(name string, columns []string, ref *meyer.ForeignKeyClause) *ast.ForeignKey {
+	fk := &ast.ForeignKey{
+		Name:     name,
+		Columns:  columns,
+		RefTable: &ast.TableName{Name: identifier(ref.Table)},
+	}
+	for _, col := range ref.Columns {
+		fk.RefColumns = append(fk.RefColumns, identifier(col.Name))
+	}
+	return fk
+}
@@ -0,0 +1,95 @@
+// Package introspect reads the schema of a live database back into the files
+// sqlc works from: a schema.sql of the DDL that recreates its tables, views,
+// enums and functions, and a catalog file of the types, operators and
+// functions that the DDL cannot declare, such as those of an extension sqlc
+// ships no data for.
+package introspect
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/core/seed"
+)
+
+// Options configures Introspect.
+type Options struct {
+	// Schemas limits PostgreSQL to the named schemas. Left empty, every schema
+	// that is not one of the system's is read. The other engines read the
+	// database they are connected to.
+	Schemas []string
+}
+
+// Result is a database's schema as sqlc reads it.
+type Result struct {
+	// Schema is the DDL, one statement per entry, in an order that applies.
+	Schema []string
+
+	// Catalog is what the DDL cannot declare, as the records of a catalog
+	// file.
+	Catalog []seed.Record
+}
+
+// Introspect reads the schema of the database db is connected to, which runs
+// engine.
+func Introspect(ctx context.Context, engine config.Engine, db *sql.DB, opts Options) (*Result, error) {
+	switch engine {
+	case config.EnginePostgreSQL:
+		return postgresql(ctx, db, opts)
+	case config.EngineMySQL:
+		return mysql(ctx, db)
+	case config.EngineSQLite:
+		return sqlite(ctx, db)
+	}
+	return nil, fmt.Errorf("introspect: unsupported engine %s", engine)
+}
+
+// WriteSchema writes the DDL as a schema file, each statement terminated and
+// separated from the next by a blank line.
+func (r *Result) WriteSchema(w io.Writer) error {
+	for i, stmt := range r.Schema {
+		if i > 0 {
+			if _, err := io.WriteString(w, "\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := io.WriteString(w, strings.TrimSuffix(strings.TrimSpace(stmt), ";")+";\n"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// WriteCatalog writes the catalog records as JSONL, one record per line.
+func (r *Result) WriteCatalog(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	for _, rec := range r.Catalog {
+		if err := enc.Encode(rec); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// query runs a query and hands each row to scan.
+func query(ctx context.Context, db *sql.DB, q string, scan func(*sql.Rows) error, args ...any) error {
+	rows, err := db.QueryContext(ctx, q, args...)
+	if err != nil {
+		return fmt.Errorf("introspect: %w", err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		if err := scan(rows); err != nil {
+			return fmt.Errorf("introspect: %w", err)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("introspect: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,86 @@
+package introspect_test
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	_ "github.com/ncruces/go-sqlite3/driver"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/core/seed"
+	"github.com/sqlc-dev/sqlc/internal/introspect"
+	_ "github.com/sqlc-dev/sqlc/internal/sqlite3ext"
+)
+
+func TestSQLite(t *testing.T) {
+	db, err := sql.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	db.SetMaxOpenConns(1)
+
+	for _, stmt := range []string{
+		"CREATE TABLE authors (id INTEGER PRIMARY KEY, name TEXT NOT NULL)",
+		"CREATE TABLE books (id INTEGER PRIMARY KEY AUTOINCREMENT, author_id INTEGER REFERENCES authors (id))",
+		"CREATE INDEX books_author ON books (author_id)",
+		"CREATE VIRTUAL TABLE notes USING fts5(body)",
+		"CREATE VIEW prolific AS SELECT author_id, count(*) AS n FROM books GROUP BY author_id",
+		"CREATE TRIGGER books_note AFTER INSERT ON books BEGIN INSERT INTO notes (body) VALUES ('new book'); END",
+		"CREATE UNIQUE INDEX authors_name ON authors (name)",
+		"INSERT INTO authors (name) VALUES ('Ursula')",
+	} {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("%s: %s", stmt, err)
+		}
+	}
+
+	res, err := introspect.Introspect(context.Background(), config.EngineSQLite, db, introspect.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := res.WriteSchema(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `CREATE TABLE authors (id INTEGER PRIMARY KEY, name TEXT NOT NULL);
+
+CREATE TABLE books (id INTEGER PRIMARY KEY AUTOINCREMENT, author_id INTEGER REFERENCES authors (id));
+
+CREATE VIRTUAL TABLE notes USING fts5(body);
+
+CREATE VIEW prolific AS SELECT author_id, count(*) AS n FROM books GROUP BY author_id;
+
+CREATE INDEX books_author ON books (author_id);
+
+CREATE UNIQUE INDEX authors_name ON authors (name);
+
+CREATE TRIGGER books_note AFTER INSERT ON books BEGIN INSERT INTO notes (body) VALUES ('new book'); END;
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("schema differed (-want +got):\n%s", diff)
+	}
+	if len(res.Catalog) != 0 {
+		t.Errorf("catalog has %d records, want none", len(res.Catalog))
+	}
+}
+
+func TestWriteCatalog(t *testing.T) {
+	res := &introspect.Result{Catalog: []seed.Record{
+		{Type: &seed.Type{Name: "geography", Category: "U"}},
+		{Function: &seed.Function{Name: "st_area", Args: []seed.Arg{{Type: "geography"}}, Returns: "float8"}},
+	}}
+	var buf bytes.Buffer
+	if err := res.WriteCatalog(&buf); err != nil {
+		t.Fatal(err)
+	}
+	want := `{"type":{"name":"geography","category":"U"}}
+{"function":{"name":"st_area","args":[{"type":"geography"}],"returns":"float8"}}
+`
+	if diff := cmp.Diff(want, buf.String()); diff != "" {
+		t.Errorf("catalog differed (-want +got):\n%s", diff)
+	}
+}
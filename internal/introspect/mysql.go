@@ -0,0 +1,172 @@
+package introspect
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/core/seed"
+)
+
+const mysqlTables = `
+SELECT TABLE_NAME
+FROM information_schema.TABLES
+WHERE TABLE_SCHEMA = DATABASE() AND TABLE_TYPE = 'BASE TABLE'
+ORDER BY TABLE_NAME
+`
+
+const mysqlViews = `
+SELECT TABLE_NAME, VIEW_DEFINITION
+FROM information_schema.VIEWS
+WHERE TABLE_SCHEMA = DATABASE()
+ORDER BY TABLE_NAME
+`
+
+const mysqlRoutines = `
+SELECT SPECIFIC_NAME, ROUTINE_NAME, ROUTINE_TYPE, COALESCE(DTD_IDENTIFIER, '')
+FROM information_schema.ROUTINES
+WHERE ROUTINE_SCHEMA = DATABASE()
+ORDER BY ROUTINE_NAME, SPECIFIC_NAME
+`
+
+const mysqlParameters = `
+SELECT SPECIFIC_NAME, COALESCE(PARAMETER_NAME, ''), COALESCE(PARAMETER_MODE, ''), DTD_IDENTIFIER
+FROM information_schema.PARAMETERS
+WHERE SPECIFIC_SCHEMA = DATABASE() AND ORDINAL_POSITION > 0
+ORDER BY SPECIFIC_NAME, ORDINAL_POSITION
+`
+
+// autoIncrement is the table option SHOW CREATE TABLE reports the next
+// AUTO_INCREMENT value in, which is data rather than schema.
+var autoIncrement = regexp.MustCompile(` AUTO_INCREMENT=\d+`)
+
+// mysqlModes maps a routine parameter's mode to the letter a catalog record
+// carries.
+var mysqlModes = map[string]string{"IN": "", "OUT": "o", "INOUT": "b"}
+
+// mysql reads the database the connection is using. Tables come from SHOW
+// CREATE TABLE, which spells out everything including the columns' enums.
+// Stored functions and procedures go to the catalog rather than the schema:
+// their bodies are not something sqlc parses, and a query only needs their
+// signatures.
+func mysql(ctx context.Context, db *sql.DB) (*Result, error) {
+	var database string
+	if err := db.QueryRowContext(ctx, "SELECT DATABASE()").Scan(&database); err != nil {
+		return nil, fmt.Errorf("introspect: %w", err)
+	}
+
+	res := &Result{}
+	var tables []string
+	err := query(ctx, db, mysqlTables, func(rows *sql.Rows) error {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return err
+		}
+		tables = append(tables, name)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range tables {
+		var name, stmt string
+		if err := db.QueryRowContext(ctx, "SHOW CREATE TABLE "+mysqlQuote(table)).Scan(&name, &stmt); err != nil {
+			return nil, fmt.Errorf("introspect: table %s: %w", table, err)
+		}
+		res.Schema = append(res.Schema, autoIncrement.ReplaceAllString(stmt, ""))
+	}
+
+	// A view's definition names everything with the database it lives in,
+	// which the schema should not depend on.
+	qualifier := mysqlQuote(database) + "."
+	type view struct{ name, def string }
+	var views []view
+	err = query(ctx, db, mysqlViews, func(rows *sql.Rows) error {
+		var v view
+		if err := rows.Scan(&v.name, &v.def); err != nil {
+			return err
+		}
+		v.def = strings.ReplaceAll(v.def, qualifier, "")
+		views = append(views, v)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	// A view has to come after the views it selects from.
+	names := make([]string, len(views))
+	defs := make([]string, len(views))
+	for i, v := range views {
+		names[i], defs[i] = v.name, v.def
+	}
+	for _, i := range dependencyOrder(names, defs) {
+		res.Schema = append(res.Schema, "CREATE VIEW "+mysqlQuote(views[i].name)+" AS "+views[i].def)
+	}
+
+	params := map[string][]seed.Arg{}
+	err = query(ctx, db, mysqlParameters, func(rows *sql.Rows) error {
+		var specific, mode string
+		var arg seed.Arg
+		if err := rows.Scan(&specific, &arg.Name, &mode, &arg.Type); err != nil {
+			return err
+		}
+		arg.Mode = mysqlModes[mode]
+		params[specific] = append(params[specific], arg)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	err = query(ctx, db, mysqlRoutines, func(rows *sql.Rows) error {
+		var specific, routineType string
+		var fn seed.Function
+		if err := rows.Scan(&specific, &fn.Name, &routineType, &fn.Returns); err != nil {
+			return err
+		}
+		if routineType == "PROCEDURE" {
+			fn.Kind = "p"
+		}
+		fn.Args = params[specific]
+		res.Catalog = append(res.Catalog, seed.Record{Function: &fn})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+func mysqlQuote(name string) string {
+	return "`" + strings.ReplaceAll(name, "`", "``") + "`"
+}
+
+// dependencyOrder returns the order to create views in so that each comes
+// after the views its definition names. A name is taken to be used wherever
+// it appears quoted in a definition, which is how MySQL writes every name
+// back. Views in a cycle, which the database would not have allowed, keep
+// their original order.
+func dependencyOrder(names, defs []string) []int {
+	order := make([]int, 0, len(names))
+	placed := make([]bool, len(names))
+	var visit func(i int, path []int)
+	visit = func(i int, path []int) {
+		if placed[i] || slices.Contains(path, i) {
+			return
+		}
+		path = append(path, i)
+		for j, name := range names {
+			if j != i && strings.Contains(defs[i], mysqlQuote(name)) {
+				visit(j, path)
+			}
+		}
+		placed[i] = true
+		order = append(order, i)
+	}
+	for i := range names {
+		visit(i, nil)
+	}
+	return order
+}
@@ -0,0 +1,55 @@
+package introspect
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestDependencyOrder(t *testing.T) {
+	for _, tc := range []struct {
+		name  string
+		names []string
+		defs  []string
+		want  []int
+	}{
+		{
+			name:  "independent",
+			names: []string{"a", "b"},
+			defs:  []string{"select 1 from `t`", "select 2 from `t`"},
+			want:  []int{0, 1},
+		},
+		{
+			name:  "dependency sorts later",
+			names: []string{"a_titles", "z_books"},
+			defs:  []string{"select `title` from `z_books`", "select `title` from `books`"},
+			want:  []int{1, 0},
+		},
+		{
+			name:  "chain",
+			names: []string{"a", "b", "c"},
+			defs:  []string{"select * from `b`", "select * from `c`", "select * from `t`"},
+			want:  []int{2, 1, 0},
+		},
+		{
+			// A name that only appears unquoted, as part of another name, is
+			// not a use.
+			name:  "prefix is not a use",
+			names: []string{"book_titles", "book"},
+			defs:  []string{"select * from `books`", "select * from `t`"},
+			want:  []int{0, 1},
+		},
+		{
+			name:  "cycle terminates",
+			names: []string{"a", "b"},
+			defs:  []string{"select * from `b`", "select * from `a`"},
+			want:  []int{1, 0},
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			if diff := cmp.Diff(tc.want, dependencyOrder(tc.names, tc.defs)); diff != "" {
+				t.Errorf("order differed (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
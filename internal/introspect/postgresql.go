@@ -0,0 +1,514 @@
+package introspect
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/core/seed"
+	pg "github.com/sqlc-dev/sqlc/internal/engine/postgresql"
+)
+
+// Every query below is restricted to the schemas being read, passed as $1, and
+// reports for each object the extension that created it, if any. Objects of an
+// extension sqlc knows come from CREATE EXTENSION and are left out; those of
+// one it does not go to the catalog file.
+
+const pgSchemas = `
+SELECT n.nspname
+FROM pg_catalog.pg_namespace AS n
+WHERE n.nspname NOT IN ('pg_catalog', 'information_schema')
+  AND n.nspname NOT LIKE 'pg\_%'
+  AND NOT EXISTS (
+    SELECT 1 FROM pg_catalog.pg_depend AS d
+    WHERE d.classid = 'pg_catalog.pg_namespace'::regclass AND d.objid = n.oid AND d.deptype = 'e'
+  )
+ORDER BY n.nspname
+`
+
+const pgExtensions = `
+SELECT quote_ident(e.extname), e.extname
+FROM pg_catalog.pg_extension AS e
+WHERE e.extname <> 'plpgsql'
+ORDER BY e.extname
+`
+
+// pgName is the expression for an object's name as a schema writes it, which
+// leaves the public schema unqualified.
+func pgName(nsp, name string) string {
+	return fmt.Sprintf("CASE WHEN %[1]s.nspname = 'public' THEN quote_ident(%[2]s) ELSE quote_ident(%[1]s.nspname) || '.' || quote_ident(%[2]s) END", nsp, name)
+}
+
+// pgExtension is the expression for the extension that created an object.
+func pgExtension(class, oid string) string {
+	return fmt.Sprintf(`COALESCE((
+    SELECT e.extname FROM pg_catalog.pg_depend AS d
+    JOIN pg_catalog.pg_extension AS e ON e.oid = d.refobjid
+    WHERE d.classid = '%s'::regclass AND d.objid = %s AND d.deptype = 'e'
+    LIMIT 1), '')`, class, oid)
+}
+
+var pgEnums = `
+SELECT ` + pgName("n", "t.typname") + `, ` + pgExtension("pg_catalog.pg_type", "t.oid") + `,
+  array_to_json(ARRAY(
+    SELECT l.enumlabel FROM pg_catalog.pg_enum AS l WHERE l.enumtypid = t.oid ORDER BY l.enumsortorder
+  ))::text
+FROM pg_catalog.pg_type AS t
+JOIN pg_catalog.pg_namespace AS n ON n.oid = t.typnamespace
+WHERE t.typtype = 'e' AND n.nspname = ANY($1)
+ORDER BY t.oid
+`
+
+// Sequences owned by an identity column are created by the column.
+var pgSequences = `
+SELECT ` + pgName("n", "c.relname") + `, ` + pgExtension("pg_catalog.pg_class", "c.oid") + `
+FROM pg_catalog.pg_class AS c
+JOIN pg_catalog.pg_namespace AS n ON n.oid = c.relnamespace
+WHERE c.relkind = 'S' AND n.nspname = ANY($1)
+  AND NOT EXISTS (
+    SELECT 1 FROM pg_catalog.pg_depend AS d
+    WHERE d.classid = 'pg_catalog.pg_class'::regclass AND d.objid = c.oid AND d.deptype = 'i'
+  )
+ORDER BY c.oid
+`
+
+// Partitions are left out: the parent is what queries name.
+var pgTables = `
+SELECT c.oid, ` + pgName("n", "c.relname") + `, ` + pgExtension("pg_catalog.pg_class", "c.oid") + `,
+  CASE WHEN c.relkind = 'p' THEN pg_catalog.pg_get_partkeydef(c.oid) ELSE '' END
+FROM pg_catalog.pg_class AS c
+JOIN pg_catalog.pg_namespace AS n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('r', 'p') AND NOT c.relispartition AND n.nspname = ANY($1)
+ORDER BY c.oid
+`
+
+const pgColumns = `
+SELECT a.attrelid, quote_ident(a.attname), pg_catalog.format_type(a.atttypid, a.atttypmod),
+  a.attnotnull, COALESCE(pg_catalog.pg_get_expr(ad.adbin, ad.adrelid), ''),
+  a.attidentity::text, a.attgenerated::text
+FROM pg_catalog.pg_attribute AS a
+JOIN pg_catalog.pg_class AS c ON c.oid = a.attrelid
+JOIN pg_catalog.pg_namespace AS n ON n.oid = c.relnamespace
+LEFT JOIN pg_catalog.pg_attrdef AS ad ON ad.adrelid = a.attrelid AND ad.adnum = a.attnum
+WHERE a.attnum > 0 AND NOT a.attisdropped
+  AND c.relkind IN ('r', 'p') AND n.nspname = ANY($1)
+ORDER BY a.attrelid, a.attnum
+`
+
+const pgConstraints = `
+SELECT co.conrelid, quote_ident(co.conname), co.contype::text, pg_catalog.pg_get_constraintdef(co.oid)
+FROM pg_catalog.pg_constraint AS co
+JOIN pg_catalog.pg_class AS c ON c.oid = co.conrelid
+JOIN pg_catalog.pg_namespace AS n ON n.oid = c.relnamespace
+WHERE co.contype IN ('p', 'u', 'c', 'f', 'x') AND co.conislocal
+  AND c.relkind IN ('r', 'p') AND n.nspname = ANY($1)
+ORDER BY co.conrelid, co.contype, co.conname
+`
+
+var pgViews = `
+SELECT ` + pgName("n", "c.relname") + `, ` + pgExtension("pg_catalog.pg_class", "c.oid") + `,
+  c.relkind::text, pg_catalog.pg_get_viewdef(c.oid, true)
+FROM pg_catalog.pg_class AS c
+JOIN pg_catalog.pg_namespace AS n ON n.oid = c.relnamespace
+WHERE c.relkind IN ('v', 'm') AND n.nspname = ANY($1)
+ORDER BY c.oid
+`
+
+// Functions are read twice over: as DDL for those CREATE FUNCTION can
+// declare, and as a signature for the rest. Every argument is listed, out
+// arguments included, so that the names and modes line up with the types.
+var pgFunctions = `
+SELECT ` + pgExtension("pg_catalog.pg_proc", "p.oid") + `,
+  p.prokind::text,
+  CASE WHEN p.prokind IN ('f', 'p') THEN pg_catalog.pg_get_functiondef(p.oid) ELSE '' END,
+  p.proname,
+  pg_catalog.format_type(p.prorettype, NULL),
+  array_to_json(ARRAY(
+    SELECT pg_catalog.format_type(a.t, NULL)
+    FROM unnest(COALESCE(p.proallargtypes, p.proargtypes::oid[])) WITH ORDINALITY AS a(t, i)
+    ORDER BY a.i
+  ))::text,
+  COALESCE(array_to_json(p.proargnames)::text, '[]'),
+  COALESCE(array_to_json(p.proargmodes)::text, '[]'),
+  p.pronargdefaults
+FROM pg_catalog.pg_proc AS p
+JOIN pg_catalog.pg_namespace AS n ON n.oid = p.pronamespace
+WHERE n.nspname = ANY($1)
+ORDER BY p.oid
+`
+
+// Array types are created alongside their element type, and so are the row
+// types of tables and views; neither is declared on its own.
+var pgTypes = `
+SELECT t.typname, t.typcategory::text, ` + pgExtension("pg_catalog.pg_type", "t.oid") + `
+FROM pg_catalog.pg_type AS t
+JOIN pg_catalog.pg_namespace AS n ON n.oid = t.typnamespace
+LEFT JOIN pg_catalog.pg_class AS c ON c.oid = t.typrelid
+WHERE t.typtype IN ('b', 'c', 'd', 'r', 'm')
+  AND t.typcategory <> 'A'
+  AND (c.oid IS NULL OR c.relkind = 'c')
+  AND n.nspname = ANY($1)
+ORDER BY t.oid
+`
+
+// Prefix operators have no left operand, which a catalog record cannot
+// describe.
+var pgOperators = `
+SELECT o.oprname, pg_catalog.format_type(o.oprleft, NULL), pg_catalog.format_type(o.oprright, NULL),
+  pg_catalog.format_type(o.oprresult, NULL), ` + pgExtension("pg_catalog.pg_operator", "o.oid") + `
+FROM pg_catalog.pg_operator AS o
+JOIN pg_catalog.pg_namespace AS n ON n.oid = o.oprnamespace
+WHERE o.oprleft <> 0 AND n.nspname = ANY($1)
+ORDER BY o.oid
+`
+
+// pgUncallable are the pseudo-types of functions only the server itself
+// calls, which no query can.
+var pgUncallable = map[string]bool{
+	"internal":         true,
+	"trigger":          true,
+	"event_trigger":    true,
+	"language_handler": true,
+	"fdw_handler":      true,
+	"index_am_handler": true,
+	"table_am_handler": true,
+	"tsm_handler":      true,
+}
+
+// pgReader collects a PostgreSQL schema, statement kind by statement kind, in
+// the order the statements have to be applied in.
+type pgReader struct {
+	ctx     context.Context
+	db      *sql.DB
+	schemas []string
+	res     Result
+
+	// unknown records the extensions sqlc ships no data for, whose objects
+	// go to the catalog.
+	unknown map[string]bool
+}
+
+func postgresql(ctx context.Context, db *sql.DB, opts Options) (*Result, error) {
+	r := &pgReader{ctx: ctx, db: db, schemas: opts.Schemas, unknown: map[string]bool{}}
+	if len(r.schemas) == 0 {
+		err := query(ctx, db, pgSchemas, func(rows *sql.Rows) error {
+			var name string
+			if err := rows.Scan(&name); err != nil {
+				return err
+			}
+			r.schemas = append(r.schemas, name)
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range r.schemas {
+		if name != "public" {
+			r.add("CREATE SCHEMA IF NOT EXISTS " + pgQuote(name))
+		}
+	}
+	for _, step := range []func() error{
+		r.extensions,
+		r.types,
+		r.enums,
+		r.sequences,
+		r.tables,
+		r.functions,
+		r.views,
+		r.operators,
+	} {
+		if err := step(); err != nil {
+			return nil, err
+		}
+	}
+	return &r.res, nil
+}
+
+func (r *pgReader) add(stmt string) {
+	r.res.Schema = append(r.res.Schema, stmt)
+}
+
+func (r *pgReader) query(q string, scan func(*sql.Rows) error) error {
+	return query(r.ctx, r.db, q, scan, r.schemas)
+}
+
+// inSchema reports whether an object of the given extension belongs in the
+// schema file, and otherwise whether it belongs in the catalog.
+func (r *pgReader) inSchema(extension string) (schema, catalog bool) {
+	if extension == "" {
+		return true, false
+	}
+	return false, r.unknown[extension]
+}
+
+func (r *pgReader) extensions() error {
+	return query(r.ctx, r.db, pgExtensions, func(rows *sql.Rows) error {
+		var quoted, name string
+		if err := rows.Scan(&quoted, &name); err != nil {
+			return err
+		}
+		if !pg.HasExtension(name) {
+			r.unknown[name] = true
+		}
+		r.add("CREATE EXTENSION IF NOT EXISTS " + quoted)
+		return nil
+	})
+}
+
+// types records the types no DDL in the schema file declares: base types and
+// ranges, which need functions written in C, and the domains and composite
+// types sqlc has no CREATE statement for. Each keeps the category PostgreSQL
+// files it under.
+func (r *pgReader) types() error {
+	return r.query(pgTypes, func(rows *sql.Rows) error {
+		var t seed.Type
+		var extension string
+		if err := rows.Scan(&t.Name, &t.Category, &extension); err != nil {
+			return err
+		}
+		if schema, catalog := r.inSchema(extension); schema || catalog {
+			r.res.Catalog = append(r.res.Catalog, seed.Record{Type: &t})
+		}
+		return nil
+	})
+}
+
+func (r *pgReader) enums() error {
+	return r.query(pgEnums, func(rows *sql.Rows) error {
+		var name, extension, labels string
+		if err := rows.Scan(&name, &extension, &labels); err != nil {
+			return err
+		}
+		if schema, _ := r.inSchema(extension); !schema {
+			return nil
+		}
+		var values []string
+		if err := json.Unmarshal([]byte(labels), &values); err != nil {
+			return err
+		}
+		for i, v := range values {
+			values[i] = pgLiteral(v)
+		}
+		r.add(fmt.Sprintf("CREATE TYPE %s AS ENUM (%s)", name, strings.Join(values, ", ")))
+		return nil
+	})
+}
+
+func (r *pgReader) sequences() error {
+	return r.query(pgSequences, func(rows *sql.Rows) error {
+		var name, extension string
+		if err := rows.Scan(&name, &extension); err != nil {
+			return err
+		}
+		if schema, _ := r.inSchema(extension); schema {
+			r.add("CREATE SEQUENCE " + name)
+		}
+		return nil
+	})
+}
+
+type pgTable struct {
+	name      string
+	partition string
+	elements  []string
+}
+
+// tables writes a CREATE TABLE for each table, with its columns and every
+// constraint but its foreign keys. Those are added once every table exists,
+// the way pg_dump does, so that tables that refer to one another apply.
+func (r *pgReader) tables() error {
+	tables := map[int64]*pgTable{}
+	var order []int64
+	err := r.query(pgTables, func(rows *sql.Rows) error {
+		var oid int64
+		var t pgTable
+		var extension string
+		if err := rows.Scan(&oid, &t.name, &extension, &t.partition); err != nil {
+			return err
+		}
+		if schema, _ := r.inSchema(extension); schema {
+			tables[oid] = &t
+			order = append(order, oid)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = r.query(pgColumns, func(rows *sql.Rows) error {
+		var oid int64
+		var name, typ, def, identity, generated string
+		var notNull bool
+		if err := rows.Scan(&oid, &name, &typ, &notNull, &def, &identity, &generated); err != nil {
+			return err
+		}
+		t, ok := tables[oid]
+		if !ok {
+			return nil
+		}
+		col := name + " " + typ
+		switch {
+		case identity == "a":
+			col += " GENERATED ALWAYS AS IDENTITY"
+		case identity == "d":
+			col += " GENERATED BY DEFAULT AS IDENTITY"
+		case generated == "s":
+			col += " GENERATED ALWAYS AS (" + def + ") STORED"
+		case def != "":
+			col += " DEFAULT " + def
+		}
+		if notNull && identity == "" {
+			col += " NOT NULL"
+		}
+		t.elements = append(t.elements, col)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	var foreignKeys []string
+	err = r.query(pgConstraints, func(rows *sql.Rows) error {
+		var oid int64
+		var name, kind, def string
+		if err := rows.Scan(&oid, &name, &kind, &def); err != nil {
+			return err
+		}
+		t, ok := tables[oid]
+		if !ok {
+			return nil
+		}
+		if kind == "f" {
+			foreignKeys = append(foreignKeys, fmt.Sprintf("ALTER TABLE %s ADD CONSTRAINT %s %s", t.name, name, def))
+			return nil
+		}
+		t.elements = append(t.elements, "CONSTRAINT "+name+" "+def)
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, oid := range order {
+		t := tables[oid]
+		stmt := "CREATE TABLE " + t.name + " (\n    " + strings.Join(t.elements, ",\n    ") + "\n)"
+		if t.partition != "" {
+			stmt += " PARTITION BY " + t.partition
+		}
+		r.add(stmt)
+	}
+	for _, fk := range foreignKeys {
+		r.add(fk)
+	}
+	return nil
+}
+
+// functions writes the DDL of the functions and procedures the schema
+// defines, and records the signatures of the rest: those of extensions sqlc
+// does not know, and aggregates, whose DDL names functions that are not worth
+// recreating.
+func (r *pgReader) functions() error {
+	return r.query(pgFunctions, func(rows *sql.Rows) error {
+		var extension, kind, def, argTypes, argNames, argModes string
+		var fn seed.Function
+		var defaults int
+		if err := rows.Scan(&extension, &kind, &def, &fn.Name, &fn.Returns,
+			&argTypes, &argNames, &argModes, &defaults); err != nil {
+			return err
+		}
+		schema, catalog := r.inSchema(extension)
+		if schema && def != "" {
+			r.add(def)
+			return nil
+		}
+		if !schema && !catalog {
+			return nil
+		}
+
+		var types, names, modes []string
+		for _, list := range []struct {
+			src string
+			dst *[]string
+		}{{argTypes, &types}, {argNames, &names}, {argModes, &modes}} {
+			if err := json.Unmarshal([]byte(list.src), list.dst); err != nil {
+				return err
+			}
+		}
+		if pgUncallable[fn.Returns] {
+			return nil
+		}
+		if kind != "f" {
+			fn.Kind = kind
+		}
+		for i, typ := range types {
+			if pgUncallable[typ] {
+				return nil
+			}
+			arg := seed.Arg{Type: typ}
+			if i < len(names) {
+				arg.Name = names[i]
+			}
+			if i < len(modes) && modes[i] != "i" {
+				arg.Mode = modes[i]
+			}
+			fn.Args = append(fn.Args, arg)
+		}
+		// The defaults belong to the trailing input arguments.
+		for i := len(fn.Args) - 1; i >= 0 && defaults > 0; i-- {
+			switch fn.Args[i].Mode {
+			case "o", "t":
+				continue
+			}
+			fn.Args[i].HasDefault = true
+			defaults--
+		}
+		r.res.Catalog = append(r.res.Catalog, seed.Record{Function: &fn})
+		return nil
+	})
+}
+
+func (r *pgReader) views() error {
+	return r.query(pgViews, func(rows *sql.Rows) error {
+		var name, extension, kind, def string
+		if err := rows.Scan(&name, &extension, &kind, &def); err != nil {
+			return err
+		}
+		if schema, _ := r.inSchema(extension); !schema {
+			return nil
+		}
+		create := "CREATE VIEW "
+		if kind == "m" {
+			create = "CREATE MATERIALIZED VIEW "
+		}
+		r.add(create + name + " AS\n" + strings.TrimSuffix(strings.TrimSpace(def), ";"))
+		return nil
+	})
+}
+
+// operators records the operators the schema defines. sqlc has no CREATE
+// OPERATOR, so they all go to the catalog, after the types they name.
+func (r *pgReader) operators() error {
+	return r.query(pgOperators, func(rows *sql.Rows) error {
+		var op seed.Operator
+		var extension string
+		if err := rows.Scan(&op.Name, &op.Left, &op.Right, &op.Result, &extension); err != nil {
+			return err
+		}
+		if schema, catalog := r.inSchema(extension); schema || catalog {
+			r.res.Catalog = append(r.res.Catalog, seed.Record{Operator: &op})
+		}
+		return nil
+	})
+}
+
+func pgQuote(name string) string {
+	return `"` + strings.ReplaceAll(name, `"`, `""`) + `"`
+}
+
+func pgLiteral(s string) string {
+	return "'" + strings.ReplaceAll(s, "'", "''") + "'"
+}
@@ -0,0 +1,144 @@
+//go:build examples
+
+package introspect_test
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"testing"
+
+	_ "github.com/go-sql-driver/mysql"
+	"github.com/google/go-cmp/cmp"
+	_ "github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/core/seed"
+	"github.com/sqlc-dev/sqlc/internal/introspect"
+	"github.com/sqlc-dev/sqlc/internal/sqltest/local"
+)
+
+// open connects to a test database and runs stmts in it.
+func open(t *testing.T, driver, uri string, stmts []string) *sql.DB {
+	t.Helper()
+	db, err := sql.Open(driver, uri)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { db.Close() })
+	for _, stmt := range stmts {
+		if _, err := db.Exec(stmt); err != nil {
+			t.Fatalf("%s: %s", stmt, err)
+		}
+	}
+	return db
+}
+
+// position returns the index of the one statement that starts with prefix.
+func position(t *testing.T, schema []string, prefix string) int {
+	t.Helper()
+	found := -1
+	for i, stmt := range schema {
+		if strings.HasPrefix(stmt, prefix) {
+			if found >= 0 {
+				t.Fatalf("more than one statement starts with %q", prefix)
+			}
+			found = i
+		}
+	}
+	if found < 0 {
+		t.Fatalf("no statement starts with %q in:\n%s", prefix, strings.Join(schema, "\n\n"))
+	}
+	return found
+}
+
+func TestPostgreSQL(t *testing.T) {
+	uri := local.PostgreSQL(t, nil)
+	db := open(t, "pgx", uri, []string{
+		"CREATE TYPE status AS ENUM ('draft', 'published')",
+		"CREATE TABLE authors (id bigint GENERATED ALWAYS AS IDENTITY PRIMARY KEY, name text NOT NULL UNIQUE)",
+		"CREATE TABLE books (id bigserial PRIMARY KEY, author_id bigint NOT NULL REFERENCES authors (id), title text NOT NULL, state status NOT NULL DEFAULT 'draft')",
+		"CREATE VIEW published AS SELECT id, author_id, title FROM books WHERE state = 'published'",
+		"CREATE VIEW published_authors AS SELECT DISTINCT a.name FROM authors a JOIN published p ON p.author_id = a.id",
+		"CREATE FUNCTION book_count(author bigint, include_drafts boolean DEFAULT false) RETURNS bigint LANGUAGE sql AS $$ SELECT count(*) FROM books WHERE author_id = author AND (include_drafts OR state = 'published') $$",
+		"CREATE AGGREGATE total(integer) (sfunc = int4pl, stype = integer)",
+	})
+
+	res, err := introspect.Introspect(context.Background(), config.EnginePostgreSQL, db, introspect.Options{Schemas: []string{"public"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	enum := position(t, res.Schema, "CREATE TYPE status AS ENUM ('draft', 'published')")
+	authors := position(t, res.Schema, "CREATE TABLE authors (")
+	books := position(t, res.Schema, "CREATE TABLE books (")
+	fk := position(t, res.Schema, "ALTER TABLE books ADD CONSTRAINT books_author_id_fkey FOREIGN KEY (author_id) REFERENCES authors(id)")
+	fn := position(t, res.Schema, "CREATE OR REPLACE FUNCTION public.book_count(author bigint, include_drafts boolean DEFAULT false)")
+	published := position(t, res.Schema, "CREATE VIEW published AS")
+	publishedAuthors := position(t, res.Schema, "CREATE VIEW published_authors AS")
+	for _, order := range [][2]int{{enum, books}, {authors, fk}, {books, fk}, {books, fn}, {books, published}, {published, publishedAuthors}} {
+		if order[0] >= order[1] {
+			t.Errorf("statement %d must come before statement %d:\n%s\n\n%s", order[0], order[1], res.Schema[order[0]], res.Schema[order[1]])
+		}
+	}
+	if !strings.Contains(res.Schema[authors], "id bigint GENERATED ALWAYS AS IDENTITY") {
+		t.Errorf("identity column not kept:\n%s", res.Schema[authors])
+	}
+
+	// Aggregates are not recreated, only recorded in the catalog.
+	want := []seed.Record{
+		{Function: &seed.Function{Name: "total", Kind: "a", Args: []seed.Arg{{Type: "integer"}}, Returns: "integer"}},
+	}
+	if diff := cmp.Diff(want, res.Catalog); diff != "" {
+		t.Errorf("catalog differed (-want +got):\n%s", diff)
+	}
+}
+
+func TestMySQL(t *testing.T) {
+	uri := local.MySQL(t, nil)
+	db := open(t, "mysql", uri, []string{
+		"CREATE TABLE authors (id INT AUTO_INCREMENT PRIMARY KEY, name VARCHAR(100) NOT NULL)",
+		"CREATE TABLE books (id INT AUTO_INCREMENT PRIMARY KEY, author_id INT NOT NULL, title VARCHAR(200) NOT NULL)",
+		"INSERT INTO authors (name) VALUES ('Ursula'), ('Octavia')",
+		// a_titles sorts before the view it selects from.
+		"CREATE VIEW z_books AS SELECT id, title FROM books",
+		"CREATE VIEW a_titles AS SELECT title FROM z_books",
+		"CREATE FUNCTION book_count(p_author INT) RETURNS INT DETERMINISTIC READS SQL DATA RETURN (SELECT COUNT(*) FROM books WHERE author_id = p_author)",
+		"CREATE PROCEDURE add_book(IN p_author INT, IN p_title VARCHAR(200), OUT p_id INT) BEGIN INSERT INTO books (author_id, title) VALUES (p_author, p_title); SET p_id = LAST_INSERT_ID(); END",
+	})
+
+	res, err := introspect.Introspect(context.Background(), config.EngineMySQL, db, introspect.Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	authors := position(t, res.Schema, "CREATE TABLE `authors`")
+	if !strings.Contains(res.Schema[authors], "AUTO_INCREMENT") {
+		t.Errorf("AUTO_INCREMENT column attribute lost:\n%s", res.Schema[authors])
+	}
+	for _, stmt := range res.Schema {
+		if strings.Contains(stmt, "AUTO_INCREMENT=") {
+			t.Errorf("AUTO_INCREMENT table option not stripped:\n%s", stmt)
+		}
+		if strings.Contains(stmt, "`sqlc_test_") {
+			t.Errorf("statement names the database:\n%s", stmt)
+		}
+	}
+	books := position(t, res.Schema, "CREATE VIEW `z_books` AS ")
+	titles := position(t, res.Schema, "CREATE VIEW `a_titles` AS ")
+	if books >= titles {
+		t.Errorf("z_books must come before a_titles, which selects from it")
+	}
+
+	want := []seed.Record{
+		{Function: &seed.Function{Name: "add_book", Kind: "p", Args: []seed.Arg{
+			{Name: "p_author", Type: "int"},
+			{Name: "p_title", Type: "varchar(200)"},
+			{Name: "p_id", Type: "int", Mode: "o"},
+		}}},
+		{Function: &seed.Function{Name: "book_count", Args: []seed.Arg{{Name: "p_author", Type: "int"}}, Returns: "int"}},
+	}
+	if diff := cmp.Diff(want, res.Catalog); diff != "" {
+		t.Errorf("catalog differed (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,42 @@
+package introspect
+
+import (
+	"context"
+	"database/sql"
+)
+
+// SQLite keeps the CREATE statement of every table, view, index and trigger
+// as it was written. pragma_table_list tells tables apart from the shadow
+// tables a virtual table keeps its data in, which have DDL of their own but
+// are created by the virtual table rather than by the schema. Indexes and
+// triggers come after every table and view, since a trigger's body can name
+// any of them. Indexes SQLite creates for PRIMARY KEY and UNIQUE constraints
+// have no DDL and are left to the table that declares them.
+const sqliteSchema = `
+SELECT s.sql
+FROM sqlite_schema AS s
+JOIN pragma_table_list AS t ON t.schema = 'main' AND t.name = s.tbl_name
+WHERE s.type IN ('table', 'view', 'index', 'trigger')
+  AND t.type IN ('table', 'view', 'virtual')
+  AND s.name NOT LIKE 'sqlite\_%' ESCAPE '\'
+  AND s.sql IS NOT NULL
+ORDER BY CASE s.type WHEN 'index' THEN 1 WHEN 'trigger' THEN 2 ELSE 0 END, s.rowid
+`
+
+// sqlite reads a SQLite database. It has no catalog of functions or types to
+// speak of, so the whole of its schema is DDL.
+func sqlite(ctx context.Context, db *sql.DB) (*Result, error) {
+	res := &Result{}
+	err := query(ctx, db, sqliteSchema, func(rows *sql.Rows) error {
+		var stmt string
+		if err := rows.Scan(&stmt); err != nil {
+			return err
+		}
+		res.Schema = append(res.Schema, stmt)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return res, nil
+}
@@ -19,6 +19,9 @@ type Metadata struct {
 	Params   map[string]string
 	Flags    map[string]bool
 
+	// Columns holds the @column annotations, keyed by result column name.
+	Columns map[string]string
+
 	// RuleSkiplist contains the names of rules to disable vetting for.
 	// If the map is empty, but the disable vet flag is specified, then all rules are ignored.
 	RuleSkiplist map[string]struct{}
@@ -37,6 +40,8 @@ const (
 	CmdBatchExec  = ":batchexec"
 	CmdBatchMany  = ":batchmany"
 	CmdBatchOne   = ":batchone"
+	CmdPaginate   = ":paginate"
+	CmdBulkExec   = ":bulkexec"
 )
 
 // A query name must be a valid Go identifier
@@ -106,7 +111,7 @@ func ParseQueryNameAndType(t string, commentStyle CommentSyntax) (string, string
 		queryName := part[2]
 		queryType := strings.TrimSpace(part[3])
 		switch queryType {
-		case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows, CmdExecLastId, CmdCopyFrom, CmdBatchExec, CmdBatchMany, CmdBatchOne:
+		case CmdOne, CmdMany, CmdExec, CmdExecResult, CmdExecRows, CmdExecLastId, CmdCopyFrom, CmdBatchExec, CmdBatchMany, CmdBatchOne, CmdPaginate, CmdBulkExec:
 		default:
 			return "", "", fmt.Errorf("invalid query type: %s", queryType)
 		}
@@ -147,6 +152,10 @@ func ParseCommentFlags(comments []string) (map[string]string, map[string]bool, m
 			}
 			params[name] = strings.Join(rest, " ")
 
+		case constants.QueryFlagColumn:
+			// Read by ParseCommentColumns.
+			continue
+
 		case constants.QueryFlagSqlcVetDisable:
 			flags[token] = true
 
@@ -170,3 +179,73 @@ func ParseCommentFlags(comments []string) (map[string]string, map[string]bool, m
 
 	return params, flags, ruleSkiplist, nil
 }
+
+// ParseCommentColumns collects the @column annotations in the comments provided
+// with a query, keyed by the name of the result column each one annotates.
+func ParseCommentColumns(comments []string) (map[string]string, error) {
+	columns := make(map[string]string)
+	for _, line := range comments {
+		s := bufio.NewScanner(strings.NewReader(line))
+		s.Split(bufio.ScanWords)
+
+		s.Scan()
+		if s.Text() != constants.QueryFlagColumn {
+			continue
+		}
+		s.Scan()
+		name := s.Text()
+		var rest []string
+		for s.Scan() {
+			rest = append(rest, s.Text())
+		}
+		if s.Err() != nil {
+			return columns, s.Err()
+		}
+		if name == "" {
+			return columns, fmt.Errorf("%s: missing column name", constants.QueryFlagColumn)
+		}
+		columns[name] = strings.Join(rest, " ")
+	}
+	return columns, nil
+}
+
+// TypeOverride is what an @param or @column annotation asks of a single
+// parameter or result column: a type to use in place of the inferred one, a
+// nullability, or both.
+type TypeOverride struct {
+	// Type is written as the annotation has it. It names either a database
+	// type or a type in the target language, and only the plugin generating
+	// code knows which.
+	Type string
+
+	// Nullable is nil when the annotation leaves nullability as inferred.
+	Nullable *bool
+}
+
+// ParseTypeOverride parses the part of an @param or @column annotation that
+// follows the name, e.g. "github.com/acme/ids.UserID not null" or "nullable".
+func ParseTypeOverride(spec string) (TypeOverride, error) {
+	var o TypeOverride
+	nullable, notNull := true, false
+	words := strings.Fields(spec)
+	for i := 0; i < len(words); i++ {
+		word := words[i]
+		switch {
+		case strings.EqualFold(word, "nullable"), strings.EqualFold(word, "null"):
+			o.Nullable = &nullable
+		case strings.EqualFold(word, "not") && i+1 < len(words) && strings.EqualFold(words[i+1], "null"):
+			i++
+			fallthrough
+		case strings.EqualFold(word, "notnull"):
+			o.Nullable = &notNull
+		case o.Type == "":
+			o.Type = word
+		default:
+			return o, fmt.Errorf("invalid type override %q: more than one type", spec)
+		}
+	}
+	if o.Type == "" && o.Nullable == nil {
+		return o, fmt.Errorf("invalid type override %q: expected a type, \"nullable\" or \"not null\"", spec)
+	}
+	return o, nil
+}
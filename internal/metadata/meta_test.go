@@ -1,6 +1,7 @@
 package metadata
 
 import (
+	"fmt"
 	"testing"
 )
 
@@ -180,3 +181,62 @@ func TestParseQueryRuleSkiplist(t *testing.T) {
 		}
 	}
 }
+
+func TestParseQueryColumns(t *testing.T) {
+	columns, err := ParseCommentColumns([]string{
+		" name: GetFoo :one",
+		" @column created_at nullable",
+		" @param foo_id UUID",
+		" @column foo_id github.com/acme/ids.FooID not null ",
+	})
+	if err != nil {
+		t.Fatalf("expected comments to parse, got err: %s", err)
+	}
+	if len(columns) != 2 {
+		t.Errorf("expected 2 columns, got %d", len(columns))
+	}
+	if columns["created_at"] != "nullable" {
+		t.Errorf("unexpected column metadata: %q", columns["created_at"])
+	}
+	if columns["foo_id"] != "github.com/acme/ids.FooID not null" {
+		t.Errorf("unexpected column metadata: %q", columns["foo_id"])
+	}
+}
+
+func TestParseTypeOverride(t *testing.T) {
+	for spec, want := range map[string]struct {
+		typ      string
+		nullable string
+	}{
+		"UUID":                         {"UUID", ""},
+		"nullable":                     {"", "true"},
+		"not null":                     {"", "false"},
+		"NOT NULL":                     {"", "false"},
+		"github.com/acme/ids.FooID":    {"github.com/acme/ids.FooID", ""},
+		"text nullable":                {"text", "true"},
+		"time.Time not null":           {"time.Time", "false"},
+		"not null github.com/a/b.Type": {"github.com/a/b.Type", "false"},
+	} {
+		o, err := ParseTypeOverride(spec)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %s", spec, err)
+			continue
+		}
+		nullable := ""
+		if o.Nullable != nil {
+			nullable = fmt.Sprint(*o.Nullable)
+		}
+		if o.Type != want.typ || nullable != want.nullable {
+			t.Errorf("%q: got (%q, %q), want (%q, %q)", spec, o.Type, nullable, want.typ, want.nullable)
+		}
+	}
+
+	for _, spec := range []string{
+		"",
+		"text bigint",
+	} {
+		if _, err := ParseTypeOverride(spec); err == nil {
+			t.Errorf("expected invalid type override: %q", spec)
+		}
+	}
+}
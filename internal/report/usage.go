@@ -0,0 +1,354 @@
+// Package report cross-references the queries sqlc compiles with the schema
+// they run against.
+package report
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/compiler"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/astutils"
+)
+
+// Usage is the usage report of one sql block of a configuration.
+type Usage struct {
+	Name   string   `json:"name"`
+	Tables []*Table `json:"tables"`
+}
+
+// Table is how the queries of a sql block use a table or view.
+type Table struct {
+	Schema string `json:"schema"`
+	Name   string `json:"name"`
+	// Read is set when a query selects from the table, and Written when one
+	// inserts into, updates or deletes from it.
+	Read    bool      `json:"read"`
+	Written bool      `json:"written"`
+	Queries []string  `json:"queries"`
+	Columns []*Column `json:"columns"`
+}
+
+// Column is how the queries of a sql block use a column.
+type Column struct {
+	Name    string   `json:"name"`
+	Queries []string `json:"queries"`
+}
+
+// Unused reports whether no query refers to the table.
+func (t *Table) Unused() bool {
+	return len(t.Queries) == 0
+}
+
+// WriteOnly reports whether queries write to the table but never read it.
+func (t *Table) WriteOnly() bool {
+	return t.Written && !t.Read
+}
+
+// systemSchemas hold the tables the database provides rather than the schema
+// declares.
+var systemSchemas = map[string]bool{
+	"pg_catalog":         true,
+	"information_schema": true,
+}
+
+// Analyze reports which tables and columns of the result's catalog its
+// queries use.
+//
+// A column is used by a query when the query names it anywhere, selects it
+// through *, writes it, or embeds its table. A column name that is not
+// qualified with its table counts for every table in the query that has a
+// column of that name, and names are compared without regard to case, so
+// that a column the report calls unused is one no query can refer to. The
+// queries of a view's definition are not followed: a column only a view
+// selects is unused unless a query uses the view's column.
+func Analyze(name string, res *compiler.Result) *Usage {
+	u := &Usage{Name: name}
+	tables := map[string]*Table{}
+	for _, schema := range res.Catalog.Schemas {
+		if systemSchemas[schema.Name] {
+			continue
+		}
+		for _, t := range schema.Tables {
+			tbl := &Table{Schema: schema.Name, Name: t.Rel.Name, Queries: []string{}}
+			for _, c := range t.Columns {
+				tbl.Columns = append(tbl.Columns, &Column{Name: c.Name, Queries: []string{}})
+			}
+			u.Tables = append(u.Tables, tbl)
+			tables[tableKey(schema.Name, t.Rel.Name)] = tbl
+		}
+	}
+	sort.Slice(u.Tables, func(i, j int) bool {
+		if u.Tables[i].Schema != u.Tables[j].Schema {
+			return u.Tables[i].Schema < u.Tables[j].Schema
+		}
+		return u.Tables[i].Name < u.Tables[j].Name
+	})
+
+	for _, q := range res.Queries {
+		if q.RawStmt == nil {
+			continue
+		}
+		s := &scan{
+			catalog: res.Catalog.DefaultSchema,
+			tables:  tables,
+			byName:  map[string]*Table{},
+			used:    map[*Column]bool{},
+			read:    map[*Table]bool{},
+			written: map[*Table]bool{},
+		}
+		s.query(q)
+		for _, t := range u.Tables {
+			touched := s.read[t] || s.written[t]
+			for _, c := range t.Columns {
+				if s.used[c] {
+					c.Queries = append(c.Queries, q.Metadata.Name)
+					touched = true
+				}
+			}
+			if touched {
+				t.Queries = append(t.Queries, q.Metadata.Name)
+			}
+			t.Read = t.Read || s.read[t]
+			t.Written = t.Written || s.written[t]
+		}
+	}
+	return u
+}
+
+func tableKey(schema, name string) string {
+	return strings.ToLower(schema + "." + name)
+}
+
+// scan collects what one query uses.
+type scan struct {
+	catalog string
+	tables  map[string]*Table
+	// byName maps the names a query refers to its tables by, their aliases
+	// and their own names, to the tables.
+	byName  map[string]*Table
+	used    map[*Column]bool
+	read    map[*Table]bool
+	written map[*Table]bool
+}
+
+func (s *scan) query(q *compiler.Query) {
+	stmt := q.RawStmt.Stmt
+
+	// The tables a statement writes are found first, so that every other
+	// reference to a table is a read.
+	targets := map[*ast.RangeVar]bool{}
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		switch n := node.(type) {
+		case *ast.InsertStmt:
+			targets[n.Relation] = true
+			t := s.table(n.Relation)
+			if items(n.Cols) {
+				s.names(t, n.Cols)
+			} else {
+				s.all(t)
+			}
+			if n.OnConflictClause != nil {
+				s.names(t, n.OnConflictClause.TargetList)
+			}
+			if n.OnDuplicateKeyUpdate != nil {
+				s.names(t, n.OnDuplicateKeyUpdate.TargetList)
+			}
+			s.returning(t, n.ReturningList)
+		case *ast.UpdateStmt:
+			for _, rv := range rangeVars(n.Relations) {
+				targets[rv] = true
+				t := s.table(rv)
+				s.names(t, n.TargetList)
+				s.returning(t, n.ReturningList)
+			}
+		case *ast.DeleteStmt:
+			for _, rv := range append(rangeVars(n.Relations), rangeVars(n.Targets)...) {
+				targets[rv] = true
+				s.returning(s.table(rv), n.ReturningList)
+			}
+		}
+	}), stmt)
+	for rv := range targets {
+		if t := s.table(rv); t != nil {
+			s.written[t] = true
+		}
+	}
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		if rv, ok := node.(*ast.RangeVar); ok && !targets[rv] {
+			if t := s.table(rv); t != nil {
+				s.read[t] = true
+			}
+		}
+	}), stmt)
+
+	astutils.Walk(astutils.VisitorFunc(func(node ast.Node) {
+		switch n := node.(type) {
+		case *ast.ColumnRef:
+			s.columnRef(n)
+		case *ast.JoinExpr:
+			if n.UsingClause != nil {
+				for _, item := range n.UsingClause.Items {
+					if str, ok := item.(*ast.String); ok {
+						s.unqualified(str.Str)
+					}
+				}
+			}
+		}
+	}), stmt)
+
+	// The compiler has resolved the query's output columns and parameters,
+	// including those of embedded tables.
+	for _, c := range q.Columns {
+		s.resolved(c)
+	}
+	for _, p := range q.Params {
+		s.resolved(p.Column)
+	}
+}
+
+// table returns the table a range variable names, or nil for a CTE or
+// anything else the catalog has no table for, and records the names the
+// query can refer to it by.
+func (s *scan) table(rv *ast.RangeVar) *Table {
+	if rv == nil || rv.Relname == nil {
+		return nil
+	}
+	schema := s.catalog
+	if rv.Schemaname != nil {
+		schema = *rv.Schemaname
+	}
+	t := s.tables[tableKey(schema, *rv.Relname)]
+	if t == nil {
+		return nil
+	}
+	s.byName[strings.ToLower(*rv.Relname)] = t
+	if rv.Alias != nil && rv.Alias.Aliasname != nil {
+		s.byName[strings.ToLower(*rv.Alias.Aliasname)] = t
+	}
+	return t
+}
+
+func (s *scan) columnRef(ref *ast.ColumnRef) {
+	var fields []string
+	star := false
+	if ref.Fields != nil {
+		for _, f := range ref.Fields.Items {
+			switch f := f.(type) {
+			case *ast.String:
+				fields = append(fields, f.Str)
+			case *ast.A_Star:
+				star = true
+			}
+		}
+	}
+	if len(fields) == 0 && ref.Name != "" {
+		fields = []string{ref.Name}
+	}
+	switch {
+	case star && len(fields) == 0:
+		for t := range s.read {
+			s.all(t)
+		}
+	case star:
+		s.all(s.byName[strings.ToLower(fields[len(fields)-1])])
+	case len(fields) == 1:
+		s.unqualified(fields[0])
+	case len(fields) > 1:
+		t := s.byName[strings.ToLower(fields[len(fields)-2])]
+		s.column(t, fields[len(fields)-1])
+	}
+}
+
+// resolved records a column the compiler resolved to a table.
+func (s *scan) resolved(c *compiler.Column) {
+	if c == nil {
+		return
+	}
+	if c.EmbedTable != nil {
+		s.all(s.lookup(c.EmbedTable))
+		return
+	}
+	if c.Table == nil {
+		return
+	}
+	name := c.OriginalName
+	if name == "" {
+		name = c.Name
+	}
+	s.column(s.lookup(c.Table), name)
+}
+
+func (s *scan) lookup(rel *ast.TableName) *Table {
+	schema := rel.Schema
+	if schema == "" {
+		schema = s.catalog
+	}
+	return s.tables[tableKey(schema, rel.Name)]
+}
+
+// unqualified records a column name that could belong to any of the query's
+// tables.
+func (s *scan) unqualified(name string) {
+	for _, t := range s.byName {
+		s.column(t, name)
+	}
+}
+
+func (s *scan) column(t *Table, name string) {
+	if t == nil {
+		return
+	}
+	for _, c := range t.Columns {
+		if strings.EqualFold(c.Name, name) {
+			s.used[c] = true
+		}
+	}
+}
+
+func (s *scan) all(t *Table) {
+	if t == nil {
+		return
+	}
+	for _, c := range t.Columns {
+		s.used[c] = true
+	}
+}
+
+// names records the columns of a list of ResTargets, which name the columns
+// an INSERT or an UPDATE sets.
+func (s *scan) names(t *Table, list *ast.List) {
+	if list == nil {
+		return
+	}
+	for _, item := range list.Items {
+		if rt, ok := item.(*ast.ResTarget); ok && rt.Name != nil {
+			s.column(t, *rt.Name)
+		}
+	}
+}
+
+// returning records that a statement that returns rows reads the table it
+// writes to.
+func (s *scan) returning(t *Table, list *ast.List) {
+	if t != nil && items(list) {
+		s.read[t] = true
+	}
+}
+
+func rangeVars(list *ast.List) []*ast.RangeVar {
+	var rvs []*ast.RangeVar
+	if list == nil {
+		return nil
+	}
+	for _, item := range list.Items {
+		if rv, ok := item.(*ast.RangeVar); ok {
+			rvs = append(rvs, rv)
+		}
+	}
+	return rvs
+}
+
+func items(list *ast.List) bool {
+	return list != nil && len(list.Items) > 0
+}
@@ -0,0 +1,97 @@
+package report
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// WriteText writes the parts of the reports a person reading them wants:
+// the tables and columns no query uses, the tables queries only write, and
+// the queries that use each table. Each report is headed with its name when
+// there is more than one.
+func WriteText(w io.Writer, reports []*Usage) error {
+	var b strings.Builder
+	for i, u := range reports {
+		if len(reports) > 1 {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "# %s\n\n", u.Name)
+		}
+		section := func(title string, lines []string) {
+			fmt.Fprintf(&b, "%s:\n", title)
+			if len(lines) == 0 {
+				b.WriteString("  (none)\n")
+			}
+			for _, line := range lines {
+				fmt.Fprintf(&b, "  %s\n", line)
+			}
+			b.WriteString("\n")
+		}
+
+		var unusedTables, unusedColumns, writeOnly, perTable []string
+		for _, t := range u.Tables {
+			name := t.Schema + "." + t.Name
+			if t.Unused() {
+				unusedTables = append(unusedTables, name)
+			} else {
+				for _, c := range t.Columns {
+					if len(c.Queries) == 0 {
+						unusedColumns = append(unusedColumns, name+"."+c.Name)
+					}
+				}
+			}
+			if t.WriteOnly() {
+				writeOnly = append(writeOnly, name)
+			}
+			line := fmt.Sprintf("%s (%d)", name, len(t.Queries))
+			if len(t.Queries) > 0 {
+				line += ": " + strings.Join(t.Queries, ", ")
+			}
+			perTable = append(perTable, line)
+		}
+		section("Unused tables", unusedTables)
+		section("Unused columns", unusedColumns)
+		section("Tables only written", writeOnly)
+		section("Queries per table", perTable)
+	}
+	_, err := io.WriteString(w, strings.TrimSuffix(b.String(), "\n"))
+	return err
+}
+
+// WriteJSON writes the reports as a JSON array.
+func WriteJSON(w io.Writer, reports []*Usage) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(reports)
+}
+
+// WriteCSV writes a row for every table, with an empty column, and a row for
+// every column. The queries that use one are separated by spaces.
+func WriteCSV(w io.Writer, reports []*Usage) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write([]string{"sql", "schema", "table", "column", "read", "written", "queries"}); err != nil {
+		return err
+	}
+	for _, u := range reports {
+		for _, t := range u.Tables {
+			read, written := strconv.FormatBool(t.Read), strconv.FormatBool(t.Written)
+			row := []string{u.Name, t.Schema, t.Name, "", read, written, strings.Join(t.Queries, " ")}
+			if err := cw.Write(row); err != nil {
+				return err
+			}
+			for _, c := range t.Columns {
+				row := []string{u.Name, t.Schema, t.Name, c.Name, "", "", strings.Join(c.Queries, " ")}
+				if err := cw.Write(row); err != nil {
+					return err
+				}
+			}
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
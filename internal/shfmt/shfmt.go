@@ -1,23 +1,53 @@
 package shfmt
 
 import (
+	"fmt"
 	"os"
 	"regexp"
 	"strings"
 )
 
-var pat = regexp.MustCompile(`\$\{[A-Z_]+\}`)
+var pat = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-[^}]*)?\}`)
 
 type Replacer struct {
 	envmap map[string]string
 }
 
+// Replace expands the ${VAR} and ${VAR:-default} references in f. A variable
+// with no value and no default expands to the empty string.
 func (r *Replacer) Replace(f string) string {
-	return pat.ReplaceAllStringFunc(f, func(s string) string {
-		s = strings.TrimPrefix(s, "${")
-		s = strings.TrimSuffix(s, "}")
-		return r.envmap[s]
+	s, _ := r.Expand(f)
+	return s
+}
+
+// Expand is Replace, also returning the names of the variables f refers to
+// that have no value and no default, in the order they appear. As in the
+// shell, a default is used when a variable is unset or empty.
+func (r *Replacer) Expand(f string) (string, []string) {
+	var missing []string
+	s := pat.ReplaceAllStringFunc(f, func(s string) string {
+		m := pat.FindStringSubmatch(s)
+		name, def := m[1], m[2]
+		if v, ok := r.envmap[name]; ok && (v != "" || def == "") {
+			return v
+		}
+		if def != "" {
+			return strings.TrimPrefix(def, ":-")
+		}
+		missing = append(missing, name)
+		return ""
 	})
+	return s, missing
+}
+
+// ReplaceStrict is Replace, returning an error that lists the variables f
+// refers to that have no value and no default.
+func (r *Replacer) ReplaceStrict(f string) (string, error) {
+	s, missing := r.Expand(f)
+	if len(missing) > 0 {
+		return "", fmt.Errorf("undefined environment variables: %s", strings.Join(missing, ", "))
+	}
+	return s, nil
 }
 
 func NewReplacer(env []string) *Replacer {
@@ -1,6 +1,9 @@
 package shfmt
 
-import "testing"
+import (
+	"strings"
+	"testing"
+)
 
 func TestReplace(t *testing.T) {
 	s := "POSTGRES_SQL://${PG_USER}:${PG_PASSWORD}@${PG_HOST}:${PG_PORT}/AUTHORS"
@@ -15,3 +18,31 @@ func TestReplace(t *testing.T) {
 		t.Errorf("%s != %s", v, e)
 	}
 }
+
+func TestExpand(t *testing.T) {
+	r := NewReplacer([]string{
+		"PG_HOST=host",
+		"PG_PORT=",
+		"SQLC_AUTH_TOKEN=sqlc_secret",
+	})
+	for _, test := range []struct {
+		in      string
+		out     string
+		missing []string
+	}{
+		{"${PG_HOST}:${PG_PORT:-5432}", "host:5432", nil},
+		{"${PG_PORT}", "", nil},
+		{"${pg_user:-postgres}@${PG_HOST:-localhost}", "postgres@host", nil},
+		{"${PG_USER}:${PG_PASSWORD}@${PG_HOST}", ":@host", []string{"PG_USER", "PG_PASSWORD"}},
+		{"${SQLC_AUTH_TOKEN}", "", []string{"SQLC_AUTH_TOKEN"}},
+		{"$PG_HOST ${1X}", "$PG_HOST ${1X}", nil},
+	} {
+		out, missing := r.Expand(test.in)
+		if out != test.out {
+			t.Errorf("Expand(%q) = %q, want %q", test.in, out, test.out)
+		}
+		if strings.Join(missing, ",") != strings.Join(test.missing, ",") {
+			t.Errorf("Expand(%q) missing %v, want %v", test.in, missing, test.missing)
+		}
+	}
+}
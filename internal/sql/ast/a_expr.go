@@ -27,8 +27,17 @@ func (n *A_Expr) isNamedParam() (string, bool) {
 	if set(n.Lexpr) || !set(n.Rexpr) {
 		return "", false
 	}
-	if nameStr, ok := n.Rexpr.(*String); ok {
-		return nameStr.Str, true
+	switch rexpr := n.Rexpr.(type) {
+	case *String:
+		return rexpr.Str, true
+	case *ColumnRef:
+		// The PostgreSQL parser reads @name as the prefix operator @ applied
+		// to the column name.
+		if rexpr.Fields != nil && len(rexpr.Fields.Items) == 1 {
+			if nameStr, ok := rexpr.Fields.Items[0].(*String); ok {
+				return nameStr.Str, true
+			}
+		}
 	}
 	return "", false
 }
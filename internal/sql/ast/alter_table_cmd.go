@@ -8,6 +8,13 @@ const (
 	AT_DropColumn
 	AT_DropNotNull
 	AT_SetNotNull
+	AT_ChangeOwner
+	AT_EnableRowSecurity
+	AT_DisableRowSecurity
+	AT_ForceRowSecurity
+	AT_NoForceRowSecurity
+	AT_AttachPartition
+	AT_DetachPartition
 )
 
 type AlterTableType int
@@ -24,6 +31,20 @@ func (t AlterTableType) String() string {
 		return "DropNotNull"
 	case AT_SetNotNull:
 		return "SetNotNull"
+	case AT_ChangeOwner:
+		return "ChangeOwner"
+	case AT_EnableRowSecurity:
+		return "EnableRowSecurity"
+	case AT_DisableRowSecurity:
+		return "DisableRowSecurity"
+	case AT_ForceRowSecurity:
+		return "ForceRowSecurity"
+	case AT_NoForceRowSecurity:
+		return "NoForceRowSecurity"
+	case AT_AttachPartition:
+		return "AttachPartition"
+	case AT_DetachPartition:
+		return "DetachPartition"
 	default:
 		return "Unknown"
 	}
@@ -34,6 +55,7 @@ type AlterTableCmd struct {
 	Name      *string
 	Def       *ColumnDef
 	Newowner  *RoleSpec
+	Partition *PartitionCmd
 	Behavior  DropBehavior
 	MissingOk bool
 }
@@ -12,6 +12,12 @@ type ColumnDef struct {
 	Vals       *List
 	Length     *int
 	PrimaryKey bool
+	// Default is the column's DEFAULT expression as SQL, rendered by the
+	// engine that parsed it.
+	Default string
+	// Generated is the expression a generated column is computed from, or nil
+	// for a column that stores the values it is given.
+	Generated Node
 
 	// From pg.ColumnDef
 	Inhcount      int
@@ -2,6 +2,9 @@ package ast
 
 type CompositeTypeStmt struct {
 	TypeName *TypeName
+	// Cols holds the columns of a type that declares them, such as a SQL
+	// Server table type.
+	Cols []*ColumnDef
 }
 
 func (n *CompositeTypeStmt) Pos() int {
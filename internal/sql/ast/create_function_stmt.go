@@ -7,6 +7,9 @@ type CreateFunctionStmt struct {
 	Params     *List
 	ReturnType *TypeName
 	Func       *FuncName
+	// IsProcedure is set for CREATE PROCEDURE, whose OUT arguments a CALL
+	// passes along with the rest.
+	IsProcedure bool
 	// TODO: Understand these two fields
 	Options    *List
 	WithClause *List
@@ -9,6 +9,43 @@ type CreateTableStmt struct {
 	ReferTable  *TableName
 	Comment     string
 	Inherits    []*TableName
+
+	// PrimaryKey and UniqueKeys hold the column sets of the table's PRIMARY
+	// KEY and UNIQUE constraints, whether declared on a column or the table.
+	PrimaryKey []string
+	UniqueKeys [][]string
+
+	// ForeignKeys holds the table's FOREIGN KEY constraints and the
+	// REFERENCES clauses of its columns.
+	ForeignKeys []*ForeignKey
+
+	// Checks holds the table's CHECK constraints, whether declared on a
+	// column or the table.
+	Checks []*Check
+
+	// Partspec is the PARTITION BY clause of a partitioned table. Partbound
+	// is the FOR VALUES clause of a partition, whose parent is its one
+	// Inherits entry; a column it lists only adds constraints to the one the
+	// parent has, and has no TypeName.
+	Partspec  *PartitionSpec
+	Partbound *PartitionBoundSpec
+}
+
+// ForeignKey is a foreign key of a table: Columns reference RefColumns of
+// RefTable. RefColumns is empty when the constraint references the primary
+// key of RefTable without naming its columns.
+type ForeignKey struct {
+	Name       string
+	Columns    []string
+	RefTable   *TableName
+	RefColumns []string
+}
+
+// Check is a CHECK constraint of a table. Name is empty when the constraint
+// was not named.
+type Check struct {
+	Name string
+	Expr Node
 }
 
 func (n *CreateTableStmt) Pos() int {
@@ -15,8 +15,23 @@ type CreateTrigStmt struct {
 	Deferrable     bool
 	Initdeferred   bool
 	Constrrel      *RangeVar
+
+	// IfNotExists is set by SQLite's CREATE TRIGGER IF NOT EXISTS.
+	IfNotExists bool
 }
 
 func (n *CreateTrigStmt) Pos() int {
 	return 0
 }
+
+// Trigger type constants (from PostgreSQL's pg_trigger.h). Timing and Events
+// are masks of these.
+const (
+	TriggerTypeRow      = 0x01
+	TriggerTypeBefore   = 0x02
+	TriggerTypeInsert   = 0x04
+	TriggerTypeDelete   = 0x08
+	TriggerTypeUpdate   = 0x10
+	TriggerTypeTruncate = 0x20
+	TriggerTypeInstead  = 0x40
+)
@@ -5,3 +5,12 @@ type GrantObjectType uint
 func (n *GrantObjectType) Pos() int {
 	return 0
 }
+
+// Grant object types (from pg_query's ObjectType). Only the ones a GRANT
+// commonly names are listed.
+const (
+	GrantObjectTypeFunction GrantObjectType = 20
+	GrantObjectTypeSchema   GrantObjectType = 37
+	GrantObjectTypeSequence GrantObjectType = 38
+	GrantObjectTypeTable    GrantObjectType = 42
+)
@@ -5,3 +5,10 @@ type GrantTargetType uint
 func (n *GrantTargetType) Pos() int {
 	return 0
 }
+
+// Grant target types (from pg_query's GrantTargetType).
+const (
+	GrantTargetObject      GrantTargetType = 1 // GRANT ... ON t
+	GrantTargetAllInSchema GrantTargetType = 2 // GRANT ... ON ALL TABLES IN SCHEMA s
+	GrantTargetDefaults    GrantTargetType = 3 // ALTER DEFAULT PRIVILEGES
+)
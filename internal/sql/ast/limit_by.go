@@ -0,0 +1,14 @@
+package ast
+
+// LimitBy represents ClickHouse's LIMIT n [OFFSET m] BY clause, which keeps
+// the first n rows of each group of rows the expressions agree on.
+type LimitBy struct {
+	Count    Node
+	Offset   Node
+	Exprs    *List
+	Location int
+}
+
+func (n *LimitBy) Pos() int {
+	return n.Location
+}
@@ -4,6 +4,8 @@ type NextValueExpr struct {
 	Xpr    Node
 	Seqid  Oid
 	TypeId Oid
+	// Sequence names the sequence of a SQL Server NEXT VALUE FOR.
+	Sequence *RangeVar
 }
 
 func (n *NextValueExpr) Pos() int {
@@ -0,0 +1,206 @@
+package ast
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/sql/format"
+)
+
+// prettyIndent is the indentation of each level of subquery.
+const prettyIndent = "  "
+
+// Pretty formats a node like Format, then breaks the result into lines. Every
+// clause of a query starts a line of its own, and a subquery is indented
+// inside its parentheses. The columns and constraints of CREATE TABLE are
+// listed one per line. Expressions, column lists and the parentheses of
+// function calls stay on one line.
+func Pretty(n Node, d format.Dialect) string {
+	return breakClauses(Format(n, d))
+}
+
+// prettyFrame is one level of parentheses, or the statement itself.
+type prettyFrame struct {
+	// query reports whether the parentheses hold a query, whose clauses
+	// start new lines.
+	query bool
+	// list reports whether the parentheses hold the elements of CREATE
+	// TABLE, which go one per line.
+	list  bool
+	depth int
+	// first is the first keyword of the query, which decides what counts as
+	// a clause.
+	first string
+	// table is set once a CREATE statement names a TABLE, and listed once its
+	// elements have been printed.
+	table, listed bool
+}
+
+// breakClauses relies on the shape of Format's output: keywords are upper
+// case, tokens are separated by single spaces and nothing is split across
+// lines.
+func breakClauses(s string) string {
+	var b strings.Builder
+	stack := []prettyFrame{{query: true}}
+	prev := "" // the previous word or punctuation
+	for i := 0; i < len(s); {
+		top := &stack[len(stack)-1]
+		switch c := s[i]; {
+		case c == '\'' || c == '"' || c == '`':
+			end := skipQuote(s, i)
+			b.WriteString(s[i:end])
+			prev = s[i:end]
+			i = end
+		case c == '(':
+			next := nextWords(s, i+1, 1)
+			frame := prettyFrame{depth: top.depth}
+			if top.query && top.table && !top.listed {
+				top.listed = true
+				frame = prettyFrame{list: true, depth: top.depth + 1}
+				if !strings.HasSuffix(b.String(), " ") {
+					b.WriteByte(' ')
+				}
+				b.WriteString("(\n")
+				b.WriteString(strings.Repeat(prettyIndent, frame.depth))
+			} else if len(next) > 0 && slices.Contains([]string{"SELECT", "WITH", "VALUES", "INSERT", "UPDATE", "DELETE"}, next[0]) {
+				frame = prettyFrame{query: true, depth: top.depth + 1}
+				b.WriteString("(\n")
+				b.WriteString(strings.Repeat(prettyIndent, frame.depth))
+			} else {
+				b.WriteByte('(')
+			}
+			stack = append(stack, frame)
+			prev = "("
+			i++
+		case c == ')':
+			if len(stack) > 1 {
+				if top.query || top.list {
+					b.WriteString("\n")
+					b.WriteString(strings.Repeat(prettyIndent, top.depth-1))
+				}
+				stack = stack[:len(stack)-1]
+			}
+			b.WriteByte(')')
+			prev = ")"
+			i++
+		case c == ' ' && top.query && top.first != "" && startsClause(top, prev, nextWords(s, i+1, 4)):
+			b.WriteString("\n")
+			b.WriteString(strings.Repeat(prettyIndent, top.depth))
+			i++
+		case isWordChar(c):
+			j := i
+			for j < len(s) && isWordChar(s[j]) {
+				j++
+			}
+			if top.first == "" {
+				top.first = s[i:j]
+			}
+			if top.first == "CREATE" && s[i:j] == "TABLE" {
+				top.table = true
+			}
+			b.WriteString(s[i:j])
+			prev = s[i:j]
+			i = j
+		case c == ',' && top.list:
+			b.WriteString(",\n")
+			b.WriteString(strings.Repeat(prettyIndent, top.depth))
+			prev = ","
+			i++
+			for i < len(s) && s[i] == ' ' {
+				i++
+			}
+		default:
+			b.WriteByte(c)
+			if c != ' ' {
+				prev = string(c)
+			}
+			i++
+		}
+	}
+	return b.String()
+}
+
+// startsClause reports whether the words that follow a space begin a new
+// clause of the query.
+func startsClause(f *prettyFrame, prev string, words []string) bool {
+	if len(words) == 0 {
+		return false
+	}
+	at := func(i int) string {
+		if i < len(words) {
+			return words[i]
+		}
+		return ""
+	}
+	switch at(0) {
+	case "FROM":
+		// DELETE FROM starts the statement, and IS DISTINCT FROM is an
+		// operator.
+		return prev != "DELETE" && prev != "DISTINCT"
+	case "WHERE", "HAVING", "WINDOW", "LIMIT", "OFFSET", "RETURNING", "UNION", "EXCEPT", "INTERSECT":
+		return true
+	case "GROUP", "ORDER":
+		return at(1) == "BY"
+	case "SELECT":
+		return true
+	case "VALUES":
+		return prev != "DEFAULT"
+	case "SET":
+		return f.first == "UPDATE" || f.first == "INSERT" || f.first == "WITH"
+	case "ON":
+		return at(1) == "CONFLICT" || at(1) == "DUPLICATE"
+	case "FOR":
+		return f.first == "SELECT" || f.first == "WITH"
+	case "INSERT", "UPDATE", "DELETE":
+		// The statement that follows a WITH clause.
+		return prev == ")"
+	case "JOIN":
+		return true
+	case "INNER", "LEFT", "RIGHT", "FULL", "CROSS", "NATURAL":
+		return at(1) == "JOIN" || at(2) == "JOIN" || at(3) == "JOIN"
+	}
+	return false
+}
+
+// nextWords returns up to n words starting at i, as long as they are
+// separated by single spaces.
+func nextWords(s string, i, n int) []string {
+	var out []string
+	for len(out) < n && i < len(s) && isWordChar(s[i]) {
+		j := i
+		for j < len(s) && isWordChar(s[j]) {
+			j++
+		}
+		out = append(out, s[i:j])
+		if j >= len(s) || s[j] != ' ' {
+			break
+		}
+		i = j + 1
+	}
+	return out
+}
+
+func isWordChar(c byte) bool {
+	return c == '_' || c == '$' ||
+		(c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') ||
+		(c >= '0' && c <= '9') ||
+		c >= 0x80
+}
+
+// skipQuote returns the offset just past the quoted string or identifier that
+// starts at i. A doubled quote character is an escaped quote.
+func skipQuote(s string, i int) int {
+	q := s[i]
+	for j := i + 1; j < len(s); j++ {
+		if s[j] != q {
+			continue
+		}
+		if j+1 < len(s) && s[j+1] == q {
+			j++
+			continue
+		}
+		return j + 1
+	}
+	return len(s)
+}
@@ -0,0 +1,17 @@
+package ast
+
+// RangePivot represents GoogleSQL's PIVOT operator, which turns the rows of a
+// relation into a column per value of ForExpr. Aggregates and Values are
+// lists of ResTarget, named by their aliases.
+type RangePivot struct {
+	Relation   Node
+	Aggregates *List
+	ForExpr    Node
+	Values     *List
+	Alias      *Alias
+	Location   int
+}
+
+func (n *RangePivot) Pos() int {
+	return n.Location
+}
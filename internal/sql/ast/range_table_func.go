@@ -1,5 +1,7 @@
 package ast
 
+import "github.com/sqlc-dev/sqlc/internal/sql/format"
+
 type RangeTableFunc struct {
 	Lateral    bool
 	Docexpr    Node
@@ -13,3 +15,22 @@ type RangeTableFunc struct {
 func (n *RangeTableFunc) Pos() int {
 	return n.Location
 }
+
+// Format writes the table function in JSON_TABLE form, the one the engines
+// that format their queries use.
+func (n *RangeTableFunc) Format(buf *TrackedBuffer, d format.Dialect) {
+	if n == nil {
+		return
+	}
+	buf.WriteString("JSON_TABLE(")
+	buf.astFormat(n.Docexpr, d)
+	buf.WriteString(", ")
+	buf.astFormat(n.Rowexpr, d)
+	buf.WriteString(" COLUMNS (")
+	buf.join(n.Columns, d, ", ")
+	buf.WriteString("))")
+	if n.Alias != nil {
+		buf.WriteString(" AS ")
+		buf.astFormat(n.Alias, d)
+	}
+}
@@ -1,5 +1,7 @@
 package ast
 
+import "github.com/sqlc-dev/sqlc/internal/sql/format"
+
 type RangeTableFuncCol struct {
 	Colname       *string
 	TypeName      *TypeName
@@ -13,3 +15,22 @@ type RangeTableFuncCol struct {
 func (n *RangeTableFuncCol) Pos() int {
 	return n.Location
 }
+
+func (n *RangeTableFuncCol) Format(buf *TrackedBuffer, d format.Dialect) {
+	if n == nil {
+		return
+	}
+	if n.Colname != nil {
+		buf.WriteString(*n.Colname)
+	}
+	if n.ForOrdinality {
+		buf.WriteString(" FOR ORDINALITY")
+		return
+	}
+	buf.WriteString(" ")
+	buf.astFormat(n.TypeName, d)
+	if set(n.Colexpr) {
+		buf.WriteString(" PATH ")
+		buf.astFormat(n.Colexpr, d)
+	}
+}
@@ -0,0 +1,18 @@
+package ast
+
+// RangeUnpivot represents GoogleSQL's UNPIVOT operator, which turns columns of
+// a relation into rows. Each of Items is a list of the ColumnRefs that become
+// the ValueColumns of a row, and NameColumn names the input they came from.
+type RangeUnpivot struct {
+	Relation     Node
+	IncludeNulls bool
+	ValueColumns *List
+	NameColumn   string
+	Items        *List
+	Alias        *Alias
+	Location     int
+}
+
+func (n *RangeUnpivot) Pos() int {
+	return n.Location
+}
@@ -20,6 +20,10 @@ func (n *RangeVar) Format(buf *TrackedBuffer, d format.Dialect) {
 	if n == nil {
 		return
 	}
+	if n.Catalogname != nil && *n.Catalogname != "" {
+		buf.WriteString(d.QuoteIdent(*n.Catalogname))
+		buf.WriteString(".")
+	}
 	if n.Schemaname != nil && *n.Schemaname != "" {
 		buf.WriteString(d.QuoteIdent(*n.Schemaname))
 		buf.WriteString(".")
@@ -5,3 +5,12 @@ type RoleSpecType uint
 func (n *RoleSpecType) Pos() int {
 	return 0
 }
+
+// Role spec types (from pg_query's RoleSpecType).
+const (
+	RoleSpecCString     RoleSpecType = 1
+	RoleSpecCurrentRole RoleSpecType = 2
+	RoleSpecCurrentUser RoleSpecType = 3
+	RoleSpecSessionUser RoleSpecType = 4
+	RoleSpecPublic      RoleSpecType = 5
+)
@@ -11,14 +11,18 @@ type SelectStmt struct {
 	IntoClause     *IntoClause
 	TargetList     *List
 	FromClause     *List
+	PrewhereClause Node // ClickHouse-specific
 	WhereClause    Node
 	GroupClause    *List
 	HavingClause   Node
+	QualifyClause  Node // GoogleSQL-specific
 	WindowClause   *List
 	ValuesLists    *List
 	SortClause     *List
 	LimitOffset    Node
 	LimitCount     Node
+	LimitBy        *LimitBy // ClickHouse-specific
+	Settings       *List    // ClickHouse-specific, of DefElem
 	LockingClause  *List
 	WithClause     *WithClause
 	Op             SetOperation
@@ -103,6 +107,11 @@ func (n *SelectStmt) Format(buf *TrackedBuffer, d format.Dialect) {
 		buf.astFormat(n.HavingClause, d)
 	}
 
+	if set(n.QualifyClause) {
+		buf.WriteString(" QUALIFY ")
+		buf.astFormat(n.QualifyClause, d)
+	}
+
 	if items(n.SortClause) {
 		buf.WriteString(" ORDER BY ")
 		buf.astFormat(n.SortClause, d)
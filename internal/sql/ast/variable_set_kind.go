@@ -2,6 +2,15 @@ package ast
 
 type VariableSetKind uint
 
+const (
+	VariableSetKind_VALUE     VariableSetKind = 1
+	VariableSetKind_DEFAULT   VariableSetKind = 2
+	VariableSetKind_CURRENT   VariableSetKind = 3
+	VariableSetKind_MULTI     VariableSetKind = 4
+	VariableSetKind_RESET     VariableSetKind = 5
+	VariableSetKind_RESET_ALL VariableSetKind = 6
+)
+
 func (n *VariableSetKind) Pos() int {
 	return 0
 }
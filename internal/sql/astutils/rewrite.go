@@ -475,6 +475,7 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "TypeName", nil, n.TypeName)
 		a.apply(n, "RawDefault", nil, n.RawDefault)
 		a.apply(n, "CookedDefault", nil, n.CookedDefault)
+		a.apply(n, "Generated", nil, n.Generated)
 		a.apply(n, "CollClause", nil, n.CollClause)
 		a.apply(n, "Constraints", nil, n.Constraints)
 		a.apply(n, "Fdwoptions", nil, n.Fdwoptions)
@@ -837,6 +838,11 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "Quals", nil, n.Quals)
 		a.apply(n, "Alias", nil, n.Alias)
 
+	case *ast.LimitBy:
+		a.apply(n, "Count", nil, n.Count)
+		a.apply(n, "Offset", nil, n.Offset)
+		a.apply(n, "Exprs", nil, n.Exprs)
+
 	case *ast.ListenStmt:
 		// pass
 
@@ -983,6 +989,19 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "Colexpr", nil, n.Colexpr)
 		a.apply(n, "Coldefexpr", nil, n.Coldefexpr)
 
+	case *ast.RangePivot:
+		a.apply(n, "Relation", nil, n.Relation)
+		a.apply(n, "Aggregates", nil, n.Aggregates)
+		a.apply(n, "ForExpr", nil, n.ForExpr)
+		a.apply(n, "Values", nil, n.Values)
+		a.apply(n, "Alias", nil, n.Alias)
+
+	case *ast.RangeUnpivot:
+		a.apply(n, "Relation", nil, n.Relation)
+		a.apply(n, "ValueColumns", nil, n.ValueColumns)
+		a.apply(n, "Items", nil, n.Items)
+		a.apply(n, "Alias", nil, n.Alias)
+
 	case *ast.RangeTableSample:
 		a.apply(n, "Relation", nil, n.Relation)
 		a.apply(n, "Method", nil, n.Method)
@@ -1080,14 +1099,18 @@ func (a *application) apply(parent ast.Node, name string, iter *iterator, n ast.
 		a.apply(n, "IntoClause", nil, n.IntoClause)
 		a.apply(n, "TargetList", nil, n.TargetList)
 		a.apply(n, "FromClause", nil, n.FromClause)
+		a.apply(n, "PrewhereClause", nil, n.PrewhereClause)
 		a.apply(n, "WhereClause", nil, n.WhereClause)
 		a.apply(n, "GroupClause", nil, n.GroupClause)
 		a.apply(n, "HavingClause", nil, n.HavingClause)
+		a.apply(n, "QualifyClause", nil, n.QualifyClause)
 		a.apply(n, "WindowClause", nil, n.WindowClause)
 		a.apply(n, "ValuesLists", nil, n.ValuesLists)
 		a.apply(n, "SortClause", nil, n.SortClause)
 		a.apply(n, "LimitOffset", nil, n.LimitOffset)
 		a.apply(n, "LimitCount", nil, n.LimitCount)
+		a.apply(n, "LimitBy", nil, n.LimitBy)
+		a.apply(n, "Settings", nil, n.Settings)
 		a.apply(n, "LockingClause", nil, n.LockingClause)
 		a.apply(n, "WithClause", nil, n.WithClause)
 		a.apply(n, "Larg", nil, n.Larg)
@@ -0,0 +1,25 @@
+package astutils
+
+import "github.com/sqlc-dev/sqlc/internal/sql/ast"
+
+// SortItems returns the ORDER BY items of a SELECT. The MySQL parser keeps
+// them as a list of SortBy nodes in the window clause instead of the sort
+// clause.
+func SortItems(stmt *ast.SelectStmt) []ast.Node {
+	if stmt.SortClause != nil && len(stmt.SortClause.Items) > 0 {
+		return stmt.SortClause.Items
+	}
+	if stmt.WindowClause == nil {
+		return nil
+	}
+	for _, item := range stmt.WindowClause.Items {
+		list, ok := item.(*ast.List)
+		if !ok || len(list.Items) == 0 {
+			continue
+		}
+		if _, ok := list.Items[0].(*ast.SortBy); ok {
+			return list.Items
+		}
+	}
+	return nil
+}
@@ -625,6 +625,9 @@ func Walk(f Visitor, node ast.Node) {
 		if n.CookedDefault != nil {
 			Walk(f, n.CookedDefault)
 		}
+		if n.Generated != nil {
+			Walk(f, n.Generated)
+		}
 		if n.CollClause != nil {
 			Walk(f, n.CollClause)
 		}
@@ -1367,6 +1370,17 @@ func Walk(f Visitor, node ast.Node) {
 			Walk(f, n.Alias)
 		}
 
+	case *ast.LimitBy:
+		if n.Count != nil {
+			Walk(f, n.Count)
+		}
+		if n.Offset != nil {
+			Walk(f, n.Offset)
+		}
+		if n.Exprs != nil {
+			Walk(f, n.Exprs)
+		}
+
 	case *ast.ListenStmt:
 		// pass
 
@@ -1651,6 +1665,37 @@ func Walk(f Visitor, node ast.Node) {
 			Walk(f, n.Coldefexpr)
 		}
 
+	case *ast.RangePivot:
+		if n.Relation != nil {
+			Walk(f, n.Relation)
+		}
+		if n.Aggregates != nil {
+			Walk(f, n.Aggregates)
+		}
+		if n.ForExpr != nil {
+			Walk(f, n.ForExpr)
+		}
+		if n.Values != nil {
+			Walk(f, n.Values)
+		}
+		if n.Alias != nil {
+			Walk(f, n.Alias)
+		}
+
+	case *ast.RangeUnpivot:
+		if n.Relation != nil {
+			Walk(f, n.Relation)
+		}
+		if n.ValueColumns != nil {
+			Walk(f, n.ValueColumns)
+		}
+		if n.Items != nil {
+			Walk(f, n.Items)
+		}
+		if n.Alias != nil {
+			Walk(f, n.Alias)
+		}
+
 	case *ast.RangeTableSample:
 		if n.Relation != nil {
 			Walk(f, n.Relation)
@@ -1857,6 +1902,9 @@ func Walk(f Visitor, node ast.Node) {
 		if n.FromClause != nil {
 			Walk(f, n.FromClause)
 		}
+		if n.PrewhereClause != nil {
+			Walk(f, n.PrewhereClause)
+		}
 		if n.WhereClause != nil {
 			Walk(f, n.WhereClause)
 		}
@@ -1866,6 +1914,9 @@ func Walk(f Visitor, node ast.Node) {
 		if n.HavingClause != nil {
 			Walk(f, n.HavingClause)
 		}
+		if n.QualifyClause != nil {
+			Walk(f, n.QualifyClause)
+		}
 		if n.WindowClause != nil {
 			Walk(f, n.WindowClause)
 		}
@@ -1881,6 +1932,12 @@ func Walk(f Visitor, node ast.Node) {
 		if n.LimitCount != nil {
 			Walk(f, n.LimitCount)
 		}
+		if n.LimitBy != nil {
+			Walk(f, n.LimitBy)
+		}
+		if n.Settings != nil {
+			Walk(f, n.Settings)
+		}
 		if n.LockingClause != nil {
 			Walk(f, n.LockingClause)
 		}
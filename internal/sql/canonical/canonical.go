@@ -0,0 +1,172 @@
+// Package canonical decides whether two pieces of SQL are the same statement.
+//
+// Commands that print SQL from sqlc's AST, such as fmt and translate, use it
+// to check their output: sqlc's AST leaves out the parts of a statement that
+// code generation has no use for, so a statement printed from it may have lost
+// a clause. Where the engine's own parser can render its full tree, that tree
+// is compared instead of sqlc's.
+package canonical
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	marinofmt "github.com/sqlc-dev/marino/format"
+	marino "github.com/sqlc-dev/marino/parser"
+	meyerast "github.com/sqlc-dev/meyer/ast"
+	meyer "github.com/sqlc-dev/meyer/parser"
+	nodes "github.com/sqlc-dev/oliphant"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/engine/clickhouse"
+	"github.com/sqlc-dev/sqlc/internal/engine/googlesql"
+	"github.com/sqlc-dev/sqlc/internal/engine/mssql"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+)
+
+// Lossless reports whether String compares the full tree of the engine's own
+// parser. For the other engines it compares sqlc's AST, which can miss a
+// clause that sqlc does not represent.
+func Lossless(engine config.Engine) bool {
+	switch engine {
+	case config.EnginePostgreSQL, config.EngineMySQL, config.EngineSQLite:
+		return true
+	}
+	return false
+}
+
+// Equal reports whether two pieces of SQL each parse to a single statement,
+// and to the same one once source positions are ignored.
+func Equal(engine config.Engine, a, b string) bool {
+	x, err := String(engine, a)
+	if err != nil {
+		return false
+	}
+	y, err := String(engine, b)
+	if err != nil {
+		return false
+	}
+	return x == y
+}
+
+// String renders one statement in a form that differs between two pieces of
+// SQL only when they parse to different trees.
+func String(engine config.Engine, sql string) (string, error) {
+	switch engine {
+	case config.EnginePostgreSQL:
+		tree, err := nodes.ParseToJSON(sql)
+		if err != nil {
+			return "", err
+		}
+		var v any
+		if err := json.Unmarshal([]byte(tree), &v); err != nil {
+			return "", err
+		}
+		stmts, _ := v.(map[string]any)["stmts"].([]any)
+		if len(stmts) != 1 {
+			return "", fmt.Errorf("expected one statement, found %d", len(stmts))
+		}
+		clearJSONLocations(v)
+		out, err := json.Marshal(v)
+		return string(out), err
+
+	case config.EngineMySQL:
+		stmts, _, err := marino.New().Parse(sql, "", "")
+		if err != nil {
+			return "", err
+		}
+		if len(stmts) != 1 {
+			return "", fmt.Errorf("expected one statement, found %d", len(stmts))
+		}
+		var b strings.Builder
+		if err := stmts[0].Restore(marinofmt.NewRestoreCtx(marinofmt.DefaultRestoreFlags, &b)); err != nil {
+			return "", err
+		}
+		return b.String(), nil
+
+	case config.EngineSQLite:
+		// The sqlite engine accepts ORDER BY and LIMIT on UPDATE and DELETE,
+		// and so must the comparison.
+		stmts, err := meyer.Options{UpdateDeleteLimit: true}.ParseString(sql)
+		if err != nil {
+			return "", err
+		}
+		if len(stmts) != 1 {
+			return "", fmt.Errorf("expected one statement, found %d", len(stmts))
+		}
+		return meyerast.String(stmts[0]), nil
+
+	case config.EngineClickHouse:
+		return astString(clickhouse.NewParser(), sql)
+	case config.EngineGoogleSQL:
+		return astString(googlesql.NewParser(), sql)
+	case config.EngineMSSQL:
+		return astString(mssql.NewParser(), sql)
+	}
+	return "", fmt.Errorf("unknown engine %s", engine)
+}
+
+// astString renders sqlc's AST for an engine with no renderer of its own.
+func astString(p interface {
+	Parse(io.Reader) ([]ast.Statement, error)
+}, sql string) (string, error) {
+	stmts, err := p.Parse(strings.NewReader(sql))
+	if err != nil {
+		return "", err
+	}
+	if len(stmts) != 1 {
+		return "", fmt.Errorf("expected one statement, found %d", len(stmts))
+	}
+	clearLocations(reflect.ValueOf(stmts[0].Raw.Stmt))
+	out, err := json.Marshal(stmts[0].Raw.Stmt)
+	return string(out), err
+}
+
+// clearLocations zeroes the source positions in a parsed statement.
+func clearLocations(v reflect.Value) {
+	switch v.Kind() {
+	case reflect.Pointer, reflect.Interface:
+		if !v.IsNil() {
+			clearLocations(v.Elem())
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			clearLocations(v.Index(i))
+		}
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			field := v.Field(i)
+			switch v.Type().Field(i).Name {
+			case "Location", "StmtLocation", "StmtLen":
+				if field.CanSet() && field.Kind() == reflect.Int {
+					field.SetInt(0)
+					continue
+				}
+			}
+			clearLocations(field)
+		}
+	}
+}
+
+// clearJSONLocations removes the source positions from a PostgreSQL parse
+// tree, which differ between two spellings of the same SQL.
+func clearJSONLocations(v any) {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, child := range v {
+			switch key {
+			case "location", "stmt_location", "stmt_len":
+				delete(v, key)
+			default:
+				clearJSONLocations(child)
+			}
+		}
+	case []any:
+		for _, child := range v {
+			clearJSONLocations(child)
+		}
+	}
+}
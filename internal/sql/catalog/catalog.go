@@ -13,6 +13,11 @@ type Catalog struct {
 	SearchPath    []string
 	LoadExtension func(string) *Schema
 
+	// TableSearchPath lists the schemas an unqualified relation name is
+	// looked up in ahead of DefaultSchema. The first of them that exists is
+	// where an unqualified CREATE TABLE or CREATE VIEW puts its relation.
+	TableSearchPath []string
+
 	// TODO: un-export
 	Extensions map[string]struct{}
 }
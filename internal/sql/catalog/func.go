@@ -17,6 +17,7 @@ type Function struct {
 	Comment            string
 	Desc               string
 	ReturnTypeNullable bool
+	IsProcedure        bool
 }
 
 type Argument struct {
@@ -26,12 +27,19 @@ type Argument struct {
 	Mode       ast.FuncParamMode
 }
 
+// InArgs returns the arguments a call passes. A CALL passes a procedure's OUT
+// arguments too, as the variables that receive them.
 func (f *Function) InArgs() []*Argument {
 	var args []*Argument
 	for _, a := range f.Args {
 		switch a.Mode {
-		case ast.FuncParamTable, ast.FuncParamOut:
+		case ast.FuncParamTable:
 			continue
+		case ast.FuncParamOut:
+			if !f.IsProcedure {
+				continue
+			}
+			args = append(args, a)
 		default:
 			args = append(args, a)
 		}
@@ -60,9 +68,10 @@ func (c *Catalog) createFunction(stmt *ast.CreateFunctionStmt) error {
 		return err
 	}
 	fn := &Function{
-		Name:       stmt.Func.Name,
-		Args:       make([]*Argument, len(stmt.Params.Items)),
-		ReturnType: stmt.ReturnType,
+		Name:        stmt.Func.Name,
+		Args:        make([]*Argument, len(stmt.Params.Items)),
+		ReturnType:  stmt.ReturnType,
+		IsProcedure: stmt.IsProcedure,
 	}
 	types := make([]*ast.TypeName, len(stmt.Params.Items))
 	for i, item := range stmt.Params.Items {
@@ -3,6 +3,7 @@ package catalog
 import (
 	"errors"
 	"fmt"
+	"slices"
 
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
 	"github.com/sqlc-dev/sqlc/internal/sql/sqlerr"
@@ -16,6 +17,53 @@ type Table struct {
 	Rel     *ast.TableName
 	Columns []*Column
 	Comment string
+
+	// PrimaryKey and UniqueKeys hold the column sets of the table's PRIMARY
+	// KEY and UNIQUE constraints.
+	PrimaryKey []string
+	UniqueKeys [][]string
+
+	// ForeignKeys holds the table's foreign keys, declared on a column or
+	// the table.
+	ForeignKeys []*ast.ForeignKey
+}
+
+// Keys returns every column set that identifies a row of the table, starting
+// with the primary key.
+func (table *Table) Keys() [][]string {
+	var keys [][]string
+	if len(table.PrimaryKey) > 0 {
+		keys = append(keys, table.PrimaryKey)
+	}
+	return append(keys, table.UniqueKeys...)
+}
+
+// dropKeyColumn forgets the keys that include a dropped column.
+func (table *Table) dropKeyColumn(name string) {
+	if slices.Contains(table.PrimaryKey, name) {
+		table.PrimaryKey = nil
+	}
+	table.UniqueKeys = slices.DeleteFunc(table.UniqueKeys, func(key []string) bool {
+		return slices.Contains(key, name)
+	})
+	table.ForeignKeys = slices.DeleteFunc(table.ForeignKeys, func(fk *ast.ForeignKey) bool {
+		return slices.Contains(fk.Columns, name)
+	})
+}
+
+// renameKeyColumn follows a renamed column into the table's keys.
+func (table *Table) renameKeyColumn(name, newName string) {
+	keys := append([][]string{table.PrimaryKey}, table.UniqueKeys...)
+	for _, fk := range table.ForeignKeys {
+		keys = append(keys, fk.Columns)
+	}
+	for _, key := range keys {
+		for i := range key {
+			if key[i] == name {
+				key[i] = newName
+			}
+		}
+	}
 }
 
 func checkMissing(err error, missingOK bool) error {
@@ -91,6 +139,7 @@ func (c *Catalog) dropColumn(table *Table, cmd *ast.AlterTableCmd) error {
 		}
 	}
 	table.Columns = append(table.Columns[:index], table.Columns[index+1:]...)
+	table.dropKeyColumn(col.Name)
 	return nil
 }
 
@@ -128,6 +177,11 @@ type Column struct {
 	ArrayDims  int
 	Comment    string
 	Length     *int
+	// Default is the column's DEFAULT expression as SQL.
+	Default string
+	// IsGenerated is set for a column computed from an expression, which an
+	// INSERT cannot give a value.
+	IsGenerated bool
 
 	linkedType bool
 }
@@ -142,6 +196,15 @@ type columnGenerator interface {
 func (c *Catalog) getTable(tableName *ast.TableName) (*Schema, *Table, error) {
 	schemaName := tableName.Schema
 	if schemaName == "" {
+		for _, ns := range c.TableSearchPath {
+			schema, err := c.getSchema(ns)
+			if err != nil {
+				continue
+			}
+			if table, _, err := schema.getTable(tableName); err == nil {
+				return schema, table, nil
+			}
+		}
 		schemaName = c.DefaultSchema
 	}
 	var schema *Schema
@@ -247,10 +310,29 @@ func (c *Catalog) alterTableSetSchema(stmt *ast.AlterTableSetSchemaStmt) error {
 	return nil
 }
 
+// CurrentSchema returns the first schema on the table search path that
+// exists, or the default one. An unqualified CREATE puts its relation there,
+// and generated names are not prefixed with its name.
+func (c *Catalog) CurrentSchema() string {
+	for _, ns := range c.TableSearchPath {
+		if _, err := c.getSchema(ns); err == nil {
+			return ns
+		}
+	}
+	return c.DefaultSchema
+}
+
 func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 	ns := stmt.Name.Schema
 	if ns == "" {
-		ns = c.DefaultSchema
+		ns = c.CurrentSchema()
+		// A relation outside the default schema is named with its schema,
+		// as it would be had the statement qualified it.
+		if ns != c.DefaultSchema {
+			name := *stmt.Name
+			name.Schema = ns
+			stmt.Name = &name
+		}
 	}
 	schema, err := c.getSchema(ns)
 	if err != nil {
@@ -263,7 +345,13 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 		return sqlerr.RelationExists(stmt.Name.Name)
 	}
 
-	tbl := Table{Rel: stmt.Name, Comment: stmt.Comment}
+	tbl := Table{
+		Rel:         stmt.Name,
+		Comment:     stmt.Comment,
+		PrimaryKey:  stmt.PrimaryKey,
+		UniqueKeys:  stmt.UniqueKeys,
+		ForeignKeys: stmt.ForeignKeys,
+	}
 	coltype := make(map[string]ast.TypeName) // used to check for duplicate column names
 	seen := make(map[string]bool)            // used to check for duplicate column names
 	for _, inheritTable := range stmt.Inherits {
@@ -303,13 +391,17 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 	for _, col := range stmt.Cols {
 		if notNull, ok := seen[col.Colname]; ok {
 			seen[col.Colname] = notNull || col.IsNotNull
-			if a, ok := coltype[col.Colname]; ok {
+			if a, ok := coltype[col.Colname]; ok && col.TypeName != nil {
 				if !sameType(&a, col.TypeName) {
 					return fmt.Errorf("column %q has a type conflict", col.Colname)
 				}
 			}
 			continue
 		}
+		// A partition's columns without a type are ones its parent has.
+		if col.TypeName == nil {
+			return sqlerr.ColumnNotFound(stmt.Name.Name, col.Colname)
+		}
 		tc, err := c.defineColumn(stmt.Name, col)
 		if err != nil {
 			return err
@@ -330,14 +422,16 @@ func (c *Catalog) createTable(stmt *ast.CreateTableStmt) error {
 
 func (c *Catalog) defineColumn(table *ast.TableName, col *ast.ColumnDef) (*Column, error) {
 	tc := &Column{
-		Name:       col.Colname,
-		Type:       *col.TypeName,
-		IsNotNull:  col.IsNotNull,
-		IsUnsigned: col.IsUnsigned,
-		IsArray:    col.IsArray,
-		ArrayDims:  col.ArrayDims,
-		Comment:    col.Comment,
-		Length:     col.Length,
+		Name:        col.Colname,
+		Type:        *col.TypeName,
+		IsNotNull:   col.IsNotNull,
+		IsUnsigned:  col.IsUnsigned,
+		IsArray:     col.IsArray,
+		ArrayDims:   col.ArrayDims,
+		Comment:     col.Comment,
+		Length:      col.Length,
+		Default:     col.Default,
+		IsGenerated: col.Generated != nil,
 	}
 	if col.Vals != nil {
 		typeName := ast.TypeName{
@@ -407,6 +501,7 @@ func (c *Catalog) renameColumn(stmt *ast.RenameColumnStmt) error {
 		return sqlerr.ColumnNotFound(tbl.Rel.Name, stmt.Col.Name)
 	}
 	tbl.Columns[idx].Name = *stmt.NewName
+	tbl.renameKeyColumn(stmt.Col.Name, *stmt.NewName)
 
 	if tbl.Columns[idx].linkedType {
 		name := fmt.Sprintf("%s_%s", tbl.Rel.Name, *stmt.NewName)
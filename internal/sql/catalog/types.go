@@ -30,6 +30,9 @@ func (e *Enum) isType() {
 type CompositeType struct {
 	Name    string
 	Comment string
+	// Columns holds the columns of a type that declares them, such as a SQL
+	// Server table type.
+	Columns []*Column
 }
 
 func (ct *CompositeType) isType() {
@@ -19,6 +19,11 @@ func (c *Catalog) createView(stmt *ast.ViewStmt, colGen columnGenerator) error {
 	if stmt.View.Schemaname != nil {
 		schemaName = *stmt.View.Schemaname
 	}
+	if schemaName == "" {
+		if ns := c.CurrentSchema(); ns != c.DefaultSchema {
+			schemaName = ns
+		}
+	}
 
 	tbl := Table{
 		Rel: &ast.TableName{
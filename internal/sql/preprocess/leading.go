@@ -0,0 +1,74 @@
+package preprocess
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+// Leading describes the text of one statement for a formatter. Start is the
+// offset of the statement's first token, just past the whitespace and
+// comments that lead it. Verbatim reports whether the rest of the statement
+// holds text that a printer would lose or rewrite: a comment, or a
+// dollar-quoted string such as a function body. ok is false for engines the
+// preprocessor has no lexical rules for.
+func Leading(engine config.Engine, text string) (start int, verbatim bool, ok bool) {
+	d, ok := DialectFor(engine)
+	if !ok {
+		return 0, false, false
+	}
+	l := &lexer{d: d, src: text}
+	start = l.skipSpace(0)
+	for i := start; i < len(text); {
+		stop := l.skip(i)
+		if stop < 0 {
+			i++
+			continue
+		}
+		if c := text[i]; c == '-' || c == '/' || c == '#' || c == '$' {
+			return start, true, true
+		}
+		i = stop
+	}
+	return start, false, true
+}
+
+// Comments splits the comments out of the text of a statement, so that a
+// printer can keep them. It returns the text with each comment replaced by
+// whitespace, and the comments as written, in order. MySQL's optimizer hints
+// and executable comments, /*+ ... */ and /*! ... */, are part of the
+// statement and stay in it. ok is false for engines the preprocessor has no
+// lexical rules for.
+func Comments(engine config.Engine, text string) (rest string, comments []string, ok bool) {
+	d, ok := DialectFor(engine)
+	if !ok {
+		return text, nil, false
+	}
+	l := &lexer{d: d, src: text}
+	var b strings.Builder
+	last := 0
+	for i := 0; i < len(text); {
+		stop := l.skip(i)
+		if stop < 0 {
+			i++
+			continue
+		}
+		comment := text[i:stop]
+		switch {
+		case strings.HasPrefix(comment, "/*+"), strings.HasPrefix(comment, "/*!"):
+		case strings.HasPrefix(comment, "--"), strings.HasPrefix(comment, "/*"),
+			d.HashComment && strings.HasPrefix(comment, "#"):
+			b.WriteString(text[last:i])
+			if strings.HasSuffix(comment, "\n") {
+				b.WriteString("\n")
+			} else {
+				b.WriteString(" ")
+			}
+			last = stop
+			comments = append(comments, strings.TrimRight(comment, " \t\r\n"))
+		}
+		i = stop
+	}
+	b.WriteString(text[last:])
+	return b.String(), comments, true
+}
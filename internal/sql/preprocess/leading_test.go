@@ -0,0 +1,90 @@
+package preprocess_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/sql/preprocess"
+)
+
+func TestLeading(t *testing.T) {
+	for _, tc := range []struct {
+		engine   config.Engine
+		text     string
+		start    int
+		verbatim bool
+	}{
+		{
+			engine: config.EnginePostgreSQL,
+			text:   "-- name: GetAuthor :one\nSELECT '--' FROM authors",
+			start:  24,
+		},
+		{
+			engine:   config.EnginePostgreSQL,
+			text:     "\n/* leading */ SELECT 1 /* inner */",
+			start:    15,
+			verbatim: true,
+		},
+		{
+			engine:   config.EnginePostgreSQL,
+			text:     "CREATE FUNCTION f() RETURNS int AS $$ SELECT 1 $$ LANGUAGE sql",
+			verbatim: true,
+		},
+		{
+			engine:   config.EngineMySQL,
+			text:     "# name: ListAuthors :many\nSELECT * FROM authors # all of them",
+			start:    26,
+			verbatim: true,
+		},
+		{
+			engine: config.EngineSQLite,
+			text:   "SELECT \"#\" FROM authors",
+		},
+	} {
+		start, verbatim, ok := preprocess.Leading(tc.engine, tc.text)
+		if !ok {
+			t.Errorf("%s: no dialect for %s", tc.text, tc.engine)
+			continue
+		}
+		if start != tc.start || verbatim != tc.verbatim {
+			t.Errorf("%q: got (%d, %t), want (%d, %t)", tc.text, start, verbatim, tc.start, tc.verbatim)
+		}
+	}
+}
+
+func TestComments(t *testing.T) {
+	for _, tc := range []struct {
+		engine   config.Engine
+		text     string
+		rest     string
+		comments []string
+	}{
+		{
+			engine:   config.EnginePostgreSQL,
+			text:     "SELECT id, /* the key */ name\nFROM authors -- all of them\nWHERE bio LIKE '%--%'",
+			rest:     "SELECT id,   name\nFROM authors \nWHERE bio LIKE '%--%'",
+			comments: []string{"/* the key */", "-- all of them"},
+		},
+		{
+			engine:   config.EngineMySQL,
+			text:     "SELECT /*+ NO_ICP(authors) */ * FROM authors # all of them",
+			rest:     "SELECT /*+ NO_ICP(authors) */ * FROM authors  ",
+			comments: []string{"# all of them"},
+		},
+		{
+			engine: config.EngineSQLite,
+			text:   "SELECT \"#\" FROM authors",
+			rest:   "SELECT \"#\" FROM authors",
+		},
+	} {
+		rest, comments, ok := preprocess.Comments(tc.engine, tc.text)
+		if !ok {
+			t.Errorf("%s: no dialect for %s", tc.text, tc.engine)
+			continue
+		}
+		if rest != tc.rest || !slices.Equal(comments, tc.comments) {
+			t.Errorf("%q: got (%q, %q), want (%q, %q)", tc.text, rest, comments, tc.rest, tc.comments)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package preprocess
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+// ValuesRow finds the row of a single-row VALUES list in an INSERT query, for
+// :bulkexec to repeat once per record. It returns the span of the row,
+// parentheses included.
+//
+// The generated code copies the row and renumbers its placeholders without a
+// lexer of its own, so every bind parameter must sit inside the row, and the
+// row may hold plain single-quoted strings but no other quoted text or
+// comments.
+func ValuesRow(engine config.Engine, query string) (start, end int, err error) {
+	d, ok := DialectFor(engine)
+	if !ok {
+		return 0, 0, errors.New(":bulkexec is not supported by this engine")
+	}
+	l := &lexer{d: d, src: query}
+
+	start = -1
+	for i := 0; i < len(query); {
+		if stop := l.skip(i); stop >= 0 {
+			i = stop
+			continue
+		}
+		if !isIdentStart(query[i]) {
+			i++
+			continue
+		}
+		j := l.identEnd(i)
+		if strings.EqualFold(query[i:j], "values") && (i == 0 || !isIdentPart(query[i-1])) {
+			start = l.skipSpace(j)
+			break
+		}
+		i = j
+	}
+	if start < 0 || l.at(start) != '(' {
+		return 0, 0, errors.New(":bulkexec requires an INSERT with a VALUES list")
+	}
+	close := l.matchParen(start)
+	if close < 0 {
+		return 0, 0, errors.New(":bulkexec requires an INSERT with a VALUES list")
+	}
+	end = close + 1
+	if l.at(l.skipSpace(end)) == ',' {
+		return 0, 0, errors.New(":bulkexec requires a VALUES list with a single row")
+	}
+
+	for _, occ := range l.scan(0, len(query)) {
+		if occ.kind != kindNative {
+			continue
+		}
+		if occ.start < start || occ.end > end {
+			return 0, 0, errors.New(":bulkexec requires every parameter to be in the VALUES row")
+		}
+	}
+	for i := start; i < end; {
+		switch c := query[i]; {
+		case c == '\'' && !l.isEscapeString(i):
+			stop := l.skipQuoted(i, '\'', false)
+			if d.Backslash && strings.ContainsRune(query[i:stop], '\\') {
+				return 0, 0, errors.New(":bulkexec does not support backslashes in the VALUES row")
+			}
+			i = stop
+			continue
+		case l.skip(i) >= 0:
+			return 0, 0, errors.New(":bulkexec does not support quoted identifiers, dollar-quoted strings or comments in the VALUES row")
+		}
+		i++
+	}
+	return start, end, nil
+}
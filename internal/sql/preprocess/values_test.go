@@ -0,0 +1,68 @@
+package preprocess_test
+
+import (
+	"testing"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/sql/preprocess"
+)
+
+func TestValuesRow(t *testing.T) {
+	for _, tc := range []struct {
+		engine config.Engine
+		query  string
+		row    string
+		err    string
+	}{
+		{
+			engine: config.EnginePostgreSQL,
+			query:  "INSERT INTO t (a, b) VALUES ($1, coalesce($2, 'x')) RETURNING id",
+			row:    "($1, coalesce($2, 'x'))",
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "INSERT INTO `values` (a) VALUES (?)",
+			row:    "(?)",
+		},
+		{
+			engine: config.EngineSQLite,
+			query:  "INSERT INTO t (a) VALUES\n  (?1)\nON CONFLICT DO NOTHING",
+			row:    "(?1)",
+		},
+		{
+			engine: config.EnginePostgreSQL,
+			query:  "INSERT INTO t (a) VALUES ($1), ($2)",
+			err:    ":bulkexec requires a VALUES list with a single row",
+		},
+		{
+			engine: config.EnginePostgreSQL,
+			query:  "INSERT INTO t (a) VALUES ($1) ON CONFLICT (a) DO UPDATE SET b = $2",
+			err:    ":bulkexec requires every parameter to be in the VALUES row",
+		},
+		{
+			engine: config.EngineMySQL,
+			query:  "INSERT INTO t (a, b) VALUES (?, 'it\\'s')",
+			err:    ":bulkexec does not support backslashes in the VALUES row",
+		},
+		{
+			engine: config.EnginePostgreSQL,
+			query:  "INSERT INTO t (a) VALUES ($1 /* note */)",
+			err:    ":bulkexec does not support quoted identifiers, dollar-quoted strings or comments in the VALUES row",
+		},
+	} {
+		start, end, err := preprocess.ValuesRow(tc.engine, tc.query)
+		if tc.err != "" {
+			if err == nil || err.Error() != tc.err {
+				t.Errorf("%s: got error %v, want %q", tc.query, err, tc.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("%s: %v", tc.query, err)
+			continue
+		}
+		if got := tc.query[start:end]; got != tc.row {
+			t.Errorf("%s: got row %q, want %q", tc.query, got, tc.row)
+		}
+	}
+}
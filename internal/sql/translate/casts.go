@@ -0,0 +1,190 @@
+package translate
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+// casts translates the types of CAST(x AS type) and of PostgreSQL's x::type,
+// which becomes a CAST in a target without it.
+func (s *stmt) casts() {
+	for p := range s.sig {
+		if !s.is(p, "cast", "(") && !s.is(p, "try_cast", "(") {
+			continue
+		}
+		end := s.match(p + 1)
+		if end < 0 {
+			continue
+		}
+		as := -1
+		for q := p + 2; q < end; q++ {
+			switch {
+			case s.is(q, "("):
+				if close := s.match(q); close > 0 {
+					q = close
+				}
+			case s.is(q, "as"):
+				as = q
+			}
+		}
+		tn, tend, ok := s.parseType(as + 1)
+		if as < 0 || !ok || tend != end-1 {
+			continue
+		}
+		if mapped, ok := s.castType(as+1, tn); ok {
+			s.writeType(as+1, tend, mapped)
+		}
+	}
+
+	// starts records where the expression of each :: cast starts, by the
+	// index of the last token of its type, so that x::a::b casts the whole
+	// of x::a.
+	starts := map[int]int{}
+	for p := range s.sig {
+		if !s.is(p, "::") {
+			continue
+		}
+		tn, tend, ok := s.parseType(p + 1)
+		if !ok {
+			s.report(p, "cannot read the type of this cast")
+			continue
+		}
+		start := s.exprStart(p-1, starts)
+		starts[tend] = start
+		mapped, ok := s.castType(p+1, tn)
+		if !ok {
+			continue
+		}
+		if s.t.to == config.EnginePostgreSQL {
+			s.writeType(p+1, tend, mapped)
+			continue
+		}
+		prefix, suffix, _ := strings.Cut(s.t.target.Cast("\x00", mapped), "\x00")
+		s.out[s.sig[start]] = prefix + s.out[s.sig[start]]
+		s.replace(p, tend, suffix)
+	}
+}
+
+// castType returns the spelling of a cast's type in the target.
+func (s *stmt) castType(p int, tn typeName) (string, bool) {
+	// MySQL casts to CHAR for a string of any length.
+	if s.t.from == config.EngineMySQL && (tn.name == "char" || tn.name == "nchar") {
+		if tn.mods == "" {
+			tn.name = "text"
+		} else {
+			tn.name = "varchar"
+		}
+	}
+	mapped, err := s.t.mapType(tn)
+	if err != nil {
+		s.report(p, "%v", err)
+		return "", false
+	}
+	if s.t.to != config.EngineMySQL {
+		return mapped, true
+	}
+	cast, ok := mysqlCastType(mapped)
+	if !ok {
+		s.report(p, "%s cannot cast to %s", s.t.to, mapped)
+	}
+	return cast, ok
+}
+
+// mysqlCastType returns the type MySQL's CAST accepts for a column type. It
+// casts to a handful of types only: SIGNED and UNSIGNED for every integer,
+// CHAR for every string, and so on.
+func mysqlCastType(mapped string) (string, bool) {
+	base, mods := mapped, ""
+	if i := strings.IndexByte(mapped, '('); i >= 0 {
+		base, mods = mapped[:i], mapped[i:]
+	}
+	if strings.HasSuffix(base, " unsigned") {
+		return "unsigned", true
+	}
+	switch base {
+	case "tinyint", "smallint", "mediumint", "int", "integer", "bigint", "bool", "boolean":
+		return "signed", true
+	case "char", "varchar":
+		return "char" + mods, true
+	case "text", "tinytext", "mediumtext", "longtext":
+		return "char", true
+	case "decimal", "numeric":
+		return "decimal" + mods, true
+	case "double", "double precision", "real":
+		return "double", true
+	case "float":
+		return "float", true
+	case "timestamp":
+		return "datetime" + mods, true
+	case "date", "datetime", "time", "json", "year", "binary":
+		return base + mods, true
+	case "varbinary", "blob", "tinyblob", "mediumblob", "longblob":
+		return "binary" + mods, true
+	}
+	return "", false
+}
+
+// exprStart returns the index of the first token of the expression that ends
+// at p, for the operand of a :: cast.
+func (s *stmt) exprStart(p int, starts map[int]int) int {
+	if start, ok := starts[p]; ok {
+		return start
+	}
+	if s.is(p, ")") || s.is(p, "]") {
+		open := s.matchBack(p)
+		if open < 0 {
+			return p
+		}
+		p = open
+		if s.is(p, "[") {
+			return s.exprStart(p-1, starts)
+		}
+		if !s.isName(p-1) || exprKeywords[s.word(p-1)] {
+			return p
+		}
+		p--
+	}
+	for s.is(p-1, ".") && s.isName(p-2) {
+		p -= 2
+	}
+	return p
+}
+
+// isName reports whether the token at p is an identifier, quoted or not.
+func (s *stmt) isName(p int) bool {
+	kind := s.tok(p).kind
+	return (kind == tokWord || kind == tokIdent) && p >= 0 && p < len(s.sig)
+}
+
+// matchBack returns the index of the parenthesis or bracket that opens the one
+// at p, or -1.
+func (s *stmt) matchBack(p int) int {
+	close := s.tok(p).text
+	open := map[string]string{")": "(", "]": "["}[close]
+	depth := 0
+	for q := p; q >= 0; q-- {
+		switch {
+		case s.tok(q).is(close):
+			depth++
+		case s.tok(q).is(open):
+			depth--
+			if depth == 0 {
+				return q
+			}
+		}
+	}
+	return -1
+}
+
+// exprKeywords are the keywords that can come before a parenthesized
+// expression, which are not the name of a function called with it.
+var exprKeywords = map[string]bool{
+	"all": true, "and": true, "any": true, "as": true, "between": true,
+	"by": true, "case": true, "distinct": true, "else": true, "exists": true,
+	"from": true, "having": true, "in": true, "is": true, "join": true,
+	"like": true, "limit": true, "not": true, "offset": true, "on": true,
+	"or": true, "return": true, "returning": true, "select": true, "set": true,
+	"some": true, "then": true, "using": true, "values": true, "when": true,
+	"where": true, "with": true,
+}
@@ -0,0 +1,139 @@
+package translate
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+// clauses translates the clauses the target writes differently and reports
+// those it has no equivalent for.
+func (s *stmt) clauses() {
+	from, to := s.t.from, s.t.to
+	if s.is(0, "create") {
+		q := 1
+		if s.is(q, "or", "replace") {
+			q += 2
+		}
+		switch word := s.word(q); word {
+		case "type", "domain", "extension":
+			s.report(q, "CREATE %s has no equivalent in %s", strings.ToUpper(word), to)
+		case "function", "procedure", "trigger":
+			s.report(q, "the body of CREATE %s cannot be translated", strings.ToUpper(word))
+		case "sequence":
+			if to == config.EngineMySQL || to == config.EngineSQLite {
+				s.report(q, "sequences have no equivalent in %s", to)
+			}
+		}
+	}
+
+	depth := s.depths()
+	for p := range s.sig {
+		if s.done[s.sig[p]] {
+			continue
+		}
+		switch {
+		case s.is(p, "||") && (to == config.EngineMySQL || to == config.EngineMSSQL):
+			s.report(p, "|| does not concatenate strings in %s; use concat()", to)
+		case s.is(p, "ilike") && to != config.EnginePostgreSQL:
+			s.report(p, "ILIKE has no equivalent in %s", to)
+		case s.is(p, "distinct", "on") && to != config.EnginePostgreSQL:
+			s.report(p, "DISTINCT ON has no equivalent in %s", to)
+		case (s.is(p, "array", "[") || s.is(p, "array", "(")) && to != config.EnginePostgreSQL:
+			s.report(p, "arrays have no equivalent in %s", to)
+		case (s.is(p, "any", "(") || s.is(p, "all", "(")) && !s.is(p+2, "select") && from == config.EnginePostgreSQL:
+			s.report(p, "%s of an array has no equivalent in %s; use sqlc.slice()", strings.ToUpper(s.word(p)), to)
+		case s.is(p, "returning") && (to == config.EngineMySQL || to == config.EngineMSSQL):
+			s.report(p, "RETURNING has no equivalent in %s", to)
+		case s.is(p, "on", "conflict") && (to == config.EngineMySQL || to == config.EngineMSSQL):
+			s.report(p, "ON CONFLICT has no equivalent in %s", to)
+		case s.is(p, "on", "duplicate", "key") && to != config.EngineMySQL:
+			s.report(p, "ON DUPLICATE KEY UPDATE has no equivalent in %s", to)
+		case s.is(p, "insert", "ignore") && to != config.EngineMySQL:
+			s.report(p, "INSERT IGNORE has no equivalent in %s", to)
+		case s.is(p, "insert", "or") && to != config.EngineSQLite:
+			s.report(p, "INSERT OR %s has no equivalent in %s", strings.ToUpper(s.word(p+2)), to)
+		case s.is(p, "replace", "into") && (to == config.EnginePostgreSQL || to == config.EngineMSSQL):
+			s.report(p, "REPLACE INTO has no equivalent in %s", to)
+		case s.is(p, "top") && from == config.EngineMSSQL:
+			s.report(p, "TOP has no equivalent in %s; use LIMIT", to)
+		case s.is(p, "interval"):
+			s.report(p, "interval literals cannot be translated")
+		case (s.is(p, "for", "update") || s.is(p, "for", "share")) && (to == config.EngineSQLite || to == config.EngineMSSQL):
+			s.report(p, "row locking clauses have no equivalent in %s", to)
+		case (s.is(p, "true") || s.is(p, "false")) && to == config.EngineMSSQL:
+			// SQL Server has no boolean literals; bit columns hold 1 and 0.
+			if s.is(p, "true") {
+				s.out[s.sig[p]] = "1"
+			} else {
+				s.out[s.sig[p]] = "0"
+			}
+		case s.is(p, "limit") && depth[p] == 0:
+			s.limit(p)
+		}
+	}
+}
+
+// limit translates the LIMIT clause at p. SQL Server limits an ordered query
+// with OFFSET ... FETCH, and any other query with TOP.
+func (s *stmt) limit(p int) {
+	to := s.t.to
+	simple := func(q int) bool {
+		kind := s.tok(q).kind
+		return kind == tokNumber || kind == tokParam
+	}
+	count, skip, end := p+1, -1, p+1
+	switch {
+	case s.is(p+2, ","):
+		// MySQL and SQLite also write LIMIT skip, count.
+		skip, count, end = p+1, p+3, p+3
+	case s.is(p+2, "offset"):
+		skip, end = p+3, p+3
+	}
+	if !simple(count) || (skip >= 0 && !simple(skip)) || end != s.last() {
+		if to == config.EngineMSSQL {
+			s.report(p, "LIMIT has no equivalent in %s", to)
+		}
+		return
+	}
+	countText := s.out[s.sig[count]]
+	skipText := "0"
+	if skip >= 0 {
+		skipText = s.out[s.sig[skip]]
+	}
+	upper := s.tok(p).text == "LIMIT"
+	keywords := func(text string) string {
+		if !upper {
+			return strings.ToLower(text)
+		}
+		return text
+	}
+
+	switch to {
+	case config.EngineMSSQL:
+		ordered := false
+		depth := s.depths()
+		for q := 0; q < p; q++ {
+			if s.is(q, "order", "by") && depth[q] == 0 {
+				ordered = true
+			}
+		}
+		switch {
+		case ordered:
+			s.replace(p, end, keywords("OFFSET "+skipText+" ROWS FETCH NEXT "+countText+" ROWS ONLY"))
+		case skip < 0 && s.is(0, "select"):
+			q := 0
+			if s.is(1, "distinct") {
+				q = 1
+			}
+			s.out[s.sig[q]] += keywords(" TOP ") + "(" + countText + ")"
+			s.remove(p, end)
+		default:
+			s.report(p, "%s skips rows with OFFSET only in a query with ORDER BY", to)
+		}
+	case config.EnginePostgreSQL:
+		if s.is(p+2, ",") {
+			s.replace(p, end, keywords("LIMIT ")+countText+keywords(" OFFSET ")+skipText)
+		}
+	}
+}
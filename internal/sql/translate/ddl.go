@@ -0,0 +1,293 @@
+package translate
+
+import (
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+// ddl translates the column definitions of CREATE TABLE and ALTER TABLE.
+func (s *stmt) ddl() {
+	switch {
+	case s.is(0, "create"):
+		s.createTable()
+	case s.is(0, "alter", "table"):
+		s.alterTable()
+	}
+}
+
+// last returns the index of the statement's last significant token other
+// than its terminating semicolon.
+func (s *stmt) last() int {
+	q := len(s.sig) - 1
+	for q >= 0 && s.is(q, ";") {
+		q--
+	}
+	return q
+}
+
+// skipName returns the index just past the possibly qualified name at p.
+func (s *stmt) skipName(p int) int {
+	p++
+	for s.is(p, ".") {
+		p += 2
+	}
+	return p
+}
+
+// split calls fn for each comma-separated item of the significant tokens
+// from p to end, inclusive.
+func (s *stmt) split(p, end int, fn func(a, b int)) {
+	start := p
+	for q := p; q <= end+1; q++ {
+		if q <= end && s.is(q, "(") {
+			if close := s.match(q); close > 0 {
+				q = close
+			}
+			continue
+		}
+		if q > end || s.is(q, ",") {
+			if start < q {
+				fn(start, q-1)
+			}
+			start = q + 1
+		}
+	}
+}
+
+func (s *stmt) createTable() {
+	p := 1
+	for {
+		switch s.word(p) {
+		case "temp", "temporary", "global", "local", "unlogged":
+			p++
+			continue
+		}
+		break
+	}
+	if !s.is(p, "table") {
+		return
+	}
+	p++
+	if s.is(p, "if", "not", "exists") {
+		if s.t.to == config.EngineMSSQL {
+			s.report(p, "CREATE TABLE IF NOT EXISTS has no equivalent in %s", s.t.to)
+		}
+		p += 3
+	}
+	p = s.skipName(p)
+	if !s.is(p, "(") {
+		// CREATE TABLE ... AS SELECT and CREATE TABLE ... LIKE have no
+		// columns to translate.
+		return
+	}
+	end := s.match(p)
+	if end < 0 {
+		return
+	}
+	s.split(p+1, end-1, s.tableElement)
+
+	// What follows the column list is storage: MySQL's ENGINE and CHARSET,
+	// SQLite's STRICT and WITHOUT ROWID. It has no bearing on the schema sqlc
+	// sees, so it is dropped. The table options of the other engines, such as
+	// PostgreSQL's PARTITION BY, are not.
+	if last := s.last(); last > end {
+		switch s.t.from {
+		case config.EngineMySQL, config.EngineSQLite:
+			s.remove(end+1, last)
+		default:
+			s.report(end+1, "table options have no equivalent in %s", s.t.to)
+		}
+	}
+}
+
+func (s *stmt) alterTable() {
+	p := 2
+	if s.is(p, "if", "exists") {
+		p += 2
+	}
+	if s.is(p, "only") {
+		p++
+	}
+	p = s.skipName(p)
+	s.split(p, s.last(), func(a, b int) {
+		switch {
+		case s.is(a, "add"):
+			c := a + 1
+			if s.is(c, "column") {
+				// SQL Server adds a column with ADD alone.
+				if s.t.to == config.EngineMSSQL {
+					s.remove(c, c)
+				}
+				c++
+			} else if isConstraint(s.word(c)) {
+				s.tableElement(c, b)
+				return
+			}
+			if s.is(c, "if", "not", "exists") {
+				c += 3
+			}
+			s.column(c, b)
+		case s.is(a, "modify"), s.is(a, "change"):
+			s.report(a, "changing a column's definition cannot be translated")
+		case s.is(a, "alter"):
+			for q := a; q <= b; q++ {
+				if s.is(q, "type") || s.t.from == config.EngineMSSQL {
+					s.report(a, "changing a column's type cannot be translated")
+					return
+				}
+			}
+		case s.is(a, "rename") && s.t.to == config.EngineMSSQL:
+			s.report(a, "%s renames with sp_rename, not ALTER TABLE", s.t.to)
+		}
+	})
+}
+
+// isConstraint reports whether a table element starting with word declares a
+// constraint or an index rather than a column.
+func isConstraint(word string) bool {
+	switch word {
+	case "constraint", "primary", "foreign", "unique", "check", "exclude",
+		"key", "index", "fulltext", "spatial", "period", "like":
+		return true
+	}
+	return false
+}
+
+// tableElement translates a column or table constraint of CREATE TABLE.
+func (s *stmt) tableElement(a, b int) {
+	word := s.word(a)
+	if !isConstraint(word) {
+		s.column(a, b)
+		return
+	}
+	switch word {
+	case "unique":
+		// MySQL names a unique key in the element: UNIQUE KEY name (...).
+		if (s.is(a+1, "key") || s.is(a+1, "index")) && s.t.to != config.EngineMySQL {
+			end := a + 1
+			if !s.is(a+2, "(") {
+				end = a + 2
+			}
+			s.remove(a+1, end)
+		}
+	case "key", "index", "fulltext", "spatial":
+		if s.t.to != config.EngineMySQL {
+			s.report(a, "an index declared in CREATE TABLE has no equivalent in %s; create it with CREATE INDEX", s.t.to)
+		}
+	case "exclude":
+		s.report(a, "exclusion constraints have no equivalent in %s", s.t.to)
+	}
+}
+
+// column translates a column definition: its type, how it auto-increments,
+// and the options the target has no equivalent for.
+func (s *stmt) column(a, b int) {
+	tn, q, ok := s.parseType(a + 1)
+	if !ok || q > b {
+		// SQLite allows a column without a type.
+		return
+	}
+	identity := false
+	if base, ok := serialTypes[tn.name]; ok && s.t.from == config.EnginePostgreSQL {
+		tn.name = base
+		identity = true
+	}
+	primary, references := false, false
+	for r := q + 1; r <= b; r++ {
+		switch {
+		case s.is(r, "("):
+			if close := s.match(r); close > 0 {
+				r = close
+			}
+		case s.is(r, "auto_increment"), s.is(r, "autoincrement"):
+			identity = true
+			s.remove(r, r)
+		case s.is(r, "identity"):
+			end := r
+			if s.is(r+1, "(") {
+				end = s.match(r + 1)
+			}
+			identity = true
+			s.remove(r, end)
+			r = end
+		case s.is(r, "generated", "always", "as", "identity"), s.is(r, "generated", "by", "default", "as", "identity"):
+			end := r + 4
+			if s.is(r+1, "by") {
+				end++
+			}
+			if s.is(end+1, "(") {
+				end = s.match(end + 1)
+			}
+			identity = true
+			s.remove(r, end)
+			r = end
+		case s.is(r, "primary", "key"):
+			primary = true
+		case s.is(r, "references"):
+			references = true
+		case s.is(r, "on", "update") && !references:
+			s.report(r, "ON UPDATE for a column has no equivalent in %s", s.t.to)
+		case s.is(r, "comment") && s.t.to != config.EngineMySQL:
+			s.report(r, "column comments have no equivalent in %s", s.t.to)
+		case s.is(r, "after"), s.is(r, "first"):
+			s.report(r, "column positions have no equivalent in %s", s.t.to)
+		case s.is(r, "character", "set"), s.is(r, "charset"):
+			// Every target stores text as Unicode.
+			end := r + 1
+			if s.is(r, "character") {
+				end++
+			}
+			s.remove(r, end)
+			r = end
+		case s.is(r, "collate"):
+			s.report(r, "collations have no equivalent in %s", s.t.to)
+		}
+	}
+	// In SQLite an INTEGER PRIMARY KEY column is the rowid, which is
+	// assigned automatically.
+	if s.t.from == config.EngineSQLite && tn.name == "integer" && primary {
+		identity = true
+	}
+	if !identity {
+		mapped, ok := s.translateType(a+1, q, tn)
+		if ok && (mapped == "boolean" || mapped == "bool") && s.t.to == config.EnginePostgreSQL {
+			s.booleanDefault(q+1, b)
+		}
+		return
+	}
+
+	// Auto-incrementing values start at one, so the signed type of the same
+	// size holds them.
+	tn.unsigned = false
+	clause, columnType, err := s.t.identity()
+	if err != nil {
+		s.report(a, "%v", err)
+		return
+	}
+	if columnType != "" {
+		if !primary {
+			s.report(a, "an auto-incrementing column must be the INTEGER PRIMARY KEY in %s", s.t.to)
+			return
+		}
+		s.writeType(a+1, q, columnType)
+		return
+	}
+	if _, ok := s.translateType(a+1, q, tn); ok {
+		s.out[s.sig[q]] += " " + clause
+	}
+}
+
+// booleanDefault rewrites a default of 0 or 1 for a column that became a
+// boolean, which PostgreSQL does not convert from an integer.
+func (s *stmt) booleanDefault(a, b int) {
+	for r := a; r < b; r++ {
+		if !s.is(r, "default") || s.tok(r+1).kind != tokNumber {
+			continue
+		}
+		switch s.tok(r + 1).text {
+		case "0":
+			s.out[s.sig[r+1]] = "false"
+		case "1":
+			s.out[s.sig[r+1]] = "true"
+		}
+	}
+}
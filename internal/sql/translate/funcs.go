@@ -0,0 +1,165 @@
+package translate
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/core"
+)
+
+// funcGroup lists the names each engine gives a function. The first name is
+// the one written when translating into the engine; the others are
+// translated from.
+type funcGroup map[config.Engine][]string
+
+var funcGroups = []funcGroup{
+	{
+		config.EnginePostgreSQL: {"coalesce"},
+		config.EngineMySQL:      {"ifnull"},
+		config.EngineSQLite:     {"ifnull"},
+		config.EngineMSSQL:      {"isnull"},
+	},
+	{
+		config.EnginePostgreSQL: {"now", "transaction_timestamp"},
+		config.EngineMySQL:      {"now", "current_timestamp", "localtimestamp"},
+		config.EngineSQLite:     {"CURRENT_TIMESTAMP"},
+		config.EngineMSSQL:      {"sysdatetime", "getdate"},
+	},
+	{
+		config.EnginePostgreSQL: {"length", "char_length", "character_length"},
+		config.EngineMySQL:      {"char_length", "character_length"},
+		config.EngineSQLite:     {"length"},
+		config.EngineMSSQL:      {"len"},
+	},
+	{
+		config.EnginePostgreSQL: {"octet_length"},
+		config.EngineMySQL:      {"length", "octet_length"},
+		config.EngineMSSQL:      {"datalength"},
+	},
+	{
+		config.EnginePostgreSQL: {"random"},
+		config.EngineMySQL:      {"rand"},
+		config.EngineSQLite:     {"random"},
+		config.EngineMSSQL:      {"rand"},
+	},
+	{
+		config.EnginePostgreSQL: {"substring", "substr"},
+		config.EngineMySQL:      {"substring", "substr", "mid"},
+		config.EngineSQLite:     {"substr", "substring"},
+		config.EngineMSSQL:      {"substring"},
+	},
+	{
+		config.EnginePostgreSQL: {"lastval"},
+		config.EngineMySQL:      {"last_insert_id"},
+		config.EngineSQLite:     {"last_insert_rowid"},
+		config.EngineMSSQL:      {"scope_identity"},
+	},
+	{
+		config.EnginePostgreSQL: {"gen_random_uuid", "uuid_generate_v4"},
+		config.EngineMySQL:      {"uuid"},
+		config.EngineMSSQL:      {"newid"},
+	},
+	{
+		config.EnginePostgreSQL: {"chr"},
+		config.EngineMySQL:      {"char"},
+		config.EngineSQLite:     {"char"},
+		config.EngineMSSQL:      {"char"},
+	},
+	{
+		config.EnginePostgreSQL: {"ceil", "ceiling"},
+		config.EngineMySQL:      {"ceil", "ceiling"},
+		config.EngineSQLite:     {"ceil", "ceiling"},
+		config.EngineMSSQL:      {"ceiling"},
+	},
+	{
+		config.EnginePostgreSQL: {"power", "pow"},
+		config.EngineMySQL:      {"pow", "power"},
+		config.EngineSQLite:     {"pow", "power"},
+		config.EngineMSSQL:      {"power"},
+	},
+}
+
+// standardFuncs are functions of standard SQL that every target has, whether
+// or not its catalog lists them.
+var standardFuncs = map[string]bool{
+	"abs": true, "avg": true, "coalesce": true, "concat": true, "count": true,
+	"floor": true, "lower": true, "ltrim": true, "max": true, "min": true,
+	"nullif": true, "replace": true, "round": true, "rtrim": true,
+	"sign": true, "sqrt": true, "sum": true, "trim": true, "upper": true,
+}
+
+// funcIndex maps each name the engine gives a function to its group.
+// COALESCE, which is standard, only ever translates into the engine.
+func funcIndex(engine config.Engine) map[string]funcGroup {
+	index := map[string]funcGroup{}
+	for _, group := range funcGroups {
+		for _, name := range group[engine] {
+			if !standardFuncs[name] {
+				index[name] = group
+			}
+		}
+	}
+	return index
+}
+
+// niladic lists the function spellings that are keywords called without
+// parentheses.
+var niladic = map[string]bool{
+	"CURRENT_TIMESTAMP": true,
+}
+
+// functions renames the functions the target spells differently, and reports
+// those it has no equivalent for.
+func (s *stmt) functions() {
+	for p := range s.sig {
+		name := s.word(p)
+		if name == "" || s.done[s.sig[p]] || !s.is(p+1, "(") || s.is(p-1, ".") || exprKeywords[name] || name == "cast" || name == "try_cast" {
+			continue
+		}
+		// A name before a parenthesis that is not a call: a table and its
+		// column list, or an index and its columns.
+		switch s.word(p - 1) {
+		case "into", "table", "references", "on", "index", "exists", "key", "view", "function", "procedure":
+			continue
+		}
+
+		if group, ok := s.t.funcsByName[name]; ok {
+			if names := group[s.t.to]; len(names) > 0 {
+				s.renameFunc(p, names[0])
+			} else {
+				s.report(p, "function %s has no equivalent in %s", name, s.t.to)
+			}
+			continue
+		}
+		if standardFuncs[name] || hasFunc(s.t.toCat, name) || !hasFunc(s.t.fromCat, name) {
+			continue
+		}
+		s.report(p, "function %s has no equivalent in %s", name, s.t.to)
+	}
+}
+
+// renameFunc writes the function called at p as name.
+func (s *stmt) renameFunc(p int, name string) {
+	if niladic[name] {
+		if end := s.match(p + 1); end == p+2 {
+			s.replace(p, end, name)
+			return
+		}
+		s.report(p, "function %s has no equivalent in %s", s.word(p), s.t.to)
+		return
+	}
+	if s.word(p) == name {
+		return
+	}
+	if text := s.tok(p).text; text == strings.ToUpper(text) {
+		name = strings.ToUpper(name)
+	}
+	s.out[s.sig[p]] = name
+	s.done[s.sig[p]] = true
+}
+
+// hasFunc reports whether the catalog has a function called name.
+func hasFunc(cat *core.Catalog, name string) bool {
+	procs, err := cat.FindProcs(name, nil)
+	return err == nil && len(procs) > 0
+}
@@ -0,0 +1,270 @@
+package translate
+
+import (
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+// syntax is the lexical side of an engine: how it quotes strings and
+// identifiers, which comments it allows, and how it spells parameters.
+type syntax struct {
+	// identQuotes are the characters that open a quoted identifier. The
+	// first is the one translated identifiers are quoted with.
+	identQuotes string
+	// doubleQuoteString reports whether "..." is a string literal.
+	doubleQuoteString bool
+	// backslash reports whether a backslash escapes the next character
+	// inside a string literal.
+	backslash bool
+	// hashComment reports whether # starts a line comment.
+	hashComment bool
+	// dollarQuote reports whether $tag$ ... $tag$ is a string literal.
+	dollarQuote bool
+
+	// Parameter syntax. Positional parameters are written as ? (question),
+	// ?NNN (questionNumber) or $NNN (dollarNumber). Named ones are written as
+	// @name (atName), :name (colonName) or {name:Type} (braceName).
+	question       bool
+	questionNumber bool
+	dollarNumber   bool
+	atName         bool
+	colonName      bool
+	braceName      bool
+}
+
+var syntaxes = map[config.Engine]syntax{
+	config.EnginePostgreSQL: {
+		identQuotes:  `"`,
+		dollarQuote:  true,
+		dollarNumber: true,
+		atName:       true,
+	},
+	config.EngineMySQL: {
+		identQuotes:       "`",
+		doubleQuoteString: true,
+		backslash:         true,
+		hashComment:       true,
+		question:          true,
+	},
+	config.EngineSQLite: {
+		identQuotes:    "\"`[",
+		question:       true,
+		questionNumber: true,
+		atName:         true,
+		colonName:      true,
+	},
+	config.EngineMSSQL: {
+		identQuotes: `["`,
+		atName:      true,
+	},
+	config.EngineGoogleSQL: {
+		identQuotes:       "`",
+		doubleQuoteString: true,
+		backslash:         true,
+		hashComment:       true,
+		question:          true,
+		atName:            true,
+	},
+	config.EngineClickHouse: {
+		identQuotes: "`\"",
+		backslash:   true,
+		question:    true,
+		braceName:   true,
+	},
+}
+
+type tokenKind int
+
+const (
+	tokSpace tokenKind = iota
+	tokComment
+	tokString
+	tokIdent // a quoted identifier
+	tokWord  // a keyword or an unquoted identifier
+	tokNumber
+	tokParam
+	tokPunct
+)
+
+type token struct {
+	kind       tokenKind
+	start, end int
+	text       string
+}
+
+// is reports whether the token is the given keyword or punctuation.
+func (t token) is(s string) bool {
+	switch t.kind {
+	case tokWord:
+		return strings.EqualFold(t.text, s)
+	case tokPunct:
+		return t.text == s
+	}
+	return false
+}
+
+// lex splits src into tokens. Whitespace and comments are tokens too, so the
+// tokens cover every byte of src.
+func lex(sx syntax, src string) []token {
+	var toks []token
+	for i := 0; i < len(src); {
+		kind, end := lexOne(sx, src, i)
+		if end <= i {
+			end = i + 1
+		}
+		toks = append(toks, token{kind: kind, start: i, end: end, text: src[i:end]})
+		i = end
+	}
+	return toks
+}
+
+func lexOne(sx syntax, src string, i int) (tokenKind, int) {
+	at := func(j int) byte {
+		if j >= 0 && j < len(src) {
+			return src[j]
+		}
+		return 0
+	}
+	c := src[i]
+	switch {
+	case isSpace(c):
+		j := i
+		for j < len(src) && isSpace(src[j]) {
+			j++
+		}
+		return tokSpace, j
+	case c == '-' && at(i+1) == '-', c == '#' && sx.hashComment:
+		if idx := strings.IndexByte(src[i:], '\n'); idx >= 0 {
+			return tokComment, i + idx
+		}
+		return tokComment, len(src)
+	case c == '/' && at(i+1) == '*':
+		if idx := strings.Index(src[i+2:], "*/"); idx >= 0 {
+			return tokComment, i + 2 + idx + 2
+		}
+		return tokComment, len(src)
+	case c == '\'':
+		return tokString, skipQuoted(src, i, '\'', sx.backslash)
+	case c == '"' && sx.doubleQuoteString:
+		return tokString, skipQuoted(src, i, '"', sx.backslash)
+	case strings.IndexByte(sx.identQuotes, c) >= 0:
+		if c == '[' {
+			if idx := strings.IndexByte(src[i:], ']'); idx >= 0 {
+				return tokIdent, i + idx + 1
+			}
+			return tokIdent, len(src)
+		}
+		return tokIdent, skipQuoted(src, i, c, false)
+	case c == '$' && sx.dollarQuote && dollarTag(src, i) != "":
+		tag := dollarTag(src, i)
+		if idx := strings.Index(src[i+len(tag):], tag); idx >= 0 {
+			return tokString, i + len(tag) + idx + len(tag)
+		}
+		return tokString, len(src)
+	case c == '$' && sx.dollarNumber && isDigit(at(i+1)):
+		return tokParam, digitsEnd(src, i+1)
+	case c == '?' && sx.question:
+		if sx.questionNumber && isDigit(at(i+1)) {
+			return tokParam, digitsEnd(src, i+1)
+		}
+		return tokParam, i + 1
+	case c == '@' && sx.atName && isIdentStart(at(i+1)):
+		return tokParam, identEnd(src, i+1)
+	case c == ':' && sx.colonName && isIdentStart(at(i+1)) && at(i-1) != ':':
+		return tokParam, identEnd(src, i+1)
+	case c == '{' && sx.braceName && isIdentStart(at(i+1)):
+		if idx := strings.IndexByte(src[i:], '}'); idx >= 0 {
+			return tokParam, i + idx + 1
+		}
+	case (c == 'N' || c == 'n') && at(i+1) == '\'' && !isIdentPart(at(i-1)):
+		// A national character string, N'...'.
+		return tokString, skipQuoted(src, i+1, '\'', sx.backslash)
+	case (c == 'E' || c == 'e') && sx.dollarQuote && at(i+1) == '\'' && !isIdentPart(at(i-1)):
+		// A PostgreSQL escape string, E'...'.
+		return tokString, skipQuoted(src, i+1, '\'', true)
+	case isIdentStart(c):
+		return tokWord, identEnd(src, i)
+	case isDigit(c) || (c == '.' && isDigit(at(i+1))):
+		j := i
+		for j < len(src) && (isDigit(src[j]) || src[j] == '.') {
+			j++
+		}
+		if (at(j) == 'e' || at(j) == 'E') && (isDigit(at(j+1)) || ((at(j+1) == '-' || at(j+1) == '+') && isDigit(at(j+2)))) {
+			j = digitsEnd(src, j+2)
+		}
+		return tokNumber, j
+	}
+	for _, op := range []string{"::", "<>", "!=", "<=", ">=", "||", "->>", "->"} {
+		if strings.HasPrefix(src[i:], op) {
+			return tokPunct, i + len(op)
+		}
+	}
+	return tokPunct, i + 1
+}
+
+// skipQuoted returns the offset just past the quoted text that starts at i. A
+// doubled quote character is an escaped quote.
+func skipQuoted(src string, i int, quote byte, backslash bool) int {
+	for j := i + 1; j < len(src); j++ {
+		switch src[j] {
+		case '\\':
+			if backslash {
+				j++
+			}
+		case quote:
+			if j+1 < len(src) && src[j+1] == quote {
+				j++
+				continue
+			}
+			return j + 1
+		}
+	}
+	return len(src)
+}
+
+// dollarTag returns the opening delimiter ("$$" or "$tag$") of a dollar-quoted
+// string starting at i, or "" when there is none.
+func dollarTag(src string, i int) string {
+	j := i + 1
+	for j < len(src) && src[j] != '$' {
+		if !isIdentPart(src[j]) || (j == i+1 && isDigit(src[j])) {
+			return ""
+		}
+		j++
+	}
+	if j >= len(src) {
+		return ""
+	}
+	return src[i : j+1]
+}
+
+func identEnd(src string, i int) int {
+	for i < len(src) && isIdentPart(src[i]) {
+		i++
+	}
+	return i
+}
+
+func digitsEnd(src string, i int) int {
+	for i < len(src) && isDigit(src[i]) {
+		i++
+	}
+	return i
+}
+
+func isSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r' || c == '\f'
+}
+
+func isDigit(c byte) bool {
+	return c >= '0' && c <= '9'
+}
+
+func isIdentStart(c byte) bool {
+	return c == '_' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || c >= 0x80
+}
+
+func isIdentPart(c byte) bool {
+	return isIdentStart(c) || isDigit(c) || c == '$'
+}
@@ -0,0 +1,81 @@
+package translate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+// param is a placeholder in the source statement.
+type param struct {
+	tok int
+	// number is the position of a positional parameter, and 0 for a named
+	// one.
+	number int
+	name   string
+}
+
+// params translates placeholders. Positional parameters keep their numbers:
+// a bare ? is numbered one past the highest number before it, as SQLite does.
+// Named parameters become the target's named placeholders, or sqlc.arg() in
+// an engine without any. The sqlc.arg() family is left alone, as every engine
+// accepts it.
+func (s *stmt) params() {
+	var params []param
+	highest := 0
+	for i, tok := range s.toks {
+		if tok.kind != tokParam {
+			continue
+		}
+		p := param{tok: i}
+		switch text := tok.text; {
+		case text == "?":
+			highest++
+			p.number = highest
+		case text[0] == '?' || text[0] == '$':
+			p.number, _ = strconv.Atoi(text[1:])
+			highest = max(highest, p.number)
+		case text[0] == '{':
+			name, _, _ := strings.Cut(strings.Trim(text, "{}"), ":")
+			p.name = strings.TrimSpace(name)
+		default:
+			p.name = text[1:]
+		}
+		params = append(params, p)
+	}
+
+	// Engines whose positional parameters are all written ? bind them in
+	// order, so the statement must use each number once and in order.
+	inOrder := true
+	n := 0
+	for _, p := range params {
+		if p.number == 0 {
+			continue
+		}
+		n++
+		if p.number != n {
+			inOrder = false
+			if s.t.to == config.EngineMySQL {
+				s.problems = append(s.problems, problem{s.toks[p.tok].start,
+					fmt.Errorf("parameter %s is out of order or repeated; %s binds parameters by position", s.toks[p.tok].text, s.t.to)})
+				return
+			}
+		}
+	}
+
+	for _, p := range params {
+		switch {
+		case p.number != 0 && !inOrder && s.t.toSyntax.questionNumber:
+			s.out[p.tok] = "?" + strconv.Itoa(p.number)
+		case p.number != 0:
+			s.out[p.tok] = s.t.target.Param(p.number)
+		case s.t.toSyntax.atName || s.t.toSyntax.colonName:
+			s.out[p.tok] = s.t.target.NamedParam(p.name)
+		default:
+			s.out[p.tok] = "sqlc.arg(" + p.name + ")"
+		}
+		s.done[p.tok] = true
+	}
+}
@@ -0,0 +1,549 @@
+// Package translate rewrites schema and query files written for one database
+// engine into the SQL of another.
+//
+// The translation works on the text of each statement rather than on sqlc's
+// AST, so everything it has no reason to change, including comments, layout
+// and the "-- name:" annotations, is kept as written. The source engine's
+// parser finds the statements; each one is then rewritten token by token:
+// quoting, placeholders, casts, column types and functions. Types are mapped
+// through the core catalogs of the two engines. A construct that has no
+// equivalent in the target is left as written and reported, and every
+// translated statement must parse in the target engine.
+package translate
+
+import (
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/core"
+	"github.com/sqlc-dev/sqlc/internal/engine/clickhouse"
+	"github.com/sqlc-dev/sqlc/internal/engine/dolphin"
+	"github.com/sqlc-dev/sqlc/internal/engine/googlesql"
+	"github.com/sqlc-dev/sqlc/internal/engine/mssql"
+	"github.com/sqlc-dev/sqlc/internal/engine/postgresql"
+	"github.com/sqlc-dev/sqlc/internal/engine/sqlite"
+	"github.com/sqlc-dev/sqlc/internal/multierr"
+	"github.com/sqlc-dev/sqlc/internal/sql/ast"
+	"github.com/sqlc-dev/sqlc/internal/sql/canonical"
+	"github.com/sqlc-dev/sqlc/internal/sql/format"
+)
+
+type parser interface {
+	Parse(io.Reader) ([]ast.Statement, error)
+	format.Dialect
+}
+
+// engine returns the parser and catalog dialect of an engine.
+func engine(e config.Engine) (parser, core.Option, error) {
+	switch e {
+	case config.EnginePostgreSQL:
+		return postgresql.NewParser(), postgresql.Dialect(), nil
+	case config.EngineMySQL:
+		return dolphin.NewParser(), dolphin.Dialect(), nil
+	case config.EngineSQLite:
+		return sqlite.NewParser(), sqlite.Dialect(), nil
+	case config.EngineClickHouse:
+		return clickhouse.NewParser(), clickhouse.Dialect(), nil
+	case config.EngineGoogleSQL:
+		return googlesql.NewParser(), googlesql.Dialect(), nil
+	case config.EngineMSSQL:
+		return mssql.NewParser(), mssql.Dialect(), nil
+	}
+	return nil, nil, fmt.Errorf("unknown engine %q", e)
+}
+
+// targets are the engines SQL can be translated into. ClickHouse and
+// GoogleSQL tables are declared too differently from the others, with table
+// engines and nullability in the type, to be a target.
+var targets = map[config.Engine]bool{
+	config.EnginePostgreSQL: true,
+	config.EngineMySQL:      true,
+	config.EngineSQLite:     true,
+	config.EngineMSSQL:      true,
+}
+
+// A Translator rewrites SQL from one engine into another.
+type Translator struct {
+	from, to    config.Engine
+	fromSyntax  syntax
+	toSyntax    syntax
+	parser      parser
+	target      parser
+	fromCat     *core.Catalog
+	toCat       *core.Catalog
+	funcsByName map[string]funcGroup
+}
+
+// New returns a Translator from one engine to another. It must be closed
+// when no longer needed.
+func New(from, to config.Engine) (*Translator, error) {
+	if from == to {
+		return nil, fmt.Errorf("cannot translate from %s to itself", from)
+	}
+	if !targets[to] {
+		return nil, fmt.Errorf("translating into %s is not supported", to)
+	}
+	fromParser, fromDialect, err := engine(from)
+	if err != nil {
+		return nil, err
+	}
+	toParser, toDialect, err := engine(to)
+	if err != nil {
+		return nil, err
+	}
+	fromCat, err := core.New(fromDialect)
+	if err != nil {
+		return nil, fmt.Errorf("%s catalog: %w", from, err)
+	}
+	toCat, err := core.New(toDialect)
+	if err != nil {
+		fromCat.Close()
+		return nil, fmt.Errorf("%s catalog: %w", to, err)
+	}
+	return &Translator{
+		from:        from,
+		to:          to,
+		fromSyntax:  syntaxes[from],
+		toSyntax:    syntaxes[to],
+		parser:      fromParser,
+		target:      toParser,
+		fromCat:     fromCat,
+		toCat:       toCat,
+		funcsByName: funcIndex(from),
+	}, nil
+}
+
+// Close releases the catalogs.
+func (t *Translator) Close() error {
+	err := t.fromCat.Close()
+	if cerr := t.toCat.Close(); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// File translates the statements of a schema or query file. Along with the
+// translation, which is complete except for the constructs that could not be
+// translated, it returns a *multierr.Error that locates each of them in src.
+func (t *Translator) File(filename, src string) (string, error) {
+	merr := multierr.New()
+	stmts, err := t.parser.Parse(strings.NewReader(src))
+	if err != nil {
+		merr.Add(filename, src, 0, err)
+		return src, merr
+	}
+	toks := lex(t.fromSyntax, src)
+
+	var b strings.Builder
+	for _, seg := range segments(toks, stmts) {
+		if !seg.stmt {
+			for _, tok := range seg.toks {
+				if tok.kind == tokComment {
+					b.WriteString(t.comment(tok.text))
+				} else {
+					b.WriteString(tok.text)
+				}
+			}
+			continue
+		}
+		s := newStmt(t, src, seg.toks)
+		s.translate()
+		out := s.String()
+		sort.SliceStable(s.problems, func(i, j int) bool { return s.problems[i].offset < s.problems[j].offset })
+		for _, p := range s.problems {
+			merr.Add(filename, src, p.offset, p.err)
+		}
+		if len(s.problems) == 0 {
+			if err := t.validate(out); err != nil {
+				merr.Add(filename, src, s.start(), fmt.Errorf("translated statement is not valid %s: %w", t.to, err))
+			}
+		}
+		b.WriteString(out)
+	}
+	if len(merr.Errs()) > 0 {
+		return b.String(), merr
+	}
+	return b.String(), nil
+}
+
+// validate checks that a translated statement parses in the target engine.
+func (t *Translator) validate(sql string) error {
+	sql = strings.TrimRight(strings.TrimSpace(sql), ";")
+	if canonical.Lossless(t.to) {
+		_, err := canonical.String(t.to, sql)
+		return err
+	}
+	// sqlc's parsers for the other engines leave out the statements that
+	// code generation has no use for, so only a parse error counts.
+	_, err := t.target.Parse(strings.NewReader(sql))
+	return err
+}
+
+type segment struct {
+	// stmt reports whether the segment holds a statement, rather than only
+	// comments, whitespace and semicolons.
+	stmt bool
+	toks []token
+}
+
+// segments groups the tokens of a file into statements. The parser's
+// statement extents are used where it has them; text outside of every extent,
+// such as a statement the parser skips, is split on semicolons.
+func segments(toks []token, stmts []ast.Statement) []segment {
+	type extent struct{ start, end int }
+	var extents []extent
+	for _, stmt := range stmts {
+		if stmt.Raw == nil {
+			continue
+		}
+		start := stmt.Raw.StmtLocation
+		extents = append(extents, extent{start, start + stmt.Raw.StmtLen})
+	}
+	sort.Slice(extents, func(i, j int) bool { return extents[i].start < extents[j].start })
+
+	var segs []segment
+	var cur []token
+	flush := func() {
+		if len(cur) == 0 {
+			return
+		}
+		seg := segment{toks: cur}
+		for _, tok := range cur {
+			if tok.kind != tokSpace && tok.kind != tokComment && !tok.is(";") {
+				seg.stmt = true
+			}
+		}
+		segs = append(segs, seg)
+		cur = nil
+	}
+	e := 0
+	inside := false
+	for _, tok := range toks {
+		for e < len(extents) && tok.start >= extents[e].end {
+			e++
+			if inside {
+				flush()
+				inside = false
+			}
+		}
+		if e < len(extents) && tok.start >= extents[e].start {
+			if !inside {
+				flush()
+				inside = true
+			}
+			cur = append(cur, tok)
+			continue
+		}
+		cur = append(cur, tok)
+		if tok.is(";") {
+			flush()
+		}
+	}
+	flush()
+	return segs
+}
+
+// comment returns a comment as the target engine writes it.
+func (t *Translator) comment(text string) string {
+	if strings.HasPrefix(text, "#") {
+		text = "--" + text[1:]
+	}
+	// MySQL only starts a comment at "--" followed by a space.
+	if t.to == config.EngineMySQL && strings.HasPrefix(text, "--") && len(text) > 2 && !isSpace(text[2]) {
+		text = "-- " + text[2:]
+	}
+	return text
+}
+
+type problem struct {
+	offset int
+	err    error
+}
+
+// stmt is a statement being translated. Each token's translation is kept in
+// out; a token that the translation removes has an empty out.
+type stmt struct {
+	t    *Translator
+	src  string
+	toks []token
+	out  []string
+	// sig holds the indexes of the tokens that are not whitespace or
+	// comments. Most passes work on these.
+	sig []int
+	// done marks the tokens that a pass has already translated, such as the
+	// words of a type, so that later passes leave them alone.
+	done     []bool
+	problems []problem
+}
+
+func newStmt(t *Translator, src string, toks []token) *stmt {
+	s := &stmt{
+		t:    t,
+		src:  src,
+		toks: toks,
+		out:  make([]string, len(toks)),
+		done: make([]bool, len(toks)),
+	}
+	for i, tok := range toks {
+		s.out[i] = tok.text
+		if tok.kind != tokSpace && tok.kind != tokComment {
+			s.sig = append(s.sig, i)
+		}
+	}
+	return s
+}
+
+func (s *stmt) String() string {
+	return strings.Join(s.out, "")
+}
+
+// start returns the offset of the statement's first significant token.
+func (s *stmt) start() int {
+	if len(s.sig) > 0 {
+		return s.toks[s.sig[0]].start
+	}
+	return s.toks[0].start
+}
+
+func (s *stmt) translate() {
+	s.lexical()
+	s.params()
+	s.ddl()
+	s.casts()
+	s.functions()
+	s.clauses()
+}
+
+// report records a construct at sig index p that cannot be translated.
+func (s *stmt) report(p int, format string, args ...any) {
+	offset := s.start()
+	if p >= 0 && p < len(s.sig) {
+		offset = s.toks[s.sig[p]].start
+	}
+	s.problems = append(s.problems, problem{offset, fmt.Errorf(format, args...)})
+}
+
+// tok returns the significant token at p, or the zero token past the end.
+func (s *stmt) tok(p int) token {
+	if p < 0 || p >= len(s.sig) {
+		return token{kind: tokSpace}
+	}
+	return s.toks[s.sig[p]]
+}
+
+// is reports whether the significant tokens from p on are the given keywords
+// or punctuation.
+func (s *stmt) is(p int, words ...string) bool {
+	for i, w := range words {
+		if !s.tok(p + i).is(w) {
+			return false
+		}
+	}
+	return true
+}
+
+// word returns the lowercased keyword or unquoted identifier at p, or "".
+func (s *stmt) word(p int) string {
+	if tok := s.tok(p); tok.kind == tokWord {
+		return strings.ToLower(tok.text)
+	}
+	return ""
+}
+
+// match returns the index of the parenthesis or bracket that closes the one at
+// p, or -1.
+func (s *stmt) match(p int) int {
+	open := s.tok(p).text
+	close := map[string]string{"(": ")", "[": "]"}[open]
+	if close == "" {
+		return -1
+	}
+	depth := 0
+	for q := p; q < len(s.sig); q++ {
+		switch {
+		case s.tok(q).is(open):
+			depth++
+		case s.tok(q).is(close):
+			depth--
+			if depth == 0 {
+				return q
+			}
+		}
+	}
+	return -1
+}
+
+// text returns the source text of the significant tokens from p to q,
+// inclusive.
+func (s *stmt) text(p, q int) string {
+	return s.src[s.tok(p).start:s.tok(q).end]
+}
+
+// outText returns the translation of the significant tokens from p to q,
+// inclusive.
+func (s *stmt) outText(p, q int) string {
+	return strings.Join(s.out[s.sig[p]:s.sig[q]+1], "")
+}
+
+// replace replaces the significant tokens from p to q, inclusive, and
+// everything between them, with text.
+func (s *stmt) replace(p, q int, text string) {
+	for i := s.sig[p]; i <= s.sig[q]; i++ {
+		s.out[i] = ""
+		s.done[i] = true
+	}
+	s.out[s.sig[p]] = text
+}
+
+// remove removes the significant tokens from p to q, inclusive, along with
+// the whitespace before them.
+func (s *stmt) remove(p, q int) {
+	from := s.sig[p]
+	for from > 0 && s.toks[from-1].kind == tokSpace {
+		from--
+	}
+	for i := from; i <= s.sig[q]; i++ {
+		s.out[i] = ""
+		s.done[i] = true
+	}
+}
+
+// depths returns the parenthesis depth of each significant token.
+func (s *stmt) depths() []int {
+	depth := make([]int, len(s.sig))
+	d := 0
+	for p := range s.sig {
+		if s.tok(p).is(")") {
+			d--
+		}
+		depth[p] = d
+		if s.tok(p).is("(") {
+			d++
+		}
+	}
+	return depth
+}
+
+// lexical translates comments, quoted identifiers and string literals.
+func (s *stmt) lexical() {
+	for i, tok := range s.toks {
+		switch tok.kind {
+		case tokComment:
+			s.out[i] = s.t.comment(tok.text)
+		case tokIdent:
+			s.out[i] = s.t.quoteIdent(unquoteIdent(tok.text))
+		case tokString:
+			lit, err := s.t.decodeString(tok.text)
+			if err != nil {
+				s.problems = append(s.problems, problem{tok.start, err})
+				continue
+			}
+			s.out[i] = s.t.encodeString(lit)
+		}
+	}
+}
+
+// quoteIdent quotes an identifier for the target.
+func (t *Translator) quoteIdent(name string) string {
+	q := t.toSyntax.identQuotes[0]
+	if q == '[' {
+		return "[" + strings.ReplaceAll(name, "]", "]]") + "]"
+	}
+	return string(q) + strings.ReplaceAll(name, string(q), string(q)+string(q)) + string(q)
+}
+
+// unquoteIdent returns the name a quoted identifier stands for.
+func unquoteIdent(text string) string {
+	open, close := text[0], text[0]
+	if open == '[' {
+		close = ']'
+	}
+	body := text[1:]
+	if strings.HasSuffix(body, string(close)) {
+		body = body[:len(body)-1]
+	}
+	return strings.ReplaceAll(body, string(close)+string(close), string(close))
+}
+
+// literal is the value of a string literal.
+type literal struct {
+	value    string
+	national bool
+}
+
+// decodeString returns the value of a string literal in the source engine.
+func (t *Translator) decodeString(text string) (literal, error) {
+	var lit literal
+	if strings.HasPrefix(text, "$") {
+		tag := dollarTag(text, 0)
+		lit.value = strings.TrimSuffix(strings.TrimPrefix(text, tag), tag)
+		return lit, nil
+	}
+	backslash := t.fromSyntax.backslash
+	switch text[0] {
+	case 'N', 'n':
+		lit.national = true
+		text = text[1:]
+	case 'E', 'e':
+		backslash = true
+		text = text[1:]
+	}
+	quote := text[0]
+	body := strings.TrimPrefix(text, string(quote))
+	body = strings.TrimSuffix(body, string(quote))
+	var b strings.Builder
+	for i := 0; i < len(body); i++ {
+		c := body[i]
+		switch {
+		case c == quote && i+1 < len(body) && body[i+1] == quote:
+			i++
+		case c == '\\' && backslash && i+1 < len(body):
+			i++
+			switch e := body[i]; e {
+			case 'n':
+				c = '\n'
+			case 'r':
+				c = '\r'
+			case 't':
+				c = '\t'
+			case 'b':
+				c = '\b'
+			case 'f':
+				c = '\f'
+			case 'Z':
+				c = 0x1a
+			case '0':
+				return lit, fmt.Errorf("a NUL character in a string literal has no equivalent in %s", t.to)
+			case '%', '_':
+				// MySQL keeps the backslash of \% and \_ so that they can
+				// escape wildcards in a LIKE pattern.
+				if t.from == config.EngineMySQL {
+					b.WriteByte('\\')
+				}
+				c = e
+			default:
+				c = e
+			}
+		}
+		b.WriteByte(c)
+	}
+	lit.value = b.String()
+	return lit, nil
+}
+
+// encodeString returns a string literal with the given value in the target
+// engine.
+func (t *Translator) encodeString(lit literal) string {
+	value := strings.ReplaceAll(lit.value, "'", "''")
+	if t.toSyntax.backslash {
+		value = strings.ReplaceAll(value, `\`, `\\`)
+	}
+	prefix := ""
+	if lit.national && t.to != config.EngineSQLite {
+		prefix = "N"
+	}
+	return prefix + "'" + value + "'"
+}
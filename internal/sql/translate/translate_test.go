@@ -0,0 +1,122 @@
+package translate_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+	"github.com/sqlc-dev/sqlc/internal/multierr"
+	"github.com/sqlc-dev/sqlc/internal/sql/translate"
+)
+
+func TestFile(t *testing.T) {
+	for _, tc := range []struct {
+		from, to config.Engine
+		src      string
+		want     string
+		problems []string
+	}{
+		{
+			from: config.EngineMySQL,
+			to:   config.EnginePostgreSQL,
+			src:  "CREATE TABLE `t` (`id` int unsigned NOT NULL AUTO_INCREMENT PRIMARY KEY, flag tinyint(1) DEFAULT 0, n int(11)) ENGINE=InnoDB;",
+			want: `CREATE TABLE "t" ("id" int GENERATED BY DEFAULT AS IDENTITY NOT NULL PRIMARY KEY, flag boolean DEFAULT false, n int);`,
+		},
+		{
+			from: config.EngineMySQL,
+			to:   config.EnginePostgreSQL,
+			src:  "# name: Get :one\nSELECT a FROM t WHERE b = \"x\\ty\" AND c = ? AND d = ? LIMIT 1",
+			want: "-- name: Get :one\nSELECT a FROM t WHERE b = 'x\ty' AND c = $1 AND d = $2 LIMIT 1",
+		},
+		{
+			from: config.EnginePostgreSQL,
+			to:   config.EngineMySQL,
+			src:  `SELECT (a + b)::int, lower(c)::varchar(10)::int, '\' FROM "t" WHERE d = $1`,
+			want: "SELECT CAST((a + b) AS signed), CAST(CAST(lower(c) AS char(10)) AS signed), '\\\\' FROM `t` WHERE d = ?",
+		},
+		{
+			from:     config.EnginePostgreSQL,
+			to:       config.EngineMySQL,
+			src:      "SELECT a FROM t WHERE b = $2 AND c = $1",
+			want:     "SELECT a FROM t WHERE b = $2 AND c = $1",
+			problems: []string{"1:27: parameter $2 is out of order or repeated; mysql binds parameters by position"},
+		},
+		{
+			from: config.EnginePostgreSQL,
+			to:   config.EngineSQLite,
+			src:  "SELECT a FROM t WHERE b = $2 AND c = $1 AND d = @d",
+			want: "SELECT a FROM t WHERE b = ?2 AND c = ?1 AND d = :d",
+		},
+		{
+			from: config.EnginePostgreSQL,
+			to:   config.EngineMSSQL,
+			src:  "CREATE TABLE t (id bigserial PRIMARY KEY, ok boolean NOT NULL DEFAULT true, body text, at timestamptz);",
+			want: "CREATE TABLE t (id bigint IDENTITY(1,1) PRIMARY KEY, ok bit NOT NULL DEFAULT 1, body nvarchar(max), at datetimeoffset);",
+		},
+		{
+			from: config.EngineSQLite,
+			to:   config.EngineMSSQL,
+			src:  "SELECT ifnull(a, '') FROM t ORDER BY a LIMIT ?;\nSELECT length(a) FROM t LIMIT 5;",
+			want: "SELECT isnull(a, '') FROM t ORDER BY a OFFSET 0 ROWS FETCH NEXT @p1 ROWS ONLY;\nSELECT TOP (5) len(a) FROM t;",
+		},
+		{
+			from: config.EngineSQLite,
+			to:   config.EnginePostgreSQL,
+			src:  "CREATE TABLE t (id INTEGER PRIMARY KEY, score REAL, at DATETIME) STRICT;",
+			want: "CREATE TABLE t (id BIGINT GENERATED BY DEFAULT AS IDENTITY PRIMARY KEY, score DOUBLE PRECISION, at TIMESTAMP);",
+		},
+		{
+			from: config.EngineMSSQL,
+			to:   config.EnginePostgreSQL,
+			src:  "SELECT [name], LEN(body), GETDATE() FROM [dbo].[t] WHERE id = @id",
+			want: `SELECT "name", LENGTH(body), NOW() FROM "dbo"."t" WHERE id = @id`,
+		},
+		{
+			from: config.EnginePostgreSQL,
+			to:   config.EngineMySQL,
+			src:  "-- name: List :many\nSELECT date_trunc('day', a) FROM t\nWHERE b ILIKE $1;",
+			want: "-- name: List :many\nSELECT date_trunc('day', a) FROM t\nWHERE b ILIKE ?;",
+			problems: []string{
+				"2:8: function date_trunc has no equivalent in mysql",
+				"3:9: ILIKE has no equivalent in mysql",
+			},
+		},
+	} {
+		t.Run(fmt.Sprintf("%s_%s", tc.from, tc.to), func(t *testing.T) {
+			tr, err := translate.New(tc.from, tc.to)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer tr.Close()
+			got, err := tr.File("query.sql", tc.src)
+			var problems []string
+			if err != nil {
+				var merr *multierr.Error
+				if !errors.As(err, &merr) {
+					t.Fatal(err)
+				}
+				for _, fileErr := range merr.Errs() {
+					problems = append(problems, fmt.Sprintf("%d:%d: %s", fileErr.Line, fileErr.Column, fileErr.Err))
+				}
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("translation differed (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.problems, problems); diff != "" {
+				t.Errorf("problems differed (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := translate.New(config.EngineMySQL, config.EngineMySQL); err == nil {
+		t.Error("translating an engine into itself succeeded")
+	}
+	if _, err := translate.New(config.EnginePostgreSQL, config.EngineClickHouse); err == nil {
+		t.Error("translating into clickhouse succeeded")
+	}
+}
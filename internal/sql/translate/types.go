@@ -0,0 +1,563 @@
+package translate
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/sqlc-dev/sqlc/internal/config"
+)
+
+// typeEquivalents maps a type to its spelling in each target, for the types
+// that the catalog casts cannot map: types of a different category in the
+// target, such as uuid, and types whose nearest cast would lose range or
+// precision. Keys are lowercased type names, optionally prefixed with the
+// source engine and a colon where a name means different things in different
+// engines. An empty spelling means the target has no equivalent.
+var typeEquivalents = map[string]map[config.Engine]string{
+	"bool": {
+		config.EngineMSSQL: "bit",
+	},
+	"boolean": {
+		config.EngineMSSQL: "bit",
+	},
+	"mssql:bit": {
+		config.EnginePostgreSQL: "boolean",
+		config.EngineMySQL:      "bool",
+		config.EngineSQLite:     "boolean",
+	},
+	"mssql:varchar(max)": {
+		config.EnginePostgreSQL: "text",
+		config.EngineMySQL:      "longtext",
+		config.EngineSQLite:     "text",
+	},
+	"mssql:nvarchar(max)": {
+		config.EnginePostgreSQL: "text",
+		config.EngineMySQL:      "longtext",
+		config.EngineSQLite:     "text",
+	},
+	"mssql:varbinary(max)": {
+		config.EnginePostgreSQL: "bytea",
+		config.EngineMySQL:      "longblob",
+		config.EngineSQLite:     "blob",
+	},
+	"mysql:tinyint(1)": {
+		config.EnginePostgreSQL: "boolean",
+		config.EngineSQLite:     "boolean",
+		config.EngineMSSQL:      "bit",
+	},
+	"timestamp": {
+		config.EngineMySQL: "datetime",
+		config.EngineMSSQL: "datetime2",
+	},
+	"timestamp without time zone": {
+		config.EngineMySQL: "datetime",
+		config.EngineMSSQL: "datetime2",
+	},
+	"timestamptz": {
+		config.EngineMySQL:  "timestamp",
+		config.EngineSQLite: "datetime",
+		config.EngineMSSQL:  "datetimeoffset",
+	},
+	"timestamp with time zone": {
+		config.EngineMySQL:  "timestamp",
+		config.EngineSQLite: "datetime",
+		config.EngineMSSQL:  "datetimeoffset",
+	},
+	"mysql:timestamp": {
+		config.EnginePostgreSQL: "timestamptz",
+		config.EngineMSSQL:      "datetimeoffset",
+	},
+	"datetime": {
+		config.EnginePostgreSQL: "timestamp",
+		config.EngineMSSQL:      "datetime2",
+	},
+	"datetime2": {
+		config.EnginePostgreSQL: "timestamp",
+		config.EngineMySQL:      "datetime",
+		config.EngineSQLite:     "datetime",
+	},
+	"smalldatetime": {
+		config.EnginePostgreSQL: "timestamp",
+		config.EngineMySQL:      "datetime",
+		config.EngineSQLite:     "datetime",
+	},
+	"datetimeoffset": {
+		config.EnginePostgreSQL: "timestamptz",
+		config.EngineMySQL:      "timestamp",
+		config.EngineSQLite:     "datetime",
+	},
+	"uuid": {
+		config.EngineMySQL:  "char(36)",
+		config.EngineSQLite: "text",
+		config.EngineMSSQL:  "uniqueidentifier",
+	},
+	"uniqueidentifier": {
+		config.EnginePostgreSQL: "uuid",
+		config.EngineMySQL:      "char(36)",
+		config.EngineSQLite:     "text",
+	},
+	"bytea": {
+		config.EngineMySQL:  "longblob",
+		config.EngineSQLite: "blob",
+		config.EngineMSSQL:  "varbinary(max)",
+	},
+	"blob": {
+		config.EnginePostgreSQL: "bytea",
+		config.EngineMSSQL:      "varbinary(max)",
+	},
+	"tinyblob": {
+		config.EnginePostgreSQL: "bytea",
+		config.EngineSQLite:     "blob",
+		config.EngineMSSQL:      "varbinary(255)",
+	},
+	"mediumblob": {
+		config.EnginePostgreSQL: "bytea",
+		config.EngineSQLite:     "blob",
+		config.EngineMSSQL:      "varbinary(max)",
+	},
+	"longblob": {
+		config.EnginePostgreSQL: "bytea",
+		config.EngineSQLite:     "blob",
+		config.EngineMSSQL:      "varbinary(max)",
+	},
+	"binary": {
+		config.EnginePostgreSQL: "bytea",
+		config.EngineSQLite:     "blob",
+	},
+	"varbinary": {
+		config.EnginePostgreSQL: "bytea",
+		config.EngineSQLite:     "blob",
+	},
+	"bytes": {
+		config.EnginePostgreSQL: "bytea",
+		config.EngineMySQL:      "longblob",
+		config.EngineSQLite:     "blob",
+		config.EngineMSSQL:      "varbinary(max)",
+	},
+	"json": {
+		config.EngineSQLite: "text",
+		config.EngineMSSQL:  "nvarchar(max)",
+	},
+	"jsonb": {
+		config.EngineMySQL:  "json",
+		config.EngineSQLite: "text",
+		config.EngineMSSQL:  "nvarchar(max)",
+	},
+	"text": {
+		config.EngineMSSQL: "nvarchar(max)",
+	},
+	"nvarchar": {
+		config.EnginePostgreSQL: "varchar",
+		config.EngineMySQL:      "varchar",
+	},
+	"nchar": {
+		config.EnginePostgreSQL: "char",
+		config.EngineMySQL:      "char",
+	},
+	"ntext": {
+		config.EnginePostgreSQL: "text",
+		config.EngineMySQL:      "text",
+	},
+	"mysql:float": {
+		config.EnginePostgreSQL: "real",
+		config.EngineMSSQL:      "real",
+	},
+	"mysql:real": {
+		config.EnginePostgreSQL: "double precision",
+		config.EngineMSSQL:      "float",
+	},
+	"mssql:float": {
+		config.EnginePostgreSQL: "double precision",
+		config.EngineMySQL:      "double",
+	},
+	"float4": {
+		config.EngineMySQL: "float",
+	},
+	"double precision": {
+		config.EngineMSSQL: "float",
+	},
+	"double": {
+		config.EnginePostgreSQL: "double precision",
+		config.EngineMSSQL:      "float",
+	},
+	"inet": {
+		config.EngineMySQL:  "varchar(43)",
+		config.EngineSQLite: "text",
+		config.EngineMSSQL:  "varchar(43)",
+	},
+	"cidr": {
+		config.EngineMySQL:  "varchar(43)",
+		config.EngineSQLite: "text",
+		config.EngineMSSQL:  "varchar(43)",
+	},
+	"int64": {
+		config.EnginePostgreSQL: "bigint",
+		config.EngineMySQL:      "bigint",
+		config.EngineSQLite:     "integer",
+		config.EngineMSSQL:      "bigint",
+	},
+	"float64": {
+		config.EnginePostgreSQL: "double precision",
+		config.EngineMySQL:      "double",
+		config.EngineSQLite:     "real",
+		config.EngineMSSQL:      "float",
+	},
+	"float32": {
+		config.EnginePostgreSQL: "real",
+		config.EngineMySQL:      "float",
+		config.EngineSQLite:     "real",
+		config.EngineMSSQL:      "real",
+	},
+	"string": {
+		config.EnginePostgreSQL: "text",
+		config.EngineMySQL:      "text",
+		config.EngineSQLite:     "text",
+		config.EngineMSSQL:      "nvarchar(max)",
+	},
+	"sqlite:integer": {
+		config.EnginePostgreSQL: "bigint",
+		config.EngineMySQL:      "bigint",
+		config.EngineMSSQL:      "bigint",
+	},
+	"sqlite:int": {
+		config.EnginePostgreSQL: "bigint",
+		config.EngineMySQL:      "bigint",
+		config.EngineMSSQL:      "bigint",
+	},
+	"sqlite:real": {
+		config.EnginePostgreSQL: "double precision",
+		config.EngineMySQL:      "double",
+		config.EngineMSSQL:      "float",
+	},
+	"clickhouse:int8": {
+		config.EnginePostgreSQL: "smallint",
+		config.EngineMySQL:      "tinyint",
+		config.EngineSQLite:     "integer",
+		config.EngineMSSQL:      "smallint",
+	},
+	"clickhouse:int16": {
+		config.EnginePostgreSQL: "smallint",
+		config.EngineMySQL:      "smallint",
+		config.EngineSQLite:     "integer",
+		config.EngineMSSQL:      "smallint",
+	},
+	"clickhouse:int32": {
+		config.EnginePostgreSQL: "integer",
+		config.EngineMySQL:      "int",
+		config.EngineSQLite:     "integer",
+		config.EngineMSSQL:      "int",
+	},
+	"clickhouse:int64": {
+		config.EnginePostgreSQL: "bigint",
+		config.EngineMySQL:      "bigint",
+		config.EngineSQLite:     "integer",
+		config.EngineMSSQL:      "bigint",
+	},
+	"clickhouse:datetime": {
+		config.EnginePostgreSQL: "timestamp",
+		config.EngineMySQL:      "datetime",
+		config.EngineSQLite:     "datetime",
+		config.EngineMSSQL:      "datetime2",
+	},
+}
+
+// unsignedTypes maps each MySQL integer type to the smallest signed type that
+// holds all of its unsigned values, for targets with no unsigned integers.
+var unsignedTypes = map[string]string{
+	"tinyint":   "smallint",
+	"smallint":  "int",
+	"mediumint": "int",
+	"int":       "bigint",
+	"integer":   "bigint",
+	"bigint":    "decimal(20)",
+}
+
+// displayWidth lists the integer types whose modifier in MySQL is a display
+// width rather than part of the type.
+var displayWidth = map[string]bool{
+	"tinyint":   true,
+	"smallint":  true,
+	"mediumint": true,
+	"int":       true,
+	"integer":   true,
+	"bigint":    true,
+}
+
+// modifiable lists the types that take a length, precision or scale, and so
+// keep the modifiers written after the type they were translated from.
+var modifiable = map[string]bool{
+	"char":              true,
+	"character":         true,
+	"varchar":           true,
+	"character varying": true,
+	"nchar":             true,
+	"nvarchar":          true,
+	"binary":            true,
+	"varbinary":         true,
+	"decimal":           true,
+	"numeric":           true,
+	"dec":               true,
+	"time":              true,
+	"timestamp":         true,
+	"timestamptz":       true,
+	"datetime":          true,
+	"datetime2":         true,
+	"datetimeoffset":    true,
+	"bit":               true,
+	"varbit":            true,
+}
+
+// typeName is a type as written in a statement.
+type typeName struct {
+	// name is the lowercased name, with the words of a multi-word name such
+	// as "double precision" separated by single spaces.
+	name string
+	// mods is the parenthesized modifier list, such as "(10, 2)", or "".
+	mods string
+	// unsigned reports a MySQL UNSIGNED integer.
+	unsigned bool
+	// array reports one or more "[]" suffixes.
+	array bool
+}
+
+// mapType returns the spelling of a type in the target engine.
+func (t *Translator) mapType(tn typeName) (string, error) {
+	if tn.array {
+		return "", fmt.Errorf("array type %s[] has no equivalent in %s", tn.name, t.to)
+	}
+	name, mods := tn.name, tn.mods
+	if t.from == config.EngineMySQL && displayWidth[name] {
+		if name == "tinyint" && strings.ReplaceAll(mods, " ", "") == "(1)" && !tn.unsigned {
+			name = "tinyint(1)"
+		}
+		mods = ""
+	}
+	if t.from == config.EngineMSSQL && strings.EqualFold(strings.ReplaceAll(mods, " ", ""), "(max)") {
+		name, mods = name+"(max)", ""
+	}
+	if tn.unsigned {
+		if t.to == config.EngineMySQL {
+			return name + mods + " unsigned", nil
+		}
+		wider, ok := unsignedTypes[name]
+		if !ok {
+			return "", fmt.Errorf("type %s unsigned has no equivalent in %s", name, t.to)
+		}
+		name = wider
+	}
+	if t.from == t.to {
+		return name + mods, nil
+	}
+
+	mapped, ok := "", false
+	for _, key := range []string{string(t.from) + ":" + name, name} {
+		if spellings, found := typeEquivalents[key]; found {
+			mapped, ok = spellings[t.to]
+			if ok {
+				break
+			}
+		}
+	}
+	if !ok {
+		if _, err := t.toCat.TypeOID(name); err == nil {
+			mapped, ok = name, true
+		}
+	}
+	if !ok {
+		mapped, ok = t.castableType(name)
+	}
+	if !ok || mapped == "" {
+		return "", fmt.Errorf("type %s has no equivalent in %s", name, t.to)
+	}
+	if strings.Contains(mapped, "(") || !modifiable[mapped] {
+		return mapped, nil
+	}
+	return mapped + mods, nil
+}
+
+// castableType finds a type for name through the source catalog's casts: of
+// the types of the same category that name implicitly casts to, the one
+// nearest to it in the dialect's own order that the target also has. Seed
+// files list the types of a category from narrowest to widest, so of two
+// equally near types the wider one wins.
+func (t *Translator) castableType(name string) (string, bool) {
+	oid, err := t.fromCat.TypeOID(name)
+	if err != nil {
+		return "", false
+	}
+	info, err := t.fromCat.LookupType(oid)
+	if err != nil || info.Category == "" {
+		return "", false
+	}
+	peers, err := t.fromCat.TypeOIDsInCategory(info.Category)
+	if err != nil {
+		return "", false
+	}
+	at := -1
+	for i, peer := range peers {
+		if peer == oid {
+			at = i
+		}
+	}
+	if at < 0 {
+		return "", false
+	}
+	candidate := func(i int) (string, bool) {
+		if i < 0 || i >= len(peers) {
+			return "", false
+		}
+		if ok, err := t.fromCat.CastAllowed(oid, peers[i], "i"); err != nil || !ok {
+			return "", false
+		}
+		peer, err := t.fromCat.TypeName(peers[i])
+		if err != nil {
+			return "", false
+		}
+		if _, err := t.toCat.TypeOID(peer); err != nil {
+			return "", false
+		}
+		return peer, true
+	}
+	for d := 1; d < len(peers); d++ {
+		if peer, ok := candidate(at + d); ok {
+			return peer, true
+		}
+		if peer, ok := candidate(at - d); ok {
+			return peer, true
+		}
+	}
+	return "", false
+}
+
+// identity returns the target's spelling of an auto-incrementing column, and
+// the type the column must have for it, if any.
+func (t *Translator) identity() (clause, columnType string, err error) {
+	switch t.to {
+	case config.EnginePostgreSQL:
+		return "GENERATED BY DEFAULT AS IDENTITY", "", nil
+	case config.EngineMySQL:
+		return "AUTO_INCREMENT", "", nil
+	case config.EngineMSSQL:
+		return "IDENTITY(1,1)", "", nil
+	case config.EngineSQLite:
+		// An INTEGER PRIMARY KEY column is an alias for the rowid, which
+		// SQLite assigns automatically.
+		return "", "integer", nil
+	}
+	return "", "", fmt.Errorf("auto-incrementing columns have no equivalent in %s", t.to)
+}
+
+// serialTypes maps PostgreSQL's serial pseudo-types to the integer type
+// behind them.
+var serialTypes = map[string]string{
+	"smallserial": "smallint",
+	"serial2":     "smallint",
+	"serial":      "integer",
+	"serial4":     "integer",
+	"bigserial":   "bigint",
+	"serial8":     "bigint",
+}
+
+// parseType reads the type written at significant token p and returns it with
+// the index of its last token.
+func (s *stmt) parseType(p int) (typeName, int, bool) {
+	if s.tok(p).kind != tokWord {
+		return typeName{}, 0, false
+	}
+	// The schema of a qualified name, such as pg_catalog.int4, is dropped.
+	for s.is(p+1, ".") && s.tok(p+2).kind == tokWord {
+		p += 2
+	}
+	tn := typeName{name: s.word(p)}
+	q := p
+	next := func(words ...string) bool {
+		if s.is(q+1, words...) {
+			q += len(words)
+			return true
+		}
+		return false
+	}
+	mods := func() {
+		if s.is(q+1, "(") {
+			if end := s.match(q + 1); end > 0 {
+				tn.mods = s.text(q+1, end)
+				q = end
+			}
+		}
+	}
+	switch tn.name {
+	case "double":
+		if next("precision") {
+			tn.name = "double precision"
+		}
+	case "character", "char":
+		if next("varying") {
+			tn.name = "character varying"
+		}
+	case "bit":
+		if next("varying") {
+			tn.name = "varbit"
+		}
+	case "time", "timestamp":
+		mods()
+		if next("with", "time", "zone") {
+			tn.name += " with time zone"
+		} else if next("without", "time", "zone") {
+			tn.name += " without time zone"
+		}
+	case "signed", "unsigned":
+		// The integer types of a MySQL CAST.
+		_ = next("integer") || next("int")
+		tn.unsigned = tn.name == "unsigned"
+		tn.name = "bigint"
+	}
+	if tn.mods == "" {
+		mods()
+	}
+	for more := true; more; {
+		switch {
+		case next("unsigned"):
+			tn.unsigned = true
+		case next("signed"), next("zerofill"):
+		default:
+			more = false
+		}
+	}
+	for s.is(q+1, "[") {
+		end := s.match(q + 1)
+		if end < 0 {
+			break
+		}
+		tn.array = true
+		q = end
+	}
+	return tn, q, true
+}
+
+// translateType rewrites the type at significant tokens p to end, and
+// returns its spelling in the target.
+func (s *stmt) translateType(p, end int, tn typeName) (string, bool) {
+	mapped, err := s.t.mapType(tn)
+	if err != nil {
+		s.report(p, "%v", err)
+		return "", false
+	}
+	s.writeType(p, end, mapped)
+	return mapped, true
+}
+
+// writeType replaces the type at significant tokens p to end with mapped,
+// written in the case of the original, unless it is already spelled that way.
+func (s *stmt) writeType(p, end int, mapped string) {
+	text := s.text(p, end)
+	if strings.Join(strings.Fields(strings.ToLower(text)), " ") == mapped {
+		for q := p; q <= end; q++ {
+			s.done[s.sig[q]] = true
+		}
+		return
+	}
+	if text == strings.ToUpper(text) {
+		mapped = strings.ToUpper(mapped)
+	}
+	s.replace(p, end, mapped)
+}
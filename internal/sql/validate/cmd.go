@@ -45,6 +45,31 @@ func validateCopyfrom(n ast.Node) error {
 	return nil
 }
 
+func validateBulk(n ast.Node) error {
+	stmt, ok := n.(*ast.InsertStmt)
+	if !ok {
+		return errors.New(":bulkexec requires an INSERT INTO statement")
+	}
+	if stmt.WithClause != nil {
+		return errors.New(":bulkexec is not compatible with WITH clauses")
+	}
+	sel, ok := stmt.SelectStmt.(*ast.SelectStmt)
+	if !ok || sel.ValuesLists == nil || len(sel.ValuesLists.Items) == 0 {
+		return errors.New(":bulkexec requires an INSERT with a VALUES list")
+	}
+	if len(sel.ValuesLists.Items) != 1 {
+		return errors.New(":bulkexec requires a VALUES list with a single row")
+	}
+	args := astutils.Search(n, func(n ast.Node) bool {
+		_, ok := n.(*ast.ParamRef)
+		return ok
+	})
+	if len(args.Items) == 0 {
+		return errors.New(":bulkexec requires parameters")
+	}
+	return nil
+}
+
 func validateBatch(n ast.Node) error {
 	args := astutils.Search(n, func(n ast.Node) bool {
 		_, ok := n.(*ast.ParamRef)
@@ -56,10 +81,43 @@ func validateBatch(n ast.Node) error {
 	return nil
 }
 
+func validatePaginate(n ast.Node) error {
+	stmt, ok := n.(*ast.SelectStmt)
+	if !ok {
+		return errors.New(":paginate requires a SELECT statement")
+	}
+	if len(astutils.SortItems(stmt)) == 0 {
+		return errors.New(":paginate requires an ORDER BY clause")
+	}
+	if present(stmt.LimitCount) || present(stmt.LimitOffset) {
+		return errors.New(":paginate is not compatible with LIMIT or OFFSET")
+	}
+	if stmt.LockingClause != nil && len(stmt.LockingClause.Items) > 0 {
+		return errors.New(":paginate is not compatible with locking clauses")
+	}
+	return nil
+}
+
+// present reports whether an optional clause was written. The PostgreSQL
+// parser fills omitted clauses with a TODO node.
+func present(n ast.Node) bool {
+	if n == nil {
+		return false
+	}
+	_, todo := n.(*ast.TODO)
+	return !todo
+}
+
 func Cmd(n ast.Node, name, cmd string) error {
 	if cmd == metadata.CmdCopyFrom {
 		return validateCopyfrom(n)
 	}
+	if cmd == metadata.CmdPaginate {
+		return validatePaginate(n)
+	}
+	if cmd == metadata.CmdBulkExec {
+		return validateBulk(n)
+	}
 	if (cmd == metadata.CmdBatchExec || cmd == metadata.CmdBatchMany) || cmd == metadata.CmdBatchOne {
 		if err := validateBatch(n); err != nil {
 			return err
@@ -1,6 +1,7 @@
 package validate
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/sqlc-dev/sqlc/internal/sql/ast"
@@ -11,6 +12,9 @@ import (
 const excludedTable = "EXCLUDED"
 
 func InsertStmt(c *catalog.Catalog, fqn *ast.TableName, stmt *ast.InsertStmt) error {
+	if err := generatedColumns(c, fqn, stmt); err != nil {
+		return err
+	}
 	sel, ok := stmt.SelectStmt.(*ast.SelectStmt)
 	if !ok {
 		return nil
@@ -44,6 +48,37 @@ func InsertStmt(c *catalog.Catalog, fqn *ast.TableName, stmt *ast.InsertStmt) er
 	return onConflictClause(c, fqn, stmt)
 }
 
+// generatedColumns reports an INSERT that names a generated column, which
+// computes its own value.
+func generatedColumns(c *catalog.Catalog, fqn *ast.TableName, n *ast.InsertStmt) error {
+	if fqn == nil || n.Cols == nil {
+		return nil
+	}
+	// A table that does not exist is reported where its columns are resolved.
+	table, err := c.GetTable(fqn)
+	if err != nil {
+		return nil
+	}
+	generated := map[string]bool{}
+	for _, col := range table.Columns {
+		if col.IsGenerated {
+			generated[col.Name] = true
+		}
+	}
+	for _, item := range n.Cols.Items {
+		target, ok := item.(*ast.ResTarget)
+		if !ok || target.Name == nil || !generated[*target.Name] {
+			continue
+		}
+		return &sqlerr.Error{
+			Code:     "428C9",
+			Message:  fmt.Sprintf("cannot insert into generated column %q", *target.Name),
+			Location: target.Location,
+		}
+	}
+	return nil
+}
+
 // onConflictClause validates an ON CONFLICT DO UPDATE clause against the target
 // table. It checks:
 //   - ON CONFLICT (col, ...) conflict target columns exist